@@ -0,0 +1,36 @@
+package main
+
+import (
+	"os"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewASTCmd returns the command which prints the AST of a document in JSON
+func NewASTCmd() *cobra.Command {
+	var attributes []string
+	cmd := &cobra.Command{
+		Use:   "ast [flags] FILE",
+		Short: "Print the AST of the document in JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sourcePath := args[0]
+			file, err := os.Open(sourcePath)
+			if err != nil {
+				return errors.Wrapf(err, "error opening %s", sourcePath)
+			}
+			defer file.Close()
+			config := configuration.NewConfiguration(
+				configuration.WithFilename(sourcePath),
+				configuration.WithAttributes(parseAttributes(attributes)))
+			return libasciidoc.ExportJSON(file, cmd.OutOrStdout(), config)
+		},
+	}
+	cmd.SilenceUsage = true
+	cmd.Flags().StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	return cmd
+}
@@ -0,0 +1,39 @@
+package main_test
+
+import (
+	"bytes"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ast cmd", func() {
+
+	It("print the AST in JSON", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("{\n  \"version\": \"1\",\n  \"document\": {\n    \"type\": \"Document\","))
+	})
+
+	It("fail to print the AST of an unknown file", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"test/unknown.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+})
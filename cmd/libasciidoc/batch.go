@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// convertAll converts the given sources with a pool of `jobs` concurrent workers (or sequentially if `jobs` is lower than 2).
+// All the sources are converted even if some of them fail, and the returned error summarizes the failures, in the order of the sources.
+func convertAll(sources []string, jobs int, convert convertFunc) error {
+	if jobs < 1 {
+		jobs = 1
+	}
+	if jobs > len(sources) {
+		jobs = len(sources)
+	}
+	errs := make([]error, len(sources))
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if _, err := convert(sources[i]); err != nil {
+					log.WithError(err).Errorf("failed to convert '%s'", sources[i])
+					errs[i] = err
+				}
+			}
+		}()
+	}
+	for i := range sources {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return summarize(sources, errs)
+}
+
+// summarize returns an error which lists the sources that could not be converted, or nil if all sources were converted
+func summarize(sources []string, errs []error) error {
+	failures := []string{}
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, "'"+sources[i]+"': "+err.Error())
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	if len(sources) == 1 {
+		// keep the original error when converting a single file
+		return errs[0]
+	}
+	return errors.Errorf("failed to convert %d of %d file(s):\n- %s", len(failures), len(sources), strings.Join(failures, "\n- "))
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("batch conversion", func() {
+
+	// concurrency records the number of concurrent conversions
+	type concurrency struct {
+		sync.Mutex
+		current   int
+		max       int
+		converted []string
+	}
+
+	convertWith := func(c *concurrency, failures ...string) convertFunc {
+		return func(source string) ([]string, error) {
+			c.Lock()
+			c.current++
+			if c.current > c.max {
+				c.max = c.current
+			}
+			c.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			c.Lock()
+			defer c.Unlock()
+			c.current--
+			c.converted = append(c.converted, source)
+			for _, f := range failures {
+				if f == source {
+					return nil, fmt.Errorf("mock error")
+				}
+			}
+			return []string{source}, nil
+		}
+	}
+
+	It("should convert sources concurrently", func() {
+		c := &concurrency{}
+		err := convertAll([]string{"a.adoc", "b.adoc", "c.adoc", "d.adoc", "e.adoc"}, 2, convertWith(c))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.converted).To(ConsistOf("a.adoc", "b.adoc", "c.adoc", "d.adoc", "e.adoc"))
+		Expect(c.max).To(Equal(2))
+	})
+
+	It("should convert sources sequentially", func() {
+		c := &concurrency{}
+		err := convertAll([]string{"a.adoc", "b.adoc", "c.adoc"}, 1, convertWith(c))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.converted).To(Equal([]string{"a.adoc", "b.adoc", "c.adoc"}))
+		Expect(c.max).To(Equal(1))
+	})
+
+	It("should summarize failures in order of sources", func() {
+		c := &concurrency{}
+		err := convertAll([]string{"a.adoc", "b.adoc", "c.adoc", "d.adoc"}, 4, convertWith(c, "d.adoc", "b.adoc"))
+		Expect(err).To(MatchError("failed to convert 2 of 4 file(s):\n- 'b.adoc': mock error\n- 'd.adoc': mock error"))
+		// all sources were converted anyways
+		Expect(c.converted).To(HaveLen(4))
+	})
+
+	It("should return original error with single source", func() {
+		c := &concurrency{}
+		err := convertAll([]string{"a.adoc"}, 4, convertWith(c, "a.adoc"))
+		Expect(err).To(MatchError("mock error"))
+	})
+})
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewBuildCmd returns the command which converts all the documents of a directory into a mirrored output directory
+func NewBuildCmd() *cobra.Command {
+	var sourceDir string
+	var destinationDir string
+	var css string
+	var attributes []string
+	cmd := &cobra.Command{
+		Use:   "build [flags]",
+		Short: "Convert all the documents of a directory (and its subdirectories) in HTML, into a mirrored output directory",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			b := &siteBuilder{
+				sourceDir:      sourceDir,
+				destinationDir: destinationDir,
+				css:            css,
+				attrs:          parseAttributes(attributes),
+				copied:         map[string]bool{},
+			}
+			return b.build(cmd.OutOrStdout())
+		},
+	}
+	cmd.SilenceUsage = true
+	flags := cmd.Flags()
+	flags.StringVar(&sourceDir, "source-dir", ".", "the directory of the documents to convert")
+	flags.StringVarP(&destinationDir, "destination-dir", "D", "public", "the directory in which the documents are converted")
+	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the documents (copied in the destination directory if it is in the source directory)")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	return cmd
+}
+
+type siteBuilder struct {
+	sourceDir      string
+	destinationDir string
+	css            string
+	attrs          map[string]string
+	// the assets which were already copied
+	copied map[string]bool
+}
+
+func (b *siteBuilder) build(out io.Writer) error {
+	src, err := filepath.Abs(b.sourceDir)
+	if err != nil {
+		return err
+	}
+	dest, err := filepath.Abs(b.destinationDir)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(src); err != nil || !info.IsDir() {
+		return errors.Errorf("'%s' is not a directory", b.sourceDir)
+	}
+	b.sourceDir, b.destinationDir = src, dest
+	docs, err := b.documents()
+	if err != nil {
+		return err
+	}
+	failures := 0
+	for _, doc := range docs {
+		if err := b.convert(doc); err != nil {
+			log.WithError(err).Errorf("failed to convert '%s'", doc)
+			failures++
+		}
+	}
+	if b.css != "" {
+		b.copyAsset(b.sourceDir, b.css)
+	}
+	fmt.Fprintf(out, "converted %d document(s) into '%s'\n", len(docs)-failures, b.destinationDir)
+	if failures > 0 {
+		return errors.Errorf("failed to convert %d document(s)", failures)
+	}
+	return nil
+}
+
+// documents returns the paths of the documents to convert, ie, the Asciidoc files of the source directory and its
+// subdirectories, except the partials (the files and directories whose name starts with `_`), the hidden files and
+// directories, and the destination directory
+func (b *siteBuilder) documents() ([]string, error) {
+	docs := []string{}
+	err := filepath.Walk(b.sourceDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name := info.Name()
+		if info.IsDir() {
+			if p != b.sourceDir && (strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") || p == b.destinationDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") {
+			return nil
+		}
+		if types.IsAsciidocFile(name) {
+			docs = append(docs, p)
+		}
+		return nil
+	})
+	return docs, err
+}
+
+// convert converts the given document into the mirrored location in the destination directory, then copies
+// the images and other local files it references
+func (b *siteBuilder) convert(doc string) error {
+	rel, err := filepath.Rel(b.sourceDir, doc)
+	if err != nil {
+		return err
+	}
+	output := filepath.Join(b.destinationDir, strings.TrimSuffix(rel, filepath.Ext(rel))+".html")
+	if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	assets := []string{}
+	config := configuration.NewConfiguration(
+		configuration.WithFilename(doc),
+		configuration.WithAttributes(b.attrs),
+		configuration.WithCSS(b.cssHref(rel)),
+		configuration.WithHeaderFooter(true),
+		// collect the local files referenced in the document
+		configuration.WithTreeProcessor(func(d *types.Document) error {
+			assets = append(assets, localReferences(d)...)
+			return nil
+		}))
+	log.Debugf("converting '%s' into '%s'", doc, output)
+	if _, err := libasciidoc.ConvertFileToHTML(f, config); err != nil {
+		return err
+	}
+	for _, a := range assets {
+		b.copyAsset(filepath.Dir(doc), a)
+	}
+	return nil
+}
+
+// cssHref returns the location of the CSS file, relative to the document at the given path within the source directory
+func (b *siteBuilder) cssHref(rel string) string {
+	if b.css == "" || !isLocalReference(b.css) || filepath.IsAbs(b.css) {
+		return b.css
+	}
+	depth := strings.Count(filepath.ToSlash(rel), "/")
+	return strings.Repeat("../", depth) + filepath.ToSlash(b.css)
+}
+
+// copyAsset copies the given local file (relative to the given directory) into its mirrored location in the
+// destination directory, unless it is outside of the source directory or it was already copied
+func (b *siteBuilder) copyAsset(dir, location string) {
+	// ignore the query and fragment, if any (eg: `file.pdf#page=2`)
+	if i := strings.IndexAny(location, "?#"); i != -1 {
+		location = location[:i]
+	}
+	p := filepath.Join(dir, filepath.FromSlash(location))
+	rel, err := filepath.Rel(b.sourceDir, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		log.Warnf("skipping asset outside of the source directory: '%s'", location)
+		return
+	}
+	if b.copied[p] {
+		return
+	}
+	b.copied[p] = true
+	if err := copyFile(p, filepath.Join(b.destinationDir, rel)); err != nil {
+		log.WithError(err).Warnf("failed to copy asset '%s'", location)
+	}
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// localReferences returns the locations of the images and the links to local files (other than documents) in the given document
+func localReferences(doc *types.Document) []string {
+	result := []string{}
+	for _, img := range types.FindImages(*doc) {
+		var loc string
+		switch img := img.(type) {
+		case types.ImageBlock:
+			loc = img.Location.String()
+		case types.InlineImage:
+			loc = img.Location.String()
+		}
+		if isLocalReference(loc) {
+			result = append(result, loc)
+		}
+	}
+	for _, l := range types.FindLinks(*doc) {
+		loc := l.Location.String()
+		if isLocalReference(loc) && !isDocument(loc) {
+			result = append(result, loc)
+		}
+	}
+	return result
+}
+
+var schemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+
+// isLocalReference returns true if the given location is a relative path to a local file (ie, not a URL, a fragment, etc.)
+func isLocalReference(loc string) bool {
+	return loc != "" && !strings.HasPrefix(loc, "#") && !strings.HasPrefix(loc, "/") && !schemeRegexp.MatchString(loc)
+}
+
+func isDocument(loc string) bool {
+	if i := strings.Index(loc, "#"); i != -1 {
+		loc = loc[:i]
+	}
+	return types.IsAsciidocFile(loc)
+}
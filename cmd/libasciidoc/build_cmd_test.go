@@ -0,0 +1,102 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("build cmd", func() {
+
+	var src, dest string
+
+	BeforeEach(func() {
+		var err error
+		src, err = ioutil.TempDir("", "libasciidoc-build-src")
+		Expect(err).ToNot(HaveOccurred())
+		dest, err = ioutil.TempDir("", "libasciidoc-build-dest")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(src)
+		os.RemoveAll(dest)
+	})
+
+	writeFile := func(name, content string) {
+		p := filepath.Join(src, filepath.FromSlash(name))
+		Expect(os.MkdirAll(filepath.Dir(p), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+	}
+
+	readFile := func(name string) string {
+		content, err := ioutil.ReadFile(filepath.Join(dest, filepath.FromSlash(name)))
+		Expect(err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	It("should convert documents into mirrored tree", func() {
+		// given
+		writeFile("index.adoc", "see xref:guides/install.adoc#linux[the install guide]\n\nimage::images/logo.png[]\n\ninclude::_partials/footer.adoc[]")
+		writeFile("guides/install.adoc", "back to <<../index.adoc#,home>> or link:files/setup.sh[the script]\n\nimage:../images/logo.png[]")
+		writeFile("guides/files/setup.sh", "echo hello")
+		writeFile("images/logo.png", "PNG")
+		writeFile("images/unused.png", "PNG")
+		writeFile("_partials/footer.adoc", "the footer")
+		writeFile("_draft.adoc", "draft")
+		writeFile("style.css", "body {}")
+		root := main.NewBuildCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--source-dir", src, "-D", dest, "--css", "style.css"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		index := readFile("index.html")
+		Expect(index).To(ContainSubstring(`<a href="guides/install.html#linux">the install guide</a>`))
+		Expect(index).To(ContainSubstring(`<p>the footer</p>`))
+		Expect(index).To(ContainSubstring(`<link type="text/css" rel="stylesheet" href="style.css">`))
+		install := readFile("guides/install.html")
+		Expect(install).To(ContainSubstring(`<a href="../index.html">home</a>`))
+		Expect(install).To(ContainSubstring(`<link type="text/css" rel="stylesheet" href="../style.css">`))
+		// assets
+		Expect(readFile("images/logo.png")).To(Equal("PNG"))
+		Expect(readFile("guides/files/setup.sh")).To(Equal("echo hello"))
+		Expect(readFile("style.css")).To(Equal("body {}"))
+		Expect(filepath.Join(dest, "images", "unused.png")).ToNot(BeAnExistingFile())
+		// partials
+		Expect(filepath.Join(dest, "_partials")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(dest, "_draft.html")).ToNot(BeAnExistingFile())
+		Expect(buf.String()).To(ContainSubstring("converted 2 document(s)"))
+	})
+
+	It("should report failures", func() {
+		// given
+		writeFile("valid.adoc", "valid")
+		writeFile("invalid.adoc", "invalid \xff content")
+		root := main.NewBuildCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--source-dir", src, "-D", dest})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("failed to convert 1 document(s)"))
+		Expect(readFile("valid.html")).To(ContainSubstring(`<p>valid</p>`))
+	})
+
+	It("should fail with missing source dir", func() {
+		root := main.NewBuildCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--source-dir", filepath.Join(src, "unknown"), "-D", dest})
+		Expect(root.Execute()).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewCheckLinksCmd returns the command which checks the links, cross references and images of documents
+func NewCheckLinksCmd() *cobra.Command {
+	var format string
+	var failureLevel string
+	var remote bool
+	var timeout time.Duration
+	var concurrency int
+	var attributes []string
+	var rules []string
+	cmd := &cobra.Command{
+		Use:   "check-links [flags] FILE...",
+		Short: "Check that the local files, images, documents and (optionally) remote resources referenced in the documents exist",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, found := reporters[format]
+			if !found {
+				return errors.Errorf("unsupported format: '%s'", format)
+			}
+			threshold, err := parseFailureLevel(failureLevel)
+			if err != nil {
+				return err
+			}
+			// keep the standard output for the report
+			log.SetOutput(cmd.OutOrStderr())
+			// the state of the rules in the project file, which is overridden by the `--rule` flags
+			settings := []configuration.Setting{}
+			project, err := loadProjectFile(args)
+			if err != nil {
+				return err
+			}
+			if project != nil {
+				for id, state := range project.Rules {
+					if !isValidationRule(id) && !isLinkRule(id) {
+						return errors.Errorf("invalid project file '%s': unknown rule: '%s'", project.Path, id)
+					}
+					s, err := configuration.ParseRuleState(state)
+					if err != nil {
+						return errors.Wrapf(err, "invalid project file '%s'", project.Path)
+					}
+					settings = append(settings, configuration.WithValidationRule(id, s))
+				}
+			}
+			ruleSettings, err := parseRules(rules, isLinkRule)
+			if err != nil {
+				return err
+			}
+			settings = append(settings, ruleSettings...)
+			settings = append(settings, configuration.WithAttributes(parseAttributes(attributes)))
+			options := []validator.LinkCheckerOption{
+				validator.WithLinkCheckConcurrency(concurrency),
+				validator.WithLinkCheckTimeout(timeout),
+			}
+			if remote {
+				// the timeout of the client also applies to the redirects and to the reading of the responses
+				options = append(options, validator.WithHTTPClient(&http.Client{Timeout: timeout}))
+			}
+			checker := validator.NewLinkChecker(options...)
+			diagnostics := []diagnostic{}
+			for _, sourcePath := range args {
+				problems, err := checker.CheckFile(configuration.NewConfiguration(
+					append(settings, configuration.WithFilename(sourcePath))...))
+				if err != nil {
+					diagnostics = append(diagnostics, parseErrorDiagnostics(sourcePath, err)...)
+					continue
+				}
+				diagnostics = append(diagnostics, problemDiagnostics(sourcePath, problems)...)
+			}
+			return reportDiagnostics(cmd.OutOrStdout(), report, diagnostics, threshold, failureLevel)
+		},
+	}
+	cmd.SilenceUsage = true
+	flags := cmd.Flags()
+	flags.StringVarP(&format, "format", "f", "text", "the format of the report [text|json|sarif]")
+	flags.StringVar(&failureLevel, "failure-level", "error", "the minimum severity of the problems which cause the command to fail [warning|error]")
+	flags.BoolVar(&remote, "remote", false, "check that the remote (HTTP and HTTPS) links respond successfully")
+	flags.DurationVar(&timeout, "timeout", validator.DefaultLinkCheckTimeout, "the timeout when checking a remote link")
+	flags.IntVarP(&concurrency, "concurrency", "c", validator.DefaultLinkCheckConcurrency, "the number of remote links checked concurrently")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	flags.StringArrayVar(&rules, "rule", []string{}, "the state of a link rule in the form of id=state, where state is one of [off|on|warning|error]")
+	return cmd
+}
@@ -0,0 +1,75 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("check-links cmd", func() {
+
+	var dir string
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-check-links")
+		Expect(err).ToNot(HaveOccurred())
+		server = httptest.NewServer(http.NotFoundHandler())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+		return p
+	}
+
+	checkLinks := func(args ...string) (string, error) {
+		root := main.NewCheckLinksCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs(args)
+		err := root.Execute()
+		return buf.String(), err
+	}
+
+	It("should report missing local files", func() {
+		writeFile("logo.png", "PNG")
+		doc := writeFile("doc.adoc", "image::logo.png[]\n\nimage::missing.png[]\n\n{server}/missing[]")
+		output, err := checkLinks("-a", "server="+server.URL, doc)
+		Expect(err).To(MatchError("found 1 problem(s) with severity 'error' or higher"))
+		Expect(output).To(HavePrefix(doc + ":3:1: error: missing image: 'missing.png' [missing-image]\n"))
+	})
+
+	It("should turn off rules", func() {
+		doc := writeFile("doc.adoc", "image::missing.png[]")
+		output, err := checkLinks("--rule", "missing-image=off", doc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(BeEmpty())
+		_, err = checkLinks("--rule", "unknown=off", doc)
+		Expect(err).To(MatchError("unknown rule: 'unknown'"))
+		// the validation rules are applied by the `lint` command only
+		_, err = checkLinks("--rule", "source-language=off", doc)
+		Expect(err).To(MatchError("unknown rule: 'source-language'"))
+	})
+
+	It("should report broken remote links", func() {
+		doc := writeFile("doc.adoc", "{server}/missing[]")
+		output, err := checkLinks("--remote", "--failure-level", "warning", "-a", "server="+server.URL, doc)
+		Expect(err).To(MatchError("found 1 problem(s) with severity 'warning' or higher"))
+		Expect(output).To(HavePrefix(doc + ": warning: remote link '" + server.URL + "/missing' responded with status 404 [broken-remote-link]\n"))
+	})
+})
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// the number of unchanged lines displayed around the changes
+const diffContext = 3
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// unifiedDiff returns the diff between the `before` and `after` contents of the file at the given path,
+// in the unified format
+func unifiedDiff(path, before, after string) string {
+	ops := diffLines(before, after)
+	result := &strings.Builder{}
+	fmt.Fprintf(result, "--- %s.orig\n+++ %s\n", path, path)
+	// positions of the ops in the `before` and `after` contents
+	beforePos := make([]int, len(ops)+1)
+	afterPos := make([]int, len(ops)+1)
+	for i, op := range ops {
+		beforePos[i+1], afterPos[i+1] = beforePos[i], afterPos[i]
+		if op.kind != '+' {
+			beforePos[i+1]++
+		}
+		if op.kind != '-' {
+			afterPos[i+1]++
+		}
+	}
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// extend the hunk until there are more than twice the context of unchanged lines
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(ops) && j-end <= 2*diffContext; j++ {
+			if ops[j].kind != ' ' {
+				end = j + 1
+			}
+		}
+		end += diffContext
+		if end > len(ops) {
+			end = len(ops)
+		}
+		fmt.Fprintf(result, "@@ -%s +%s @@\n",
+			hunkRange(beforePos[start], beforePos[end]-beforePos[start]),
+			hunkRange(afterPos[start], afterPos[end]-afterPos[start]))
+		for _, op := range ops[start:end] {
+			fmt.Fprintf(result, "%c%s\n", op.kind, op.line)
+		}
+		i = end
+	}
+	return result.String()
+}
+
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
+
+// diffLines computes the operations to transform the `before` content into the `after` content, line by line
+func diffLines(before, after string) []diffOp {
+	dmp := diffmatchpatch.New()
+	chars1, chars2, lines := dmp.DiffLinesToChars(withTrailingNewline(before), withTrailingNewline(after))
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(chars1, chars2, false), lines)
+	ops := []diffOp{}
+	for _, d := range diffs {
+		var kind byte
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			kind = '+'
+		case diffmatchpatch.DiffDelete:
+			kind = '-'
+		default:
+			kind = ' '
+		}
+		for _, l := range strings.SplitAfter(d.Text, "\n") {
+			if l != "" {
+				ops = append(ops, diffOp{kind: kind, line: strings.TrimSuffix(l, "\n")})
+			}
+		}
+	}
+	return ops
+}
+
+// withTrailingNewline appends a newline to the given (non-empty) content if it has none, so that the
+// last line is compared with the same line in the other content
+func withTrailingNewline(content string) string {
+	if content == "" || strings.HasSuffix(content, "\n") {
+		return content
+	}
+	return content + "\n"
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+
+	"github.com/pkg/errors"
+)
+
+// FormatError formats the given error to print it on the console. Parse errors are printed
+// in a compiler-style format, eg:
+//
+//	doc.adoc:1:7: error: rule WS: invalid encoding
+//	hello � world
+//	      ^
+func FormatError(err error) string {
+	parseErrs, ok := errors.Cause(err).(parser.ParseErrors)
+	if !ok {
+		return err.Error()
+	}
+	buf := &strings.Builder{}
+	for i, e := range parseErrs {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		location := fmt.Sprintf("%d:%d", e.Line, e.Column)
+		if e.Filename != "" {
+			location = e.Filename + ":" + location
+		}
+		msg := e.Message
+		if e.Rule != "" {
+			msg = fmt.Sprintf("rule %s: %s", e.Rule, e.Message)
+		}
+		fmt.Fprintf(buf, "%s: error: %s", location, msg)
+		if e.Excerpt != "" {
+			buf.WriteString("\n" + e.Excerpt)
+		}
+	}
+	return buf.String()
+}
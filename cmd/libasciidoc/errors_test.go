@@ -0,0 +1,34 @@
+package main_test
+
+import (
+	"fmt"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+
+	"github.com/pkg/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("format errors", func() {
+
+	It("should format parse errors in compiler-style", func() {
+		err := errors.Wrap(parser.ParseErrors{
+			{
+				Filename: "doc.adoc",
+				Line:     1,
+				Column:   7,
+				Rule:     "WS",
+				Message:  "invalid encoding",
+				Excerpt:  "hello \xff world\n      ^",
+			},
+		}, "error while parsing")
+		Expect(main.FormatError(err)).To(Equal("doc.adoc:1:7: error: rule WS: invalid encoding\nhello \xff world\n      ^"))
+	})
+
+	It("should format other errors", func() {
+		Expect(main.FormatError(fmt.Errorf("unknown help topic: foo"))).To(Equal("unknown help topic: foo"))
+	})
+})
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewFmtCmd returns the command which formats the documents into canonical Asciidoc
+func NewFmtCmd() *cobra.Command {
+	var write bool
+	var diff bool
+	cmd := &cobra.Command{
+		Use:   "fmt [flags] FILES",
+		Short: "Format the documents into canonical Asciidoc",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, sourcePath := range args {
+				source, err := ioutil.ReadFile(sourcePath)
+				if err != nil {
+					return errors.Wrapf(err, "error opening %s", sourcePath)
+				}
+				config := configuration.NewConfiguration(configuration.WithFilename(sourcePath))
+				result := bytes.NewBuffer(nil)
+				if err := libasciidoc.Format(bytes.NewReader(source), result, config); err != nil {
+					return errors.Wrapf(err, "error formatting %s", sourcePath)
+				}
+				changed := !bytes.Equal(source, result.Bytes())
+				if diff && changed {
+					fmt.Fprint(cmd.OutOrStdout(), unifiedDiff(sourcePath, string(source), result.String()))
+				}
+				if write && changed {
+					info, err := os.Stat(sourcePath)
+					if err != nil {
+						return errors.Wrapf(err, "error writing %s", sourcePath)
+					}
+					if err := ioutil.WriteFile(sourcePath, result.Bytes(), info.Mode()); err != nil {
+						return errors.Wrapf(err, "error writing %s", sourcePath)
+					}
+				}
+				if !write && !diff {
+					if _, err := cmd.OutOrStdout().Write(result.Bytes()); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	}
+	cmd.SilenceUsage = true
+	cmd.Flags().BoolVarP(&write, "write", "w", false, "write the result to the source files instead of the standard output")
+	cmd.Flags().BoolVarP(&diff, "diff", "d", false, "display the diffs instead of the formatted documents")
+	return cmd
+}
@@ -0,0 +1,113 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fmt cmd", func() {
+
+	var sourcePath string
+
+	BeforeEach(func() {
+		f, err := ioutil.TempFile("", "libasciidoc-fmt-*.adoc")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteString("= Title\n\n\n== Section\n- item 1\n- item 2\n\n|===\n|a |b\n|===\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		sourcePath = f.Name()
+	})
+
+	AfterEach(func() {
+		os.Remove(sourcePath)
+	})
+
+	It("print the formatted document", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{sourcePath})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("= Title\n\n== Section\n\n- item 1\n- item 2\n\n|===\n| a | b\n|===\n"))
+	})
+
+	It("write the formatted document", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-w", sourcePath})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+		content, err := ioutil.ReadFile(sourcePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("= Title\n\n== Section\n\n- item 1\n- item 2\n\n|===\n| a | b\n|===\n"))
+	})
+
+	It("print the diff", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-d", sourcePath})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("--- " + sourcePath + ".orig\n" +
+			"+++ " + sourcePath + "\n" +
+			"@@ -1,10 +1,10 @@\n" +
+			" = Title\n" +
+			" \n" +
+			"-\n" +
+			" == Section\n" +
+			"+\n" +
+			" - item 1\n" +
+			" - item 2\n" +
+			" \n" +
+			" |===\n" +
+			"-|a |b\n" +
+			"+| a | b\n" +
+			" |===\n"))
+	})
+
+	It("print nothing when the document is already formatted", func() {
+		// given
+		Expect(ioutil.WriteFile(sourcePath, []byte("= Title\n\n== Section\n"), 0644)).To(Succeed())
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-d", sourcePath})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+	})
+
+	It("fail to format an unknown file", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"test/unknown.adoc"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+})
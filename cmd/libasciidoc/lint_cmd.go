@@ -0,0 +1,435 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewLintCmd returns the command which parses and validates documents, and reports the problems
+// in text, JSON or SARIF
+func NewLintCmd() *cobra.Command {
+	var format string
+	var failureLevel string
+	var attributes []string
+	var rules []string
+	var listRules bool
+	cmd := &cobra.Command{
+		Use:   "lint [flags] FILE...",
+		Short: "Parse and validate the documents, and report the problems (without rendering the documents)",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if listRules {
+				return nil
+			}
+			return cobra.MinimumNArgs(1)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if listRules {
+				return printRules(cmd.OutOrStdout())
+			}
+			report, found := reporters[format]
+			if !found {
+				return errors.Errorf("unsupported format: '%s'", format)
+			}
+			threshold, err := parseFailureLevel(failureLevel)
+			if err != nil {
+				return err
+			}
+			// keep the standard output for the report
+			log.SetOutput(cmd.OutOrStderr())
+			ruleSettings, err := parseRules(rules, isValidationRule)
+			if err != nil {
+				return err
+			}
+			// the rules given in the flags override the default rules of the command
+			settings := append(lintRuleSettings(), ruleSettings...)
+			settings = append(settings, configuration.WithAttributes(parseAttributes(attributes)))
+			diagnostics := []diagnostic{}
+			for _, sourcePath := range args {
+				diagnostics = append(diagnostics, lint(sourcePath, settings)...)
+			}
+			return reportDiagnostics(cmd.OutOrStdout(), report, diagnostics, threshold, failureLevel)
+		},
+	}
+	cmd.SilenceUsage = true
+	flags := cmd.Flags()
+	flags.StringVarP(&format, "format", "f", "text", "the format of the report [text|json|sarif]")
+	flags.StringVar(&failureLevel, "failure-level", "error", "the minimum severity of the problems which cause the command to fail [warning|error]")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	flags.StringArrayVar(&rules, "rule", []string{}, "the state of a validation rule in the form of id=state, where state is one of [off|on|warning|error]")
+	flags.BoolVar(&listRules, "list-rules", false, "list the validation rules")
+	return cmd
+}
+
+// parseRules converts the `id=state` values of the `--rule` flags into configuration settings, given a function
+// which returns true if the rule with the given ID is supported by the command
+func parseRules(rules []string, supported func(id string) bool) ([]configuration.Setting, error) {
+	settings := make([]configuration.Setting, 0, len(rules))
+	for _, r := range rules {
+		data := strings.SplitN(r, "=", 2)
+		if len(data) != 2 {
+			return nil, errors.Errorf("invalid rule: '%s' (expected id=state)", r)
+		}
+		if !supported(data[0]) {
+			return nil, errors.Errorf("unknown rule: '%s'", data[0])
+		}
+		state, err := configuration.ParseRuleState(data[1])
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, configuration.WithValidationRule(data[0], state))
+	}
+	return settings, nil
+}
+
+// isValidationRule returns true if the given ID is the ID of a rule of the validation registry
+func isValidationRule(id string) bool {
+	_, found := validator.LookupRule(id)
+	return found
+}
+
+// isLinkRule returns true if the given ID is the ID of a rule applied by the `check-links` command
+func isLinkRule(id string) bool {
+	_, found := validator.LookupLinkRule(id)
+	return found
+}
+
+// the rules which are enabled by default in the `lint` command, in addition to the rules which are enabled by default
+// in the registry (they are disabled by default when the documents are converted, to avoid reporting the same warnings
+// at each conversion)
+var lintRules = []string{
+	validator.BrokenCrossReferenceRule,
+	validator.DuplicateIDRule,
+	validator.SectionLevelRule,
+}
+
+// lintRuleSettings returns the settings which enable the default rules of the `lint` command
+func lintRuleSettings() []configuration.Setting {
+	settings := make([]configuration.Setting, 0, len(lintRules))
+	for _, id := range lintRules {
+		settings = append(settings, configuration.WithValidationRule(id, configuration.RuleOn))
+	}
+	return settings
+}
+
+// isLintRule returns true if the rule with the given ID is enabled by default in the `lint` command
+func isLintRule(id string) bool {
+	for _, r := range lintRules {
+		if r == id {
+			return true
+		}
+	}
+	return false
+}
+
+// printRules prints the ID, the default state (in the `lint` command) and the description of the registered validation rules
+func printRules(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tDEFAULT\tDESCRIPTION")
+	for _, r := range validator.RegisteredRules() {
+		state := configuration.RuleOff
+		if r.EnabledByDefault || isLintRule(r.Rule.ID()) {
+			state = configuration.RuleOn
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.Rule.ID(), state, r.Rule.Description())
+	}
+	return w.Flush()
+}
+
+const (
+	// the severity of the problems reported by the command
+	severityError   = "error"
+	severityWarning = "warning"
+	// the ID of the rule for the documents which could not be parsed
+	parseErrorRule = "parse-error"
+)
+
+// diagnostic a problem found in a file
+type diagnostic struct {
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// reportDiagnostics writes the diagnostics with the given reporter, and returns an error if some of them have the
+// given severity level or higher
+func reportDiagnostics(out io.Writer, report reporter, diagnostics []diagnostic, threshold int, failureLevel string) error {
+	if err := report(out, diagnostics); err != nil {
+		return err
+	}
+	failures := 0
+	for _, d := range diagnostics {
+		if severityRank(d.Severity) >= threshold {
+			failures++
+		}
+	}
+	if failures > 0 {
+		return errors.Errorf("found %d problem(s) with severity '%s' or higher", failures, failureLevel)
+	}
+	return nil
+}
+
+func parseFailureLevel(level string) (int, error) {
+	switch level {
+	case severityWarning, severityError:
+		return severityRank(level), nil
+	default:
+		return 0, errors.Errorf("unsupported failure level: '%s'", level)
+	}
+}
+
+func severityRank(severity string) int {
+	if severity == severityError {
+		return 2
+	}
+	return 1
+}
+
+// lint parses and validates the given file and returns the problems found, including the diagnostics
+// reported while parsing the file (eg: unknown attributes or failed inclusions)
+func lint(sourcePath string, settings []configuration.Setting) []diagnostic {
+	result := []diagnostic{}
+	config := configuration.NewConfiguration(append(settings,
+		configuration.WithFilename(sourcePath),
+		configuration.WithDiagnosticHandler(func(d types.Diagnostic) {
+			result = append(result, parseDiagnostic(sourcePath, d))
+		}))...)
+	problems, err := libasciidoc.ValidateFile(config)
+	if err != nil {
+		return append(result, parseErrorDiagnostics(sourcePath, err)...)
+	}
+	return append(result, problemDiagnostics(sourcePath, problems)...)
+}
+
+// parseDiagnostic converts the given diagnostic reported while parsing a file into a diagnostic
+// whose rule is the category of the diagnostic (eg: `parse` or `include`)
+func parseDiagnostic(sourcePath string, d types.Diagnostic) diagnostic {
+	severity := severityWarning
+	if d.Severity == types.DiagnosticError {
+		severity = severityError
+	}
+	file := d.Position.Filename
+	if file == "" {
+		file = sourcePath
+	}
+	msg := d.Message
+	if d.Cause != nil {
+		msg = fmt.Sprintf("%s: %v", msg, d.Cause)
+	}
+	return diagnostic{
+		File:     file,
+		Line:     d.Position.Line,
+		Column:   d.Position.Column,
+		Rule:     string(d.Category),
+		Severity: severity,
+		Message:  msg,
+	}
+}
+
+// problemDiagnostics converts the given validation problems into diagnostics
+func problemDiagnostics(sourcePath string, problems []validator.Problem) []diagnostic {
+	result := make([]diagnostic, 0, len(problems))
+	for _, p := range problems {
+		severity := severityWarning
+		if p.Severity == validator.Error {
+			severity = severityError
+		}
+		file := p.Position.Filename
+		if file == "" {
+			file = sourcePath
+		}
+		result = append(result, diagnostic{
+			File:     file,
+			Line:     p.Position.Line,
+			Column:   p.Position.Column,
+			Rule:     p.Rule,
+			Severity: severity,
+			Message:  p.Message,
+		})
+	}
+	return result
+}
+
+// parseErrorDiagnostics converts the given parse error into diagnostics (one per parse error, without duplicates)
+func parseErrorDiagnostics(sourcePath string, err error) []diagnostic {
+	parseErrs, ok := errors.Cause(err).(parser.ParseErrors)
+	if !ok {
+		return []diagnostic{
+			{
+				File:     sourcePath,
+				Rule:     parseErrorRule,
+				Severity: severityError,
+				Message:  err.Error(),
+			},
+		}
+	}
+	result := []diagnostic{}
+	seen := map[string]bool{}
+	for _, e := range parseErrs {
+		msg := e.Message
+		if e.Rule != "" {
+			msg = fmt.Sprintf("rule %s: %s", e.Rule, e.Message)
+		}
+		key := fmt.Sprintf("%d:%d:%s", e.Line, e.Column, msg)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, diagnostic{
+			File:     sourcePath,
+			Rule:     parseErrorRule,
+			Severity: severityError,
+			Line:     e.Line,
+			Column:   e.Column,
+			Message:  msg,
+		})
+	}
+	return result
+}
+
+type reporter func(io.Writer, []diagnostic) error
+
+var reporters = map[string]reporter{
+	"text":  textReport,
+	"json":  jsonReport,
+	"sarif": sarifReport,
+}
+
+// textReport writes the diagnostics in a compiler-style format, eg: `file.adoc:1:9: error: invalid encoding [parse-error]`
+func textReport(out io.Writer, diagnostics []diagnostic) error {
+	for _, d := range diagnostics {
+		location := d.File
+		if d.Line > 0 {
+			location = fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+		}
+		if _, err := fmt.Fprintf(out, "%s: %s: %s [%s]\n", location, d.Severity, d.Message, d.Rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func jsonReport(out io.Writer, diagnostics []diagnostic) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diagnostics)
+}
+
+// sarifReport writes the diagnostics in the SARIF 2.1.0 format
+// (see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html)
+func sarifReport(out io.Writer, diagnostics []diagnostic) error {
+	version := libasciidoc.BuildTag
+	if version == "" {
+		version = libasciidoc.BuildCommit
+	}
+	rules := []sarifRule{}
+	ruleIDs := map[string]bool{}
+	results := make([]sarifResult, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		if !ruleIDs[d.Rule] {
+			ruleIDs[d.Rule] = true
+			rules = append(rules, sarifRule{ID: d.Rule})
+		}
+		location := sarifLocation{}
+		location.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(d.File)
+		if d.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{
+				StartLine:   d.Line,
+				StartColumn: d.Column,
+			}
+		}
+		results = append(results, sarifResult{
+			RuleID:    d.Rule,
+			Level:     d.Severity,
+			Message:   sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{location},
+		})
+	}
+	report := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "libasciidoc",
+						InformationURI: "https://github.com/bytesparadise/libasciidoc",
+						Version:        version,
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region *sarifRegion `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
@@ -0,0 +1,203 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lint cmd", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-lint")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+		return p
+	}
+
+	lint := func(args ...string) (string, error) {
+		root := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs(args)
+		err := root.Execute()
+		return buf.String(), err
+	}
+
+	It("should not report problems", func() {
+		valid := writeFile("valid.adoc", "= Title\n\ncontent")
+		output, err := lint(valid)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(BeEmpty())
+	})
+
+	It("should report problems in text", func() {
+		manpage := writeFile("manpage.adoc", "= Title\n:doctype: manpage\n\ncontent")
+		invalid := writeFile("invalid.adoc", "hello \xff world")
+		output, err := lint(manpage, invalid)
+		Expect(err).To(MatchError("found 3 problem(s) with severity 'error' or higher"))
+		Expect(output).To(HavePrefix(manpage + `: error: manpage document is missing the 'Name' section' [manpage-structure]
+` + invalid + `:1:7: error: rule WS: invalid encoding [parse-error]
+`))
+	})
+
+	It("should report problems in JSON", func() {
+		invalid := writeFile("invalid.adoc", "hello \xff world")
+		output, err := lint("--format", "json", "--failure-level", "warning", invalid)
+		Expect(err).To(HaveOccurred())
+		result := []map[string]interface{}{}
+		Expect(json.NewDecoder(bytes.NewBufferString(output)).Decode(&result)).To(Succeed())
+		Expect(result).To(ContainElement(map[string]interface{}{
+			"file":     invalid,
+			"line":     1.0,
+			"column":   7.0,
+			"rule":     "parse-error",
+			"severity": "error",
+			"message":  "rule WS: invalid encoding",
+		}))
+	})
+
+	It("should report problems in SARIF", func() {
+		manpage := writeFile("manpage.adoc", "= Title\n:doctype: manpage\n\ncontent")
+		output, err := lint("-f", "sarif", manpage)
+		Expect(err).To(HaveOccurred())
+		result := map[string]interface{}{}
+		Expect(json.NewDecoder(bytes.NewBufferString(output)).Decode(&result)).To(Succeed())
+		Expect(result["version"]).To(Equal("2.1.0"))
+		run := result["runs"].([]interface{})[0].(map[string]interface{})
+		Expect(run["tool"]).To(HaveKeyWithValue("driver", HaveKeyWithValue("name", "libasciidoc")))
+		Expect(run["results"]).To(Equal([]interface{}{
+			map[string]interface{}{
+				"ruleId": "manpage-structure",
+				"level":  "error",
+				"message": map[string]interface{}{
+					"text": "manpage document is missing the 'Name' section'",
+				},
+				"locations": []interface{}{
+					map[string]interface{}{
+						"physicalLocation": map[string]interface{}{
+							"artifactLocation": map[string]interface{}{
+								"uri": filepath.ToSlash(manpage),
+							},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("should report the region of the problems in SARIF", func() {
+		doc := writeFile("doc.adoc", "== Section\n\nsee <<unknown>>")
+		output, err := lint("-f", "sarif", doc)
+		Expect(err).ToNot(HaveOccurred())
+		result := map[string]interface{}{}
+		Expect(json.NewDecoder(bytes.NewBufferString(output)).Decode(&result)).To(Succeed())
+		run := result["runs"].([]interface{})[0].(map[string]interface{})
+		Expect(run["results"]).To(Equal([]interface{}{
+			map[string]interface{}{
+				"ruleId": "broken-cross-reference",
+				"level":  "warning",
+				"message": map[string]interface{}{
+					"text": "cross reference to an unknown element: 'unknown'",
+				},
+				"locations": []interface{}{
+					map[string]interface{}{
+						"physicalLocation": map[string]interface{}{
+							"artifactLocation": map[string]interface{}{
+								"uri": filepath.ToSlash(doc),
+							},
+							"region": map[string]interface{}{
+								"startLine":   3.0,
+								"startColumn": 5.0,
+							},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("should report the diagnostics of the parser", func() {
+		doc := writeFile("doc.adoc", "= Title\n\na paragraph with {unknown}\n\ninclude::missing.adoc[]")
+		output, err := lint(doc)
+		Expect(err).To(MatchError("found 1 problem(s) with severity 'error' or higher"))
+		Expect(output).To(ContainSubstring(doc + ":5:1: error: failed to include 'missing.adoc'"))
+		Expect(output).To(ContainSubstring(doc + ": warning: unable to find attribute 'unknown' [parse]\n"))
+	})
+
+	It("should fail with unsupported format", func() {
+		valid := writeFile("valid.adoc", "content")
+		_, err := lint("-f", "xml", valid)
+		Expect(err).To(MatchError("unsupported format: 'xml'"))
+	})
+
+	It("should fail with unsupported failure level", func() {
+		valid := writeFile("valid.adoc", "content")
+		_, err := lint("--failure-level", "info", valid)
+		Expect(err).To(MatchError("unsupported failure level: 'info'"))
+	})
+
+	It("should enable rules", func() {
+		doc := writeFile("doc.adoc", "[source]\n----\ncode\n----")
+		output, err := lint(doc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(BeEmpty())
+		output, err = lint("--rule", "source-language=error", doc)
+		Expect(err).To(MatchError("found 1 problem(s) with severity 'error' or higher"))
+		Expect(output).To(HavePrefix(doc + ":1:1: error: source block without language [source-language]\n"))
+	})
+
+	It("should report reference problems by default", func() {
+		doc := writeFile("doc.adoc", "== Section\n\nsee <<unknown>>")
+		output, err := lint("--failure-level", "warning", doc)
+		Expect(err).To(MatchError("found 1 problem(s) with severity 'warning' or higher"))
+		Expect(output).To(HavePrefix(doc + ":3:5: warning: cross reference to an unknown element: 'unknown' [broken-cross-reference]\n"))
+		output, err = lint("--rule", "broken-cross-reference=off", doc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(BeEmpty())
+	})
+
+	It("should fail with invalid rules", func() {
+		valid := writeFile("valid.adoc", "content")
+		_, err := lint("--rule", "unknown=on", valid)
+		Expect(err).To(MatchError("unknown rule: 'unknown'"))
+		_, err = lint("--rule", "source-language", valid)
+		Expect(err).To(MatchError("invalid rule: 'source-language' (expected id=state)"))
+		_, err = lint("--rule", "source-language=fatal", valid)
+		Expect(err).To(MatchError("unknown rule state: 'fatal'"))
+	})
+
+	It("should list rules", func() {
+		output, err := lint("--list-rules")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(HavePrefix("RULE"))
+		Expect(output).To(MatchRegexp(`broken-cross-reference\s+on\s+the targets of the cross references exist`))
+		Expect(output).To(MatchRegexp(`insecure-link\s+off\s+`))
+		// the link rules are applied by the `check-links` command only
+		Expect(output).ToNot(ContainSubstring("missing-image"))
+	})
+
+	It("should reject link rules", func() {
+		valid := writeFile("valid.adoc", "content")
+		_, err := lint("--rule", "missing-image=off", valid)
+		Expect(err).To(MatchError("unknown rule: 'missing-image'"))
+	})
+})
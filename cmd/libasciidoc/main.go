@@ -13,9 +13,22 @@ func main() {
 	rootCmd := NewRootCmd()
 	versionCmd := NewVersionCmd()
 	rootCmd.AddCommand(versionCmd)
+	astCmd := NewASTCmd()
+	rootCmd.AddCommand(astCmd)
+	fmtCmd := NewFmtCmd()
+	rootCmd.AddCommand(fmtCmd)
+	serveCmd := NewServeCmd()
+	rootCmd.AddCommand(serveCmd)
+	buildCmd := NewBuildCmd()
+	rootCmd.AddCommand(buildCmd)
+	lintCmd := NewLintCmd()
+	rootCmd.AddCommand(lintCmd)
+	checkLinksCmd := NewCheckLinksCmd()
+	rootCmd.AddCommand(checkLinksCmd)
 	rootCmd.SetHelpCommand(helpCommand)
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		// print the error on STDERR, so it does not mix with the output of the command (eg: a JSON report)
+		fmt.Fprintln(os.Stderr, FormatError(err))
 		os.Exit(1)
 	}
 }
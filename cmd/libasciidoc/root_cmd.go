@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"path/filepath"
 
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -21,13 +27,22 @@ func NewRootCmd() *cobra.Command {
 
 	var noHeaderFooter bool
 	var outputName string
+	var destinationDir string
 	var logLevel string
 	var css string
+	var backend string
+	var safeMode string
 	var attributes []string
+	var watchChanges bool
+	var jobs int
+	var rules []string
+	var timings bool
+	var cpuProfile string
+	var memProfile string
 
 	rootCmd := &cobra.Command{
 		Use:   "libasciidoc [flags] FILE",
-		Short: `libasciidoc is a tool to convert from Asciidoc to HTML`,
+		Short: `libasciidoc is a tool to convert from Asciidoc to HTML, reveal.js slides, EPUB3 or LaTeX`,
 		Args:  cobra.ArbitraryArgs,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			lvl, err := log.ParseLevel(logLevel)
@@ -41,40 +56,182 @@ func NewRootCmd() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
-				return helpCommand.RunE(cmd, args)
+			// the settings of the project file, which are overridden by the flags of the command
+			project, err := loadProjectFile(args)
+			if err != nil {
+				return err
 			}
-			attrs := parseAttributes(attributes)
-			for _, sourcePath := range args {
-				out, close := getOut(cmd, sourcePath, outputName)
-				if out != nil {
-					defer close()
-					path, _ := filepath.Abs(sourcePath)
-					log.Debugf("Starting to process file %v", path)
-					config := configuration.NewConfiguration(
-						configuration.WithFilename(sourcePath),
-						configuration.WithAttributes(attrs),
-						configuration.WithCSS(css),
-						configuration.WithHeaderFooter(!noHeaderFooter))
-					_, err := libasciidoc.ConvertFileToHTML(out, config)
-					if err != nil {
+			projectSettings := []configuration.Setting{}
+			projectAttributes := map[string]string{}
+			if project != nil {
+				if len(args) == 0 {
+					if args, err = project.InputFiles(); err != nil {
 						return err
 					}
 				}
+				flags := cmd.Flags()
+				if !flags.Changed("backend") && project.Backend != "" {
+					backend = project.Backend
+				}
+				if !flags.Changed("css") {
+					css = project.CSS
+				}
+				if !flags.Changed("safe-mode") && project.SafeMode != "" {
+					safeMode = project.SafeMode
+				}
+				if !flags.Changed("destination-dir") {
+					destinationDir = project.DestinationDir
+				}
+				for id := range project.Rules {
+					// the project file also sets the state of the rules of the `check-links` command
+					if !isValidationRule(id) && !isLinkRule(id) {
+						return errors.Errorf("invalid project file '%s': unknown rule: '%s'", project.Path, id)
+					}
+				}
+				if projectSettings, err = project.Settings(); err != nil {
+					return err
+				}
+				projectAttributes = project.Attributes
 			}
-			return nil
+			if len(args) == 0 {
+				return helpCommand.RunE(cmd, args)
+			}
+			var convert func(io.Reader, io.Writer, configuration.Configuration) (types.Metadata, error)
+			var ext string
+			switch backend {
+			case "html", "html5":
+				convert = libasciidoc.ConvertToHTML
+				ext = ".html"
+			case "slides", "revealjs":
+				convert = libasciidoc.ConvertToSlides
+				ext = ".html"
+			case "epub", "epub3":
+				convert = libasciidoc.ConvertToEPUB
+				ext = ".epub"
+			case "latex":
+				convert = libasciidoc.ConvertToLaTeX
+				ext = ".tex"
+			default:
+				return fmt.Errorf("unsupported backend: '%s'", backend)
+			}
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			attrs := make(map[string]string, len(projectAttributes)+len(attributes))
+			for k, v := range projectAttributes {
+				attrs[k] = v
+			}
+			for k, v := range parseAttributes(attributes) {
+				attrs[k] = v
+			}
+			ruleSettings, err := parseRules(rules, isValidationRule)
+			if err != nil {
+				return err
+			}
+			// the output files mirror the tree of the source files in the destination directory
+			sourceDir := commonDir(args)
+			if outputName == "" {
+				if err := checkOutputNames(args, sourceDir, destinationDir, ext); err != nil {
+					return err
+				}
+			}
+			reporter := &timingsReporter{
+				out: cmd.OutOrStderr(),
+			}
+			stopProfiling, err := startProfiling(cpuProfile, memProfile)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := stopProfiling(); err != nil {
+					log.WithError(err).Error("failed to write profiles")
+				}
+			}()
+			convertFile := func(sourcePath string) ([]string, error) {
+				// open the source before creating the output file, so that no empty output is left behind if the source is missing
+				source, err := os.Open(sourcePath)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error opening %s", sourcePath)
+				}
+				defer source.Close()
+				// use the file mtime as the `last updated` value
+				stat, err := source.Stat()
+				if err != nil {
+					return nil, errors.Wrapf(err, "error opening %s", sourcePath)
+				}
+				out, close, err := getOut(cmd, sourcePath, outputName, sourceDir, destinationDir, ext)
+				if err != nil {
+					return nil, err
+				}
+				path, _ := filepath.Abs(sourcePath)
+				log.Debugf("Starting to process file %v", path)
+				settings := append(append([]configuration.Setting{}, projectSettings...), ruleSettings...)
+				if timings {
+					// the timings are collected in the metadata of the converted document
+					settings = append(settings, configuration.WithTimingHandler(func(types.Timing) {}))
+				}
+				config := configuration.NewConfiguration(append(settings,
+					configuration.WithFilename(sourcePath),
+					configuration.WithLastUpdated(stat.ModTime()),
+					configuration.WithAttributes(attrs),
+					configuration.WithCSS(css),
+					configuration.WithHeaderFooter(!noHeaderFooter),
+					configuration.WithSafeMode(mode))...)
+				// keep track of the included files, in case they need to be watched
+				resolver := trackIncludes(&config)
+				metadata, err := convert(source, out, config)
+				// close the output file as soon as the source is converted
+				if cerr := close(); cerr != nil && err == nil {
+					err = cerr
+				}
+				if timings && err == nil {
+					err = reporter.report(sourcePath, metadata.Timings)
+				}
+				deps := append([]string{path}, resolver.resolvedPaths()...)
+				deps = append(deps, cssFile(css)...)
+				deps = append(deps, docinfoFiles(sourcePath)...)
+				return deps, err
+			}
+			if watchChanges {
+				ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+				defer stop()
+				return watch(ctx, args, convertFile, watchInterval, watchDebounce)
+			}
+			if outputName != "" && jobs > 1 {
+				// all sources are written in the same output
+				log.Debugf("converting the files sequentially since they are written in '%s'", outputName)
+				jobs = 1
+			}
+			return convertAll(args, jobs, convertFile)
 		},
 	}
 	rootCmd.SilenceUsage = true
 	flags := rootCmd.Flags()
 	flags.BoolVarP(&noHeaderFooter, "no-header-footer", "s", false, "do not render header/footer (default: false)")
 	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.StringVarP(&destinationDir, "destination-dir", "D", "", "the directory in which the output files are written, in the same tree as the input files (default: the directory of the input file)")
 	flags.StringVar(&logLevel, "log", "warning", "log level to set [debug|info|warning|error|fatal|panic]")
 	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the document")
+	flags.StringVarP(&backend, "backend", "b", "html5", "the backend used to convert the document [html5|slides|epub3|latex]")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "the safe mode [unsafe|safe|server|secure]")
+	flags.BoolVar(&watchChanges, "watch", false, "watch the files and their dependencies (included files, CSS, docinfo), and convert them again when they change")
+	flags.IntVarP(&jobs, "jobs", "j", 1, "the number of files to convert concurrently")
+	flags.BoolVar(&timings, "timings", false, "print the duration and the memory allocations of each phase of the conversion, and of each included file")
+	flags.StringVar(&cpuProfile, "cpuprofile", "", "write a CPU profile (in the pprof format) of the conversion to the given file")
+	flags.StringVar(&memProfile, "memprofile", "", "write a heap profile (in the pprof format) at the end of the conversion to the given file")
+	flags.StringArrayVar(&rules, "rule", []string{}, "the state of a validation rule in the form of id=state, where state is one of [off|on|warning|error]")
 	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
 	return rootCmd
 }
 
+const (
+	// the interval at which the watched files are checked
+	watchInterval = 250 * time.Millisecond
+	// the delay without change after which the files are converted again
+	watchDebounce = 500 * time.Millisecond
+)
+
 type closeFunc func() error
 
 func defaultCloseFunc() closeFunc {
@@ -87,29 +244,98 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, closeFunc) {
+func getOut(cmd *cobra.Command, sourcePath, outputName, sourceDir, destinationDir, ext string) (io.Writer, closeFunc, error) {
 	if outputName == "-" {
 		// outfile is STDOUT
-		return cmd.OutOrStdout(), defaultCloseFunc()
+		return cmd.OutOrStdout(), defaultCloseFunc(), nil
 	} else if outputName != "" {
 		// outfile is specified in the command line
-		outfile, e := os.Create(outputName)
-		if e != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outputName)
+		outfile, err := os.Create(outputName)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot create output file '%s'", outputName)
 		}
-		return outfile, newCloseFileFunc(outfile)
+		return outfile, newCloseFileFunc(outfile), nil
 	} else if sourcePath != "" {
 		// outfile is based on sourcePath
-		path, _ := filepath.Abs(sourcePath)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
+		outname := outputPath(sourcePath, sourceDir, destinationDir, ext)
+		if destinationDir != "" {
+			// outfile is in the destination directory
+			if err := os.MkdirAll(filepath.Dir(outname), 0755); err != nil {
+				return nil, nil, errors.Wrapf(err, "cannot create destination directory '%s'", filepath.Dir(outname))
+			}
+		}
 		outfile, err := os.Create(outname)
 		if err != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outname)
-			return nil, nil
+			return nil, nil, errors.Wrapf(err, "cannot create output file '%s'", outname)
+		}
+		return outfile, newCloseFileFunc(outfile), nil
+	}
+	return cmd.OutOrStdout(), defaultCloseFunc(), nil
+}
+
+// outputPath returns the path of the output file of the given source file, ie, next to the source file, or in the
+// same location relatively to the destination directory as the source file relatively to the source directory
+func outputPath(sourcePath, sourceDir, destinationDir, ext string) string {
+	path, _ := filepath.Abs(sourcePath)
+	outname := strings.TrimSuffix(path, filepath.Ext(path)) + ext
+	if destinationDir == "" {
+		return outname
+	}
+	rel, err := filepath.Rel(sourceDir, outname)
+	if err != nil {
+		rel = filepath.Base(outname)
+	}
+	return filepath.Join(destinationDir, rel)
+}
+
+// commonDir returns the (absolute) deepest directory which contains all the given source files
+func commonDir(sourcePaths []string) string {
+	result := ""
+	for i, p := range sourcePaths {
+		path, _ := filepath.Abs(p)
+		dir := filepath.Dir(path)
+		if i == 0 {
+			result = dir
+			continue
+		}
+		for result != filepath.Dir(result) && dir != result && !strings.HasPrefix(dir, result+string(filepath.Separator)) {
+			result = filepath.Dir(result)
+		}
+	}
+	return result
+}
+
+// checkOutputNames returns an error if two of the given source files would be converted into the same output file
+// (eg: `doc.adoc` and `doc.asciidoc`), since one would overwrite the other
+func checkOutputNames(sourcePaths []string, sourceDir, destinationDir, ext string) error {
+	sources := make(map[string]string, len(sourcePaths))
+	for _, p := range sourcePaths {
+		outname := outputPath(p, sourceDir, destinationDir, ext)
+		if other, found := sources[outname]; found && other != p {
+			return errors.Errorf("'%s' and '%s' would both be converted into '%s'", other, p, outname)
 		}
-		return outfile, newCloseFileFunc(outfile)
+		sources[outname] = p
+	}
+	return nil
+}
+
+// loadProjectFile loads the project file found in the directory of the first source file (or in the current directory
+// if there is no source file) or in its parent directories. Returns `nil` if there is no project file
+func loadProjectFile(sourcePaths []string) (*configuration.ProjectFile, error) {
+	dir := "."
+	if len(sourcePaths) > 0 {
+		dir = filepath.Dir(sourcePaths[0])
+	}
+	path, err := configuration.FindProjectFile(dir)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	log.Debugf("using project file '%s'", path)
+	project, err := configuration.LoadProjectFile(path)
+	if err != nil {
+		return nil, err
 	}
-	return cmd.OutOrStdout(), defaultCloseFunc()
+	return &project, nil
 }
 
 // converts the `name`, `!name` and `name=value` into a map
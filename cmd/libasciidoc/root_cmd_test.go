@@ -3,6 +3,8 @@ package main_test
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
 
@@ -12,6 +14,18 @@ import (
 
 var _ = Describe("root cmd", func() {
 
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-root")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
 	It("render with STDOUT output", func() {
 		// given
 		root := main.NewRootCmd()
@@ -31,6 +45,7 @@ var _ = Describe("root cmd", func() {
 		buf := new(bytes.Buffer)
 		root.SetOutput(buf)
 		root.SetArgs([]string{"test/test.adoc"})
+		defer os.Remove("test/test.html")
 		// when
 		err := root.Execute()
 		// then
@@ -96,7 +111,7 @@ var _ = Describe("root cmd", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(buf.String()).ToNot(BeEmpty())
 		// console output also includes a warning message
-		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo2'"
+		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo2'" position=test/doc_with_attributes.adoc
 <div class="paragraph">
 <p>bar1 and {foo2}</p>
 </div>`))
@@ -107,11 +122,44 @@ var _ = Describe("root cmd", func() {
 		root := main.NewRootCmd()
 		buf := new(bytes.Buffer)
 		root.SetOutput(buf)
-		root.SetArgs([]string{"-s", "test/admonition.adoc", "test/test.adoc"})
+		root.SetArgs([]string{"-s", "-D", dir, "test/admonition.adoc", "test/test.adoc"})
 		// when
 		err := root.Execute()
 		// then
 		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(dir, "admonition.html")).To(BeARegularFile())
+		Expect(filepath.Join(dir, "test.html")).To(BeARegularFile())
+	})
+
+	It("render multiple files concurrently", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-j", "2", "-D", dir, "test/admonition.adoc", "test/test.adoc", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(dir, "admonition.html")).To(BeARegularFile())
+		Expect(filepath.Join(dir, "test.html")).To(BeARegularFile())
+		Expect(filepath.Join(dir, "doc_with_attributes.html")).To(BeARegularFile())
+	})
+
+	It("when rendering multiple files concurrently, return summary of failures", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-j", "2", "-D", dir, "test/doesnotexist.adoc", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("failed to convert 1 of 2 file(s):\n- 'test/doesnotexist.adoc': "))
+		// no empty output file for the missing source
+		Expect(filepath.Join(dir, "doesnotexist.html")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(dir, "test.html")).To(BeARegularFile())
 	})
 
 	It("when rendering multiple files, return last error", func() {
@@ -119,13 +167,77 @@ var _ = Describe("root cmd", func() {
 		root := main.NewRootCmd()
 		buf := new(bytes.Buffer)
 		root.SetOutput(buf)
-		root.SetArgs([]string{"-s", "test/doesnotexist.adoc", "test/test.adoc"})
+		root.SetArgs([]string{"-s", "-o", filepath.Join(dir, "out.html"), "test/doesnotexist.adoc", "test/test.adoc"})
 		// when
 		err := root.Execute()
 		// then
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("render with epub3 backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "epub3", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("PK"))
+		Expect(buf.String()).To(ContainSubstring("mimetypeapplication/epub+zip"))
+	})
+
+	It("render with slides backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "slides", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<div class="reveal">`))
+	})
+
+	It("render with latex backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "latex", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix(`\documentclass{article}`))
+	})
+
+	It("fail to render with unknown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "docbook", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fail to render with unknown safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--safe-mode", "paranoid", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("unknown safe mode: 'paranoid'"))
+	})
+
 	It("show help when executed with no arg", func() {
 		// given
 		root := main.NewRootCmd()
@@ -138,4 +250,151 @@ var _ = Describe("root cmd", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("render with timings and profiles", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc-profiles")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		cpuProfile := filepath.Join(dir, "cpu.prof")
+		memProfile := filepath.Join(dir, "mem.prof")
+		root.SetArgs([]string{"--timings", "--cpuprofile", cpuProfile, "--memprofile", memProfile, "-o", filepath.Join(dir, "test.html"), "test/test.adoc"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("timings of 'test/test.adoc':\nPHASE "))
+		for _, phase := range []string{"preprocessing", "parsing", "substitution", "rearranging", "tree processing", "validation", "rendering", "total"} {
+			Expect(buf.String()).To(MatchRegexp(`(?m)^` + phase + ` +[0-9.]+[nµm]?s +\d+ +\d+$`))
+		}
+		Expect(cpuProfile).To(BeARegularFile())
+		Expect(memProfile).To(BeARegularFile())
+	})
+
+	Context("with project file", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "libasciidoc-project")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Mkdir(filepath.Join(dir, "docs"), 0755)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		writeFile := func(name, content string) string {
+			p := filepath.Join(dir, name)
+			Expect(os.MkdirAll(filepath.Dir(p), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+			return p
+		}
+
+		execute := func(args ...string) (string, error) {
+			root := main.NewRootCmd()
+			buf := new(bytes.Buffer)
+			root.SetOutput(buf)
+			root.SetArgs(args)
+			err := root.Execute()
+			return buf.String(), err
+		}
+
+		It("render with settings of project file in parent directory", func() {
+			writeFile(".libasciidoc.yml", `attributes:
+  foo1: bar1
+  foo2: bar2
+css: /styles/main.css
+`)
+			source := writeFile("docs/doc.adoc", "{foo1} and {foo2}")
+			output, err := execute("-o", "-", source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(ContainSubstring(`<link type="text/css" rel="stylesheet" href="/styles/main.css">`))
+			Expect(output).To(ContainSubstring(`<p>bar1 and bar2</p>`))
+		})
+
+		It("render with flags overriding project file", func() {
+			writeFile(".libasciidoc.yml", `attributes:
+  foo1: bar1
+  foo2: bar2
+backend: latex
+`)
+			source := writeFile("docs/doc.adoc", "{foo1} and {foo2}")
+			output, err := execute("-s", "-b", "html5", "-afoo2=baz2", "-o", "-", source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(Equal(`<div class="paragraph">
+<p>bar1 and baz2</p>
+</div>`))
+		})
+
+		It("render input files of project file in destination directory", func() {
+			writeFile(".libasciidoc.yml", `destination-dir: build
+inputs:
+- docs/*.adoc
+`)
+			writeFile("docs/a.adoc", "a paragraph")
+			writeFile("docs/b.adoc", "another paragraph")
+			wd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Chdir(filepath.Join(dir, "docs"))).To(Succeed())
+			defer os.Chdir(wd) // nolint: errcheck
+			_, err = execute()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filepath.Join(dir, "build", "a.html")).To(BeARegularFile())
+			Expect(filepath.Join(dir, "build", "b.html")).To(BeARegularFile())
+			Expect(filepath.Join(dir, "docs", "a.html")).ToNot(BeAnExistingFile())
+		})
+
+		It("render input files with same name in destination directory", func() {
+			writeFile(".libasciidoc.yml", `destination-dir: build
+inputs:
+- docs/*/index.adoc
+`)
+			writeFile("docs/a/index.adoc", "a paragraph")
+			writeFile("docs/b/index.adoc", "another paragraph")
+			wd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Chdir(filepath.Join(dir, "docs"))).To(Succeed())
+			defer os.Chdir(wd) // nolint: errcheck
+			_, err = execute("--jobs", "2")
+			Expect(err).ToNot(HaveOccurred())
+			content, err := ioutil.ReadFile(filepath.Join(dir, "build", "a", "index.html"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("<p>a paragraph</p>"))
+			content, err = ioutil.ReadFile(filepath.Join(dir, "build", "b", "index.html"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("<p>another paragraph</p>"))
+			Expect(filepath.Join(dir, "build", "index.html")).ToNot(BeAnExistingFile())
+		})
+
+		It("fail when input files would be converted into same output file", func() {
+			a := writeFile("docs/doc.adoc", "a paragraph")
+			b := writeFile("docs/doc.asciidoc", "another paragraph")
+			_, err := execute("-D", filepath.Join(dir, "build"), a, b)
+			Expect(err).To(MatchError("'" + a + "' and '" + b + "' would both be converted into '" + filepath.Join(dir, "build", "doc.html") + "'"))
+			Expect(filepath.Join(dir, "build")).ToNot(BeAnExistingFile())
+		})
+
+		It("fail with unknown rule in project file", func() {
+			writeFile(".libasciidoc.yml", `rules:
+  unknown: error
+`)
+			source := writeFile("docs/doc.adoc", "a paragraph")
+			_, err := execute("-o", "-", source)
+			Expect(err).To(MatchError("invalid project file '" + filepath.Join(dir, ".libasciidoc.yml") + "': unknown rule: 'unknown'"))
+		})
+
+		It("fail with unknown setting in project file", func() {
+			writeFile(".libasciidoc.yml", `stylesheet: main.css
+`)
+			source := writeFile("docs/doc.adoc", "a paragraph")
+			_, err := execute("-o", "-", source)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("unable to parse project file '" + filepath.Join(dir, ".libasciidoc.yml") + "'"))
+		})
+	})
 })
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewServeCmd returns the command which serves a live preview of the documents in a directory
+func NewServeCmd() *cobra.Command {
+	var addr string
+	var css string
+	var attributes []string
+	var safeMode string
+	cmd := &cobra.Command{
+		Use:   "serve [flags] [DIR]",
+		Short: "Serve a live preview of the documents in the directory (default: the current directory)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := "."
+			if len(args) > 0 {
+				dir = args[0]
+			}
+			if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+				return errors.Errorf("'%s' is not a directory", dir)
+			}
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			server := &http.Server{
+				Addr:    addr,
+				Handler: newPreviewHandler(dir, css, parseAttributes(attributes), mode, 250*time.Millisecond),
+				// close the pending event streams when the server is stopped
+				BaseContext: func(net.Listener) context.Context {
+					return ctx
+				},
+			}
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := server.Shutdown(shutdownCtx); err != nil {
+					log.WithError(err).Error("failed to shutdown the server")
+				}
+			}()
+			fmt.Fprintf(cmd.OutOrStdout(), "serving '%s' on http://%s\n", dir, addr)
+			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.SilenceUsage = true
+	flags := cmd.Flags()
+	flags.StringVar(&addr, "addr", "localhost:8080", "the address on which the server listens")
+	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the documents")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "safe", "the safe mode [unsafe|safe|server|secure] (files outside of the directory cannot be included unless 'unsafe')")
+	return cmd
+}
+
+// the path of the endpoint which notifies the pages when they need to be reloaded
+const eventsPath = "/_libasciidoc/events"
+
+// the script injected in the rendered documents, which reloads the page when the endpoint sends a `reload` event
+const reloadScript = `<script>
+(function() {
+  var events = new EventSource("` + eventsPath + `?path=" + encodeURIComponent(window.location.pathname));
+  events.onmessage = function(e) {
+    if (e.data === "reload") {
+      events.close();
+      window.location.reload();
+    }
+  };
+})();
+</script>`
+
+// previewHandler renders the Asciidoc files of a directory in HTML, serves the other files as-is
+// and notifies the pages when the files they were rendered from changed
+type previewHandler struct {
+	dir      string
+	css      string
+	attrs    map[string]string
+	mode     configuration.SafeMode
+	interval time.Duration
+	files    http.Handler
+	mu       sync.Mutex
+	// the state of the files on which each page depends when the page was rendered, indexed by the page path
+	dependencies map[string]map[string]snapshot
+}
+
+func newPreviewHandler(dir, css string, attrs map[string]string, mode configuration.SafeMode, interval time.Duration) *previewHandler {
+	return &previewHandler{
+		dir:          dir,
+		css:          css,
+		attrs:        attrs,
+		mode:         mode,
+		interval:     interval,
+		files:        http.FileServer(http.Dir(dir)),
+		dependencies: map[string]map[string]snapshot{},
+	}
+}
+
+func (h *previewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p := path.Clean("/" + r.URL.Path)
+	switch {
+	case p == eventsPath:
+		h.serveEvents(w, r)
+	case p == "/" && h.exists("/index.adoc"):
+		h.serveDocument(w, "/index.adoc")
+	case strings.HasSuffix(p, ".adoc") && h.exists(p):
+		h.serveDocument(w, p)
+	default:
+		h.files.ServeHTTP(w, r)
+	}
+}
+
+// localPath returns the path of the file in the served directory. The given path must be clean and absolute
+// (ie, it cannot reference a file outside of the directory)
+func (h *previewHandler) localPath(p string) string {
+	return filepath.Join(h.dir, filepath.FromSlash(p))
+}
+
+func (h *previewHandler) exists(p string) bool {
+	info, err := os.Stat(h.localPath(p))
+	return err == nil && !info.IsDir()
+}
+
+func (h *previewHandler) serveDocument(w http.ResponseWriter, p string) {
+	sourcePath := h.localPath(p)
+	abs, _ := filepath.Abs(sourcePath)
+	output := bytes.NewBuffer(nil)
+	includes, err := h.render(sourcePath, output)
+	deps := append([]string{abs}, includes...)
+	if h.css != "" {
+		deps = append(deps, cssFile(h.localPath(path.Clean("/"+h.css)))...)
+	}
+	deps = append(deps, docinfoFiles(sourcePath)...)
+	snapshots := make(map[string]snapshot, len(deps))
+	for _, d := range deps {
+		snapshots[d] = stat(d)
+	}
+	h.mu.Lock()
+	h.dependencies[p] = snapshots
+	h.mu.Unlock()
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err != nil {
+		log.WithError(err).Errorf("failed to render '%s'", sourcePath)
+		// display the error in an overlay, and keep the reload script so that the page is refreshed once the error is fixed
+		fmt.Fprintf(w, `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<title>Error in %[1]s</title>
+</head>
+<body>
+<div id="libasciidoc-error" style="position:fixed;top:0;left:0;right:0;bottom:0;overflow:auto;padding:2em;background:rgba(0,0,0,0.85);color:#ff6b6b;font-family:monospace">
+<h1>Unable to render %[1]s</h1>
+<pre>%[2]s</pre>
+</div>
+%[3]s
+</body>
+</html>`, html.EscapeString(p), html.EscapeString(err.Error()), reloadScript)
+		return
+	}
+	w.Write(injectScript(output.Bytes())) // nolint: errcheck
+}
+
+// render renders the given document in the given output, and returns the paths of the included files
+func (h *previewHandler) render(sourcePath string, output *bytes.Buffer) ([]string, error) {
+	f, err := os.Open(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	config := configuration.NewConfiguration(
+		configuration.WithFilename(sourcePath),
+		configuration.WithAttributes(h.attrs),
+		configuration.WithCSS(h.css),
+		configuration.WithSafeMode(h.mode),
+		// the documents can include the files of the served directory, but not the files outside of it
+		configuration.WithBaseDir(h.dir),
+		configuration.WithHeaderFooter(true))
+	resolver := trackIncludes(&config)
+	_, err = libasciidoc.ConvertToHTML(f, output, config)
+	return resolver.resolvedPaths(), err
+}
+
+// injectScript inserts the reload script before the closing `body` tag of the given page (or at the end of the page)
+func injectScript(page []byte) []byte {
+	i := bytes.LastIndex(page, []byte("</body>"))
+	if i == -1 {
+		return append(page, []byte(reloadScript)...)
+	}
+	result := make([]byte, 0, len(page)+len(reloadScript)+1)
+	result = append(result, page[:i]...)
+	result = append(result, []byte(reloadScript+"\n")...)
+	return append(result, page[i:]...)
+}
+
+// serveEvents sends a `reload` server-sent event when one of the files of the page given in the `path` query parameter
+// changed since the page was rendered
+func (h *previewHandler) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	p := path.Clean("/" + r.URL.Query().Get("path"))
+	if p == "/" {
+		p = "/index.adoc"
+	}
+	h.mu.Lock()
+	snapshots := h.dependencies[p]
+	h.mu.Unlock()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			for d, previous := range snapshots {
+				if stat(d) != previous {
+					log.Debugf("'%s' changed, reloading '%s'", d, p)
+					fmt.Fprint(w, "data: reload\n\n")
+					flusher.Flush()
+					return
+				}
+			}
+		}
+	}
+}
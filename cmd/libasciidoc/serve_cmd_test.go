@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("serve cmd", func() {
+
+	var dir string
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-serve")
+		Expect(err).ToNot(HaveOccurred())
+		server = httptest.NewServer(newPreviewHandler(dir, "", map[string]string{}, configuration.Safe, 10*time.Millisecond))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) {
+		p := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+		t := time.Now().Add(time.Duration(len(content)) * time.Second)
+		Expect(os.Chtimes(p, t, t)).To(Succeed())
+	}
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, string(body)
+	}
+
+	It("should render document with reload script", func() {
+		writeFile("doc.adoc", "include::chapter.adoc[]")
+		writeFile("chapter.adoc", "hello, world")
+		status, body := get("/doc.adoc")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(ContainSubstring(`<div class="paragraph">
+<p>hello, world</p>
+</div>`))
+		Expect(body).To(MatchRegexp(`(?s)new EventSource\(.*</script>\n</body>`))
+	})
+
+	It("should render index document", func() {
+		writeFile("index.adoc", "welcome")
+		status, body := get("/")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(ContainSubstring(`<p>welcome</p>`))
+	})
+
+	It("should serve static files", func() {
+		writeFile("style.css", "body {}")
+		status, body := get("/style.css")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("body {}"))
+	})
+
+	It("should not serve files outside of the directory", func() {
+		status, _ := get("/../../etc/passwd")
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+
+	It("should refuse to include file outside of the directory", func() {
+		outside, err := ioutil.TempDir("", "libasciidoc-outside")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(outside)
+		secret := filepath.Join(outside, "secret.adoc")
+		Expect(ioutil.WriteFile(secret, []byte("top secret"), 0644)).To(Succeed())
+		rel, err := filepath.Rel(dir, secret)
+		Expect(err).ToNot(HaveOccurred())
+		writeFile("doc.adoc", "include::"+rel+"[]\n\ninclude::"+secret+"[]")
+		status, body := get("/doc.adoc")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(ContainSubstring("doc.adoc - include::" + rel + "[]"))
+		Expect(body).To(ContainSubstring("doc.adoc - include::" + secret + "[]"))
+		Expect(body).ToNot(ContainSubstring("top secret"))
+	})
+
+	It("should include file from parent directory within the directory", func() {
+		Expect(os.Mkdir(filepath.Join(dir, "sub"), 0755)).To(Succeed())
+		writeFile("sub/doc.adoc", "include::../chapter.adoc[]")
+		writeFile("chapter.adoc", "hello, world")
+		status, body := get("/sub/doc.adoc")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(ContainSubstring(`<p>hello, world</p>`))
+	})
+
+	It("should display error in overlay", func() {
+		writeFile("invalid.adoc", "hello \xff world")
+		status, body := get("/invalid.adoc")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(ContainSubstring(`<div id="libasciidoc-error"`))
+		Expect(body).To(ContainSubstring(`invalid encoding`))
+		Expect(body).To(ContainSubstring(`new EventSource(`))
+	})
+
+	It("should notify page when included file changes", func() {
+		writeFile("doc.adoc", "include::chapter.adoc[]")
+		writeFile("chapter.adoc", "hello, world")
+		get("/doc.adoc")
+		resp, err := http.Get(server.URL + eventsPath + "?path=/doc.adoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+		events := make(chan string)
+		go func() {
+			defer GinkgoRecover()
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				if scanner.Text() != "" {
+					events <- scanner.Text()
+				}
+			}
+		}()
+		Consistently(events, 100*time.Millisecond).ShouldNot(Receive())
+		// when
+		writeFile("chapter.adoc", "hello, gophers!")
+		// then
+		Eventually(events).Should(Receive(Equal("data: reload")))
+	})
+})
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// timingsReporter writes the timings of the conversion of each file, one file at a time (in case the files are converted concurrently)
+type timingsReporter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// report writes the timings of the phases of the conversion of the given file in a table, followed by their total
+// (the included files are listed separately, since they are parsed during the `parsing` phase)
+func (r *timingsReporter) report(sourcePath string, timings []types.Timing) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	w := tabwriter.NewWriter(r.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "timings of '%s':\n", sourcePath)
+	fmt.Fprintln(w, "PHASE\tDURATION\tALLOCS\tBYTES")
+	var total time.Duration
+	var allocs, bytes uint64
+	for _, t := range timings {
+		if t.Phase == types.IncludePhase {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%v\t%d\t%d\n", t.Phase, t.Duration, t.Allocs, t.AllocBytes)
+		total += t.Duration
+		allocs += t.Allocs
+		bytes += t.AllocBytes
+	}
+	fmt.Fprintf(w, "total\t%v\t%d\t%d\n", total, allocs, bytes)
+	for _, t := range timings {
+		if t.Phase == types.IncludePhase {
+			fmt.Fprintf(w, "%s %s\t%v\t%d\t%d\n", t.Phase, t.Path, t.Duration, t.Allocs, t.AllocBytes)
+		}
+	}
+	return w.Flush()
+}
+
+// startProfiling starts the CPU profiling if `cpuProfile` is not empty, and returns a function which stops it
+// and writes the heap profile if `memProfile` is not empty
+func startProfiling(cpuProfile, memProfile string) (func() error, error) {
+	var cpuFile *os.File
+	if cpuProfile != "" {
+		var err error
+		if cpuFile, err = os.Create(cpuProfile); err != nil {
+			return nil, errors.Wrapf(err, "cannot create CPU profile '%s'", cpuProfile)
+		}
+		if err := pprof.StartCPUProfile(cpuFile); err != nil {
+			cpuFile.Close()
+			return nil, errors.Wrap(err, "cannot start CPU profiling")
+		}
+	}
+	return func() error {
+		if cpuFile != nil {
+			pprof.StopCPUProfile()
+			if err := cpuFile.Close(); err != nil {
+				return errors.Wrapf(err, "cannot write CPU profile '%s'", cpuProfile)
+			}
+		}
+		if memProfile != "" {
+			memFile, err := os.Create(memProfile)
+			if err != nil {
+				return errors.Wrapf(err, "cannot create heap profile '%s'", memProfile)
+			}
+			defer memFile.Close()
+			// get up-to-date statistics
+			runtime.GC()
+			if err := pprof.WriteHeapProfile(memFile); err != nil {
+				return errors.Wrapf(err, "cannot write heap profile '%s'", memProfile)
+			}
+		}
+		return nil
+	}, nil
+}
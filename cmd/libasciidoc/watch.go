@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// convertFunc converts the given source file and returns the paths of all the local files
+// it depends on (ie, the file itself, the included files, etc.)
+type convertFunc func(sourcePath string) ([]string, error)
+
+// watch converts all the given source files, then polls their dependencies at the given interval and
+// converts again the sources whose dependencies changed. Changes are debounced, ie, the sources are converted
+// once no more change was detected during the `debounce` delay. Conversion errors are logged, but they do not
+// stop the watch, which runs until the given context is done.
+func watch(ctx context.Context, sources []string, convert convertFunc, interval, debounce time.Duration) error {
+	w := &watcher{
+		convert:      convert,
+		dependencies: map[string][]string{},
+		snapshots:    map[string]snapshot{},
+	}
+	for _, s := range sources {
+		w.build(s)
+	}
+	log.Infof("watching %d file(s) for changes...", len(w.snapshots))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	changes := map[string]bool{}
+	var lastChange time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stopped watching for changes")
+			return nil
+		case now := <-ticker.C:
+			if changed := w.changes(); len(changed) > 0 {
+				for _, f := range changed {
+					changes[f] = true
+				}
+				lastChange = now
+				continue
+			}
+			if len(changes) == 0 || now.Sub(lastChange) < debounce {
+				continue
+			}
+			for _, s := range sources {
+				if w.dependsOn(s, changes) {
+					log.Infof("converting '%s' again after changes", s)
+					w.build(s)
+				}
+			}
+			changes = map[string]bool{}
+		}
+	}
+}
+
+type watcher struct {
+	convert convertFunc
+	// the dependencies of each source file
+	dependencies map[string][]string
+	// the last known state of each dependency
+	snapshots map[string]snapshot
+}
+
+// snapshot the state of a file when it was last checked. A missing file has a zero snapshot.
+type snapshot struct {
+	modTime time.Time
+	size    int64
+}
+
+func stat(path string) snapshot {
+	info, err := os.Stat(path)
+	if err != nil {
+		return snapshot{}
+	}
+	return snapshot{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+// build converts the given source and records its dependencies. Errors are reported, but not returned.
+func (w *watcher) build(source string) {
+	deps, err := w.convert(source)
+	if err != nil {
+		log.WithError(err).Errorf("failed to convert '%s'", source)
+	}
+	if abs, err := filepath.Abs(source); err == nil {
+		deps = append(deps, abs) // make sure the source itself is always watched, even if it could not be converted
+	}
+	w.dependencies[source] = deps
+	for _, d := range deps {
+		w.snapshots[d] = stat(d)
+	}
+}
+
+// changes returns the dependencies which changed since the last call, and updates their snapshots
+func (w *watcher) changes() []string {
+	result := []string{}
+	for path, previous := range w.snapshots {
+		if current := stat(path); current != previous {
+			log.Debugf("detected change in '%s'", path)
+			w.snapshots[path] = current
+			result = append(result, path)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (w *watcher) dependsOn(source string, changes map[string]bool) bool {
+	for _, d := range w.dependencies[source] {
+		if changes[d] {
+			return true
+		}
+	}
+	return false
+}
+
+// trackingIncludeResolver an include resolver which records the paths of the resolved files
+// (including those which could not be read, so that their creation is detected)
+type trackingIncludeResolver struct {
+	delegate configuration.IncludeResolver
+	mu       sync.Mutex
+	paths    []string
+}
+
+func (r *trackingIncludeResolver) Resolve(ctx context.Context, target string, attributes types.ElementAttributes, includingFile string) (io.ReadCloser, string, error) {
+	f, path, err := r.delegate.Resolve(ctx, target, attributes, includingFile)
+	if path != "" {
+		r.mu.Lock()
+		r.paths = append(r.paths, path)
+		r.mu.Unlock()
+	}
+	return f, path, err
+}
+
+// trackIncludes wraps the include resolver of the given configuration (ie, the one set in the settings of the
+// configuration, or the default one) with a resolver which records the paths of the resolved files
+func trackIncludes(config *configuration.Configuration) *trackingIncludeResolver {
+	resolver := &trackingIncludeResolver{
+		delegate: config.IncludeResolver(),
+	}
+	configuration.WithIncludeResolver(resolver)(config)
+	return resolver
+}
+
+func (r *trackingIncludeResolver) resolvedPaths() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.paths...)
+}
+
+// docinfoFiles returns the docinfo files which exist next to the given source file
+// (`docinfo.html`, `docinfo-footer.html`, `<docname>-docinfo.html` and `<docname>-docinfo-footer.html`)
+func docinfoFiles(sourcePath string) []string {
+	dir := filepath.Dir(sourcePath)
+	name := strings.TrimSuffix(filepath.Base(sourcePath), filepath.Ext(sourcePath))
+	result := []string{}
+	for _, f := range []string{"docinfo.html", "docinfo-footer.html", name + "-docinfo.html", name + "-docinfo-footer.html"} {
+		p, err := filepath.Abs(filepath.Join(dir, f))
+		if err != nil {
+			continue
+		}
+		if _, err := os.Stat(p); err == nil {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// cssFile returns the absolute path of the given CSS file if it exists on the local filesystem
+// (ie, if it is not a remote stylesheet)
+func cssFile(css string) []string {
+	if css == "" || strings.Contains(css, "://") {
+		return nil
+	}
+	p, err := filepath.Abs(css)
+	if err != nil {
+		return nil
+	}
+	if _, err := os.Stat(p); err != nil {
+		return nil
+	}
+	return []string{p}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing/fstest"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("watch", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-watch")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// writeFile writes the given content in the file, and changes its modification time to make sure the change is detected
+	writeFile := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+		t := time.Now().Add(time.Duration(len(content)) * time.Second)
+		Expect(os.Chtimes(p, t, t)).To(Succeed())
+		return p
+	}
+
+	// conversions records the conversions of each source
+	type conversions struct {
+		sync.Mutex
+		count map[string]int
+	}
+
+	startWatch := func(sources []string, convert convertFunc) (context.CancelFunc, chan error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- watch(ctx, sources, convert, 10*time.Millisecond, 100*time.Millisecond)
+		}()
+		return cancel, done
+	}
+
+	It("should convert only the sources whose dependencies changed", func() {
+		a := writeFile("a.adoc", "a")
+		b := writeFile("b.adoc", "b")
+		shared := writeFile("shared.adoc", "shared")
+		c := conversions{count: map[string]int{}}
+		cancel, done := startWatch([]string{a, b}, func(source string) ([]string, error) {
+			c.Lock()
+			defer c.Unlock()
+			c.count[source]++
+			if source == a {
+				return []string{a, shared}, nil
+			}
+			return []string{b}, nil
+		})
+		count := func(source string) func() int {
+			return func() int {
+				c.Lock()
+				defer c.Unlock()
+				return c.count[source]
+			}
+		}
+		Eventually(count(a)).Should(Equal(1))
+		Eventually(count(b)).Should(Equal(1))
+		// when
+		writeFile("shared.adoc", "shared content")
+		// then
+		Eventually(count(a)).Should(Equal(2))
+		Consistently(count(b), 300*time.Millisecond).Should(Equal(1))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should debounce changes", func() {
+		a := writeFile("a.adoc", "a")
+		c := conversions{count: map[string]int{}}
+		cancel, done := startWatch([]string{a}, func(source string) ([]string, error) {
+			c.Lock()
+			defer c.Unlock()
+			c.count[source]++
+			return []string{a}, nil
+		})
+		count := func() int {
+			c.Lock()
+			defer c.Unlock()
+			return c.count[a]
+		}
+		Eventually(count).Should(Equal(1))
+		// when
+		for i := 0; i < 5; i++ {
+			writeFile("a.adoc", strings.Repeat("a", i+2))
+			time.Sleep(20 * time.Millisecond)
+		}
+		// then
+		Eventually(count).Should(Equal(2))
+		Consistently(count, 300*time.Millisecond).Should(Equal(2))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should keep watching after an error", func() {
+		a := writeFile("a.adoc", "a")
+		c := conversions{count: map[string]int{}}
+		cancel, done := startWatch([]string{a}, func(source string) ([]string, error) {
+			c.Lock()
+			defer c.Unlock()
+			c.count[source]++
+			return nil, fmt.Errorf("mock error")
+		})
+		count := func() int {
+			c.Lock()
+			defer c.Unlock()
+			return c.count[a]
+		}
+		Eventually(count).Should(Equal(1))
+		// when
+		writeFile("a.adoc", "a changed")
+		// then the source itself is still watched
+		Eventually(count).Should(Equal(2))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should track the included files", func() {
+		writeFile("chapter.adoc", "chapter")
+		main := writeFile("main.adoc", "include::chapter.adoc[]\n\ninclude::missing.adoc[]")
+		resolver := &trackingIncludeResolver{
+			delegate: configuration.OSIncludeResolver{},
+		}
+		_, err := libasciidoc.ConvertFileToHTML(ioutil.Discard, configuration.NewConfiguration(
+			configuration.WithFilename(main),
+			configuration.WithIncludeResolver(resolver)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolver.resolvedPaths()).To(Equal([]string{
+			filepath.Join(dir, "chapter.adoc"),
+			filepath.Join(dir, "missing.adoc"),
+		}))
+	})
+
+	It("should track the included files with the include resolver of the configuration", func() {
+		config := configuration.NewConfiguration(
+			configuration.WithFilename("main.adoc"),
+			configuration.WithFS(fstest.MapFS{
+				"chapter.adoc": {Data: []byte("a chapter")},
+			}))
+		resolver := trackIncludes(&config)
+		output := &strings.Builder{}
+		_, err := libasciidoc.ConvertToHTML(strings.NewReader("include::chapter.adoc[]"), output, config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.String()).To(ContainSubstring("a chapter"))
+		Expect(resolver.resolvedPaths()).To(Equal([]string{"chapter.adoc"}))
+	})
+
+	It("should track the docinfo and CSS files", func() {
+		main := writeFile("main.adoc", "content")
+		docinfo := writeFile("main-docinfo.html", "<meta>")
+		css := writeFile("style.css", "body {}")
+		Expect(docinfoFiles(main)).To(Equal([]string{docinfo}))
+		Expect(cssFile(css)).To(Equal([]string{css}))
+		Expect(cssFile("https://example.com/style.css")).To(BeEmpty())
+	})
+})
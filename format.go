@@ -0,0 +1,135 @@
+package libasciidoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Format converts the content of the given reader `r` into "canonical" Asciidoc, written in the given writer `output`.
+// Comments, document attribute declarations and file inclusions are retained.
+// Returns an error if a problem occurred, including when the formatted content would not be parsed into the same
+// document as the source content (in which case nothing is written in the `output`)
+func Format(r io.Reader, output io.Writer, config configuration.Configuration) error {
+	source, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, "unable to format document")
+	}
+	draftDoc, err := parser.ParseRawDocument(bytes.NewReader(source), config)
+	if err != nil {
+		return errors.Wrap(err, "unable to format document")
+	}
+	result := bytes.NewBuffer(nil)
+	if err := asciidoc.Render(draftDoc, result); err != nil {
+		return errors.Wrap(err, "unable to format document")
+	}
+	// verify that the formatted content is equivalent to the source
+	expected, err := parser.ParseDocument(bytes.NewReader(source), config)
+	if err != nil {
+		return errors.Wrap(err, "unable to format document")
+	}
+	// the diagnostics were already reported when parsing the source content (and their positions
+	// in the formatted content would not match the source file)
+	silentConfig := config.Clone()
+	configuration.WithDiagnosticHandler(func(types.Diagnostic) {})(&silentConfig)
+	actual, err := parser.ParseDocument(bytes.NewReader(result.Bytes()), silentConfig)
+	if err != nil {
+		return errors.Wrap(err, "unable to format document")
+	}
+	if equivalent, err := equivalentDocuments(expected, actual); err != nil {
+		return errors.Wrap(err, "unable to format document")
+	} else if !equivalent {
+		if log.IsLevelEnabled(log.DebugLevel) {
+			log.Debugf("formatted document:\n%s", result.String())
+		}
+		return errors.New("unable to format document: the formatted content does not match the source content")
+	}
+	_, err = output.Write(result.Bytes())
+	return err
+}
+
+// equivalentDocuments returns `true` if both documents have the same AST, regardless of the spaces
+// around the content of the table cells
+func equivalentDocuments(doc1, doc2 types.Document) (bool, error) {
+	ast1, err := normalizedAST(doc1)
+	if err != nil {
+		return false, err
+	}
+	ast2, err := normalizedAST(doc2)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(ast1, ast2), nil
+}
+
+// normalizedAST returns the AST of the given document in a generic form (ie, as decoded from JSON), in which
+// the content of the table cells is trimmed
+func normalizedAST(doc types.Document) (interface{}, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := ast.ExportJSON(doc, buf); err != nil {
+		return nil, err
+	}
+	var result interface{}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		return nil, err
+	}
+	trimTableCells(result)
+	return result, nil
+}
+
+func trimTableCells(node interface{}) {
+	switch node := node.(type) {
+	case map[string]interface{}:
+		if node["type"] == "TableLine" {
+			cells, _ := node["cells"].([]interface{})
+			for i, cell := range cells {
+				if elements, ok := cell.([]interface{}); ok {
+					cells[i] = trimCell(elements)
+				}
+			}
+		}
+		for _, v := range node {
+			trimTableCells(v)
+		}
+	case []interface{}:
+		for _, v := range node {
+			trimTableCells(v)
+		}
+	}
+}
+
+// trimCell trims the spaces at the beginning and at the end of the cell content, and discards
+// the elements which are empty once trimmed
+func trimCell(elements []interface{}) []interface{} {
+	for len(elements) > 0 && trimContent(elements[0], strings.TrimLeft) == "" {
+		elements = elements[1:]
+	}
+	for len(elements) > 0 && trimContent(elements[len(elements)-1], strings.TrimRight) == "" {
+		elements = elements[:len(elements)-1]
+	}
+	return elements
+}
+
+// trimContent trims the content of the given node if it is a string element, and returns
+// the trimmed content (or a non-empty placeholder if the node is not a string element)
+func trimContent(node interface{}, trim func(string, string) string) string {
+	if e, ok := node.(map[string]interface{}); ok && e["type"] == "StringElement" {
+		if content, ok := e["content"].(string); ok {
+			e["content"] = trim(content, " \t")
+			return e["content"].(string)
+		}
+	}
+	return "-"
+}
@@ -1,6 +1,6 @@
 module github.com/bytesparadise/libasciidoc
 
-go 1.11
+go 1.16
 
 require (
 	github.com/alecthomas/chroma v0.7.1
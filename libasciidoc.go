@@ -1,16 +1,23 @@
 // Package libasciidoc is an open source Go library that converts Asciidoc
-// content into HTML.
+// content into HTML, reveal.js slides, EPUB3 or LaTeX.
 package libasciidoc
 
 import (
+	"bytes"
+	"context"
 	"io"
+	"io/ioutil"
 	"os"
 	"time"
 
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/epub3"
 	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/latex"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/slides"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/bytesparadise/libasciidoc/pkg/validator"
 	"github.com/pkg/errors"
@@ -31,6 +38,108 @@ var (
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.
 func ConvertFileToHTML(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertFileToHTMLContext(context.Background(), output, config)
+}
+
+// ConvertFileToHTMLContext converts the content of the given filename into an HTML document, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertFileToHTML`).
+func ConvertFileToHTMLContext(ctx context.Context, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convertFile(ctx, output, config, ConvertToHTMLContext)
+}
+
+// ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
+// Returns an error if a problem occurred
+func ConvertToHTML(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertToHTMLContext(context.Background(), r, output, config)
+}
+
+// ConvertToHTMLContext converts the content of the given reader `r` into a full HTML document, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertToHTML`).
+func ConvertToHTMLContext(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convert(ctx, r, output, config, "HTML", htmlrenderer.Render)
+}
+
+// ConvertFileToSlides converts the content of the given filename into a reveal.js slide deck.
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
+// as the result of the function call.
+func ConvertFileToSlides(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertFileToSlidesContext(context.Background(), output, config)
+}
+
+// ConvertFileToSlidesContext converts the content of the given filename into a reveal.js slide deck, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertFileToSlides`).
+func ConvertFileToSlidesContext(ctx context.Context, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convertFile(ctx, output, config, ConvertToSlidesContext)
+}
+
+// ConvertToSlides converts the content of the given reader `r` into a reveal.js slide deck, written in the given writer `output`.
+// Level 1 sections are rendered as slides, and level 2 sections as vertical sub-slides.
+// Returns an error if a problem occurred
+func ConvertToSlides(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertToSlidesContext(context.Background(), r, output, config)
+}
+
+// ConvertToSlidesContext converts the content of the given reader `r` into a reveal.js slide deck, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertToSlides`).
+func ConvertToSlidesContext(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convert(ctx, r, output, config, "slides", slides.Render)
+}
+
+// ConvertFileToEPUB converts the content of the given filename into an EPUB3 publication.
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
+// as the result of the function call.
+func ConvertFileToEPUB(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertFileToEPUBContext(context.Background(), output, config)
+}
+
+// ConvertFileToEPUBContext converts the content of the given filename into an EPUB3 publication, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertFileToEPUB`).
+func ConvertFileToEPUBContext(ctx context.Context, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convertFile(ctx, output, config, ConvertToEPUBContext)
+}
+
+// ConvertToEPUB converts the content of the given reader `r` into an EPUB3 publication (ie, a zip archive), written in the given writer `output`.
+// Images are looked-up relatively to the directory of the `config.Filename`.
+// Returns an error if a problem occurred
+func ConvertToEPUB(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertToEPUBContext(context.Background(), r, output, config)
+}
+
+// ConvertToEPUBContext converts the content of the given reader `r` into an EPUB3 publication, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertToEPUB`).
+func ConvertToEPUBContext(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convert(ctx, r, output, config, "EPUB", epub3.Render)
+}
+
+// ConvertFileToLaTeX converts the content of the given filename into a LaTeX document.
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
+// as the result of the function call.
+func ConvertFileToLaTeX(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertFileToLaTeXContext(context.Background(), output, config)
+}
+
+// ConvertFileToLaTeXContext converts the content of the given filename into a LaTeX document, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertFileToLaTeX`).
+func ConvertFileToLaTeXContext(ctx context.Context, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convertFile(ctx, output, config, ConvertToLaTeXContext)
+}
+
+// ConvertToLaTeX converts the content of the given reader `r` into a LaTeX document, written in the given writer `output`.
+// Returns an error if a problem occurred
+func ConvertToLaTeX(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertToLaTeXContext(context.Background(), r, output, config)
+}
+
+// ConvertToLaTeXContext converts the content of the given reader `r` into a LaTeX document, and stops
+// with the error of the given context if it is done before the end of the conversion (see `ConvertToLaTeX`).
+func ConvertToLaTeXContext(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return convert(ctx, r, output, config, "LaTeX", latex.Render)
+}
+
+type convertFunc func(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error)
+
+// convertFile opens the file to convert, and uses its mtime as the `last updated` value
+func convertFile(ctx context.Context, output io.Writer, config configuration.Configuration, convert convertFunc) (types.Metadata, error) {
 	file, err := os.Open(config.Filename)
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
@@ -42,38 +151,175 @@ func ConvertFileToHTML(output io.Writer, config configuration.Configuration) (ty
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	config.LastUpdated = stat.ModTime()
-	return ConvertToHTML(file, output, config)
+	return convert(ctx, file, output, config)
 }
 
-// ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
-// Returns an error if a problem occurred
-func ConvertToHTML(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+type renderFunc func(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error)
+
+// convert parses, validates and renders the content of the given reader `r`. Returns the error of the
+// given context (rather than the wrapped error returned by the parser or the renderer) if it is done
+func convert(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration, format string, render renderFunc) (types.Metadata, error) {
 	start := time.Now()
 	defer func() {
 		duration := time.Since(start)
-		log.Debugf("rendered the HTML output in %v", duration)
+		log.Debugf("rendered the %s output in %v", format, duration)
 	}()
+	diagnostics := collectDiagnostics(&config)
+	timings := collectTimings(&config)
+	doc, err := parseAndValidate(ctx, r, config)
+	if err != nil {
+		return types.Metadata{Diagnostics: diagnostics.all, Timings: timings.all}, err
+	}
+	// render
+	rctx := renderer.NewContext(doc, config)
+	rctx.Context = ctx
+	done := config.Measure(types.RenderingPhase, "")
+	// the postprocessors rewrite the whole output, except for the EPUB publications (ie, zip archives)
+	// in which they rewrite each content document
+	out := output
+	rendered := bytes.NewBuffer(nil)
+	postprocess := len(config.Postprocessors()) > 0 && format != "EPUB"
+	if postprocess {
+		out = rendered
+	}
+	metadata, err := render(rctx, doc, out)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return types.Metadata{Diagnostics: diagnostics.all, Timings: timings.all}, err
+	}
+	if postprocess {
+		result, err := postprocessOutput(rendered.Bytes(), config)
+		if err != nil {
+			return types.Metadata{Diagnostics: diagnostics.all, Timings: timings.all}, err
+		}
+		if _, err := output.Write(result); err != nil {
+			return types.Metadata{Diagnostics: diagnostics.all, Timings: timings.all}, errors.Wrapf(err, "unable to write the %s output", format)
+		}
+	}
+	done()
+	log.Debugf("Done processing document")
+	metadata.Diagnostics = diagnostics.all
+	metadata.Timings = timings.all
+	return metadata, nil
+}
+
+// postprocessOutput applies the postprocessors of the given configuration on the given output
+func postprocessOutput(output []byte, config configuration.Configuration) ([]byte, error) {
+	for _, p := range config.Postprocessors() {
+		var err error
+		if output, err = p(output); err != nil {
+			return nil, errors.Wrap(err, "unable to postprocess the document")
+		}
+	}
+	return output, nil
+}
+
+// ExportJSON parses the content of the given reader `r` and writes its AST in JSON in the given writer `output`.
+// See `ast.SchemaVersion` for the description of the JSON schema, and `ast.ImportJSON` to read the AST back.
+// Returns an error if a problem occurred
+func ExportJSON(r io.Reader, output io.Writer, config configuration.Configuration) error {
+	doc, err := parseAndValidate(context.Background(), r, config)
+	if err != nil {
+		return err
+	}
+	return ast.ExportJSON(doc, output)
+}
+
+// ValidateFile parses and validates the content of the given filename, without rendering it.
+// Returns the problems found during the validation, or an error if the document could not be parsed
+func ValidateFile(config configuration.Configuration) ([]validator.Problem, error) {
+	file, err := os.Open(config.Filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s", config.Filename)
+	}
+	defer file.Close()
+	return Validate(file, config)
+}
+
+// Validate parses and validates the content of the given reader `r`, without rendering it.
+// Returns the problems found during the validation, or an error if the document could not be parsed
+func Validate(r io.Reader, config configuration.Configuration) ([]validator.Problem, error) {
+	// keep the source to locate the problems
+	source, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := parser.ParseDocument(bytes.NewReader(source), config)
+	if err != nil {
+		return nil, err
+	}
+	return validator.Validate(&doc, validator.WithConfiguration(config), validator.WithSource(config.Filename, source)), nil
+}
+
+func parseAndValidate(ctx context.Context, r io.Reader, config configuration.Configuration) (types.Document, error) {
 	log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument(r, config) //, parser.Debug(true))
+	// keep the source to locate the problems found during the validation
+	source, err := ioutil.ReadAll(r)
 	if err != nil {
-		return types.Metadata{}, err
+		return types.Document{}, err
+	}
+	doc, err := parser.ParseDocumentContext(ctx, bytes.NewReader(source), config) //, parser.Debug(true))
+	if err != nil {
+		return types.Document{}, err
 	}
 	// validate the document
-	problems := validator.Validate(&doc)
+	done := config.Measure(types.ValidationPhase, "")
+	problems := validator.Validate(&doc, validator.WithConfiguration(config), validator.WithSource(config.Filename, source))
+	done()
 	for _, problem := range problems {
-		switch problem.Severity {
-		case validator.Error:
-			log.Error(problem.Message)
-		case validator.Warning:
-			log.Warn(problem.Message)
+		severity := types.DiagnosticWarning
+		if problem.Severity == validator.Error {
+			severity = types.DiagnosticError
 		}
+		config.Report(severity, types.ValidationCategory, problem.Position, "%s", problem.Message)
 	}
-	// render
-	ctx := renderer.NewContext(doc, config)
-	metadata, err := htmlrenderer.Render(ctx, doc, output)
-	if err != nil {
-		return types.Metadata{}, err
+	return doc, nil
+}
+
+// diagnosticsCollector collects the diagnostics reported during a conversion, before passing them
+// to the handler of the configuration
+type diagnosticsCollector struct {
+	handler configuration.DiagnosticHandler
+	all     []types.Diagnostic
+}
+
+// collectDiagnostics replaces the diagnostic handler of the given configuration with a collector,
+// so the diagnostics can be returned in the metadata of the converted document
+func collectDiagnostics(config *configuration.Configuration) *diagnosticsCollector {
+	c := &diagnosticsCollector{
+		handler: config.DiagnosticHandler(),
 	}
-	log.Debugf("Done processing document")
-	return metadata, nil
+	configuration.WithDiagnosticHandler(c.handle)(config)
+	return c
+}
+
+func (c *diagnosticsCollector) handle(diagnostic types.Diagnostic) {
+	c.all = append(c.all, diagnostic)
+	c.handler(diagnostic)
+}
+
+// timingsCollector collects the timings of the phases of a conversion, before passing them
+// to the handler of the configuration
+type timingsCollector struct {
+	handler configuration.TimingHandler
+	all     []types.Timing
+}
+
+// collectTimings replaces the timing handler of the given configuration with a collector, so the timings can be
+// returned in the metadata of the converted document. Does nothing if the configuration has no timing handler
+func collectTimings(config *configuration.Configuration) *timingsCollector {
+	c := &timingsCollector{
+		handler: config.TimingHandler(),
+	}
+	if c.handler != nil {
+		configuration.WithTimingHandler(c.handle)(config)
+	}
+	return c
+}
+
+func (c *timingsCollector) handle(timing types.Timing) {
+	c.all = append(c.all, timing)
+	c.handler(timing)
 }
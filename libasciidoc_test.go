@@ -1,11 +1,22 @@
 package libasciidoc_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing/fstest"
 	"time"
 
+	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
@@ -474,4 +485,363 @@ Last updated {{.LastUpdated}}
 
 	})
 
+	Context("validation", func() {
+
+		It("should report problems without rendering", func() {
+			source := `= Title
+:doctype: manpage
+
+content`
+			problems, err := libasciidoc.Validate(strings.NewReader(source), configuration.NewConfiguration())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problems).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.ManpageStructureRule,
+					Severity: validator.Error,
+					Message:  "manpage document is missing the 'Name' section'",
+				},
+			}))
+		})
+
+		It("should fail to validate invalid document", func() {
+			_, err := libasciidoc.Validate(strings.NewReader("hello \xff world"), configuration.NewConfiguration())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("diagnostics", func() {
+
+		source := `= a document title
+:toc: sidebar
+
+a paragraph with {unknown}
+
+include::../../test/includes/unknown.adoc[]
+
+==== Section A.a.a`
+
+		It("should return diagnostics in metadata", func() {
+			// setup logger to write in a buffer so we can check the output
+			console, reset := ConfigureLogger()
+			defer reset()
+			metadata, err := libasciidoc.ConvertToHTML(strings.NewReader(source), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithFilename("doc.adoc"),
+				configuration.WithLastUpdated(lastUpdated),
+				configuration.WithValidationRule(validator.SectionLevelRule, configuration.RuleOn)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metadata.Diagnostics).To(HaveLen(4))
+			Expect(metadata.Diagnostics[0].Cause).To(MatchError(ContainSubstring("no such file or directory")))
+			metadata.Diagnostics[0].Cause = nil
+			Expect(metadata.Diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.DiagnosticError,
+					Category: types.IncludeCategory,
+					Message:  "failed to include '../../test/includes/unknown.adoc'",
+					Position: types.Position{Filename: "doc.adoc", Line: 6, Column: 1},
+				},
+				{
+					Severity: types.DiagnosticWarning,
+					Category: types.ParseCategory,
+					Message:  "unable to find attribute 'unknown'",
+					Position: types.Position{Filename: "doc.adoc"},
+				},
+				{
+					Severity: types.DiagnosticWarning,
+					Category: types.ParseCategory,
+					Message:  "invalid or unsupported value for 'toc' attribute: 'sidebar'",
+					Position: types.Position{Filename: "doc.adoc"},
+				},
+				{
+					Severity: types.DiagnosticWarning,
+					Category: types.ValidationCategory,
+					Message:  "section 'Section A.a.a' is at level 3, but expected level 1",
+					Position: types.Position{Filename: "doc.adoc", Line: 8, Column: 1},
+				},
+			}))
+			// diagnostics are also logged by default
+			Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "unable to find attribute 'unknown'"))
+		})
+
+		It("should report include failure once", func() {
+			metadata, err := libasciidoc.ConvertToHTML(strings.NewReader("a paragraph\n\ninclude::long.adoc[]"), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithFilename("doc.adoc"),
+				configuration.WithLastUpdated(lastUpdated),
+				// a line longer than the buffer of the scanner cannot be read
+				configuration.WithFS(fstest.MapFS{
+					"long.adoc": {Data: []byte(strings.Repeat("a", 100000))},
+				}),
+				configuration.WithDiagnosticHandler(func(types.Diagnostic) {})))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metadata.Diagnostics).To(HaveLen(1))
+			Expect(metadata.Diagnostics[0].Message).To(Equal("failed to include 'long.adoc'"))
+			Expect(metadata.Diagnostics[0].Position).To(Equal(types.Position{Filename: "doc.adoc", Line: 3, Column: 1}))
+			Expect(metadata.Diagnostics[0].Cause).To(MatchError(ContainSubstring("unable to read file to include")))
+		})
+
+		It("should return rendering diagnostics in metadata", func() {
+			metadata, err := libasciidoc.ConvertToLaTeX(strings.NewReader("asciimath:[sqrt(4)]"), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithLastUpdated(lastUpdated)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metadata.Diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.DiagnosticWarning,
+					Category: types.RenderCategory,
+					Message:  "AsciiMath is not supported by the LaTeX backend: 'asciimath:[sqrt(4)]'",
+				},
+			}))
+		})
+
+		It("should pass diagnostics to handler instead of logging them", func() {
+			// setup logger to write in a buffer so we can check the output
+			console, reset := ConfigureLogger()
+			defer reset()
+			handled := []types.Diagnostic{}
+			metadata, err := libasciidoc.ConvertToHTML(strings.NewReader(source), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithLastUpdated(lastUpdated),
+				configuration.WithValidationRule(validator.SectionLevelRule, configuration.RuleOn),
+				configuration.WithDiagnosticHandler(func(d types.Diagnostic) {
+					handled = append(handled, d)
+				})))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(HaveLen(4))
+			Expect(handled).To(Equal(metadata.Diagnostics))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+	})
+
+	Context("format", func() {
+
+		It("should report include failure once with position in source", func() {
+			handled := []types.Diagnostic{}
+			output := &strings.Builder{}
+			err := libasciidoc.Format(strings.NewReader("= Title\n\n\n\n\na   paragraph\n\n\n\ninclude::unknown.adoc[]\n"), output, configuration.NewConfiguration(
+				configuration.WithFilename("test/tmp/doc.adoc"),
+				configuration.WithDiagnosticHandler(func(d types.Diagnostic) {
+					handled = append(handled, d)
+				})))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(ContainSubstring("include::unknown.adoc[]"))
+			Expect(handled).To(HaveLen(1))
+			Expect(handled[0].Position).To(Equal(types.Position{
+				Filename: "test/tmp/doc.adoc",
+				Line:     10,
+				Column:   1,
+			}))
+		})
+	})
+
+	Context("postprocessors", func() {
+
+		shout := configuration.WithPostprocessor(func(output []byte) ([]byte, error) {
+			return bytes.Replace(output, []byte("hello"), []byte("HELLO"), -1), nil
+		})
+
+		It("should postprocess LaTeX output", func() {
+			output := &strings.Builder{}
+			_, err := libasciidoc.ConvertToLaTeX(strings.NewReader("hello, world"), output, configuration.NewConfiguration(shout))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(ContainSubstring("HELLO, world"))
+		})
+
+		It("should postprocess slides output", func() {
+			output := &strings.Builder{}
+			_, err := libasciidoc.ConvertToSlides(strings.NewReader("== hello\n\nhello, world"), output, configuration.NewConfiguration(shout))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(ContainSubstring("<h2>HELLO</h2>"))
+			Expect(output.String()).To(ContainSubstring("HELLO, world"))
+		})
+
+		It("should postprocess content documents of EPUB publication", func() {
+			output := &bytes.Buffer{}
+			_, err := libasciidoc.ConvertToEPUB(strings.NewReader("= hello\n\nhello, world"), output, configuration.NewConfiguration(shout))
+			Expect(err).ToNot(HaveOccurred())
+			archive, err := zip.NewReader(bytes.NewReader(output.Bytes()), int64(output.Len()))
+			Expect(err).ToNot(HaveOccurred())
+			found := false
+			for _, f := range archive.File {
+				if f.Name != "OEBPS/chapter-01.xhtml" {
+					continue
+				}
+				r, err := f.Open()
+				Expect(err).ToNot(HaveOccurred())
+				content, err := ioutil.ReadAll(r)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring("HELLO, world"))
+				found = true
+			}
+			Expect(found).To(BeTrue())
+		})
+
+		It("should fail to postprocess", func() {
+			output := &strings.Builder{}
+			_, err := libasciidoc.ConvertToLaTeX(strings.NewReader("hello, world"), output, configuration.NewConfiguration(
+				configuration.WithPostprocessor(func(output []byte) ([]byte, error) {
+					return nil, errors.New("mock error")
+				})))
+			Expect(err).To(MatchError("unable to postprocess the document: mock error"))
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+
+	Context("timings", func() {
+
+		It("should return timings of phases and included files in metadata", func() {
+			handled := []types.Timing{}
+			metadata, err := libasciidoc.ConvertToHTML(strings.NewReader("a paragraph\n\ninclude::../../test/includes/chapter-a.adoc[]"), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithFilename("test/tmp/doc.adoc"),
+				configuration.WithLastUpdated(lastUpdated),
+				configuration.WithTimingHandler(func(t types.Timing) {
+					handled = append(handled, t)
+				})))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metadata.Timings).To(Equal(handled))
+			phases := make([]types.Phase, len(metadata.Timings))
+			for i, t := range metadata.Timings {
+				phases[i] = t.Phase
+			}
+			Expect(phases).To(Equal([]types.Phase{
+				types.PreprocessingPhase,
+				types.IncludePhase,
+				types.ParsingPhase,
+				types.SubstitutionPhase,
+				types.RearrangingPhase,
+				types.TreeProcessingPhase,
+				types.ValidationPhase,
+				types.RenderingPhase,
+			}))
+			Expect(metadata.Timings[1].Path).To(HaveSuffix("test/includes/chapter-a.adoc"))
+			// the included file is parsed during the parsing phase
+			Expect(metadata.Timings[2].Duration).To(BeNumerically(">", metadata.Timings[1].Duration))
+			Expect(metadata.Timings[2].Allocs).To(BeNumerically(">", metadata.Timings[1].Allocs))
+		})
+
+		It("should not return timings in metadata without handler", func() {
+			metadata, err := libasciidoc.ConvertToHTML(strings.NewReader("a paragraph"), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithLastUpdated(lastUpdated)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metadata.Timings).To(BeNil())
+		})
+	})
+
+	Context("cancellation and limits", func() {
+
+		It("should not convert with cancelled context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := libasciidoc.ConvertToHTMLContext(ctx, strings.NewReader("a paragraph"), &strings.Builder{}, configuration.NewConfiguration())
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("should stop converting file when deadline is exceeded", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+			defer cancel()
+			<-ctx.Done()
+			_, err := libasciidoc.ConvertFileToLaTeXContext(ctx, &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithFilename("test/fixtures/supported/sample.adoc")))
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
+
+		It("should fail with limit error", func() {
+			_, err := libasciidoc.ConvertToHTML(strings.NewReader("a paragraph"), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithMaxInputSize(5)))
+			var limitErr *configuration.LimitError
+			Expect(errors.As(err, &limitErr)).To(BeTrue())
+			Expect(limitErr.Limit).To(Equal(configuration.InputSizeLimit))
+		})
+	})
+
+	Context("project file", func() {
+
+		It("should convert with configuration loaded from project file", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc-project")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+			Expect(configuration.RegisterExtension("shout", configuration.WithPostprocessor(func(output []byte) ([]byte, error) {
+				return []byte(strings.ToUpper(string(output))), nil
+			}))).To(Succeed())
+			Expect(configuration.RegisterExtension("shout")).To(MatchError("an extension named 'shout' is already registered"))
+			path := filepath.Join(dir, configuration.ProjectFilename)
+			Expect(ioutil.WriteFile(path, []byte(`attributes:
+  product: libasciidoc
+  version: 1.0
+extensions:
+- shout
+`), 0644)).To(Succeed())
+			config, err := configuration.LoadConfiguration(path, configuration.WithAttribute("version", "2.0"))
+			Expect(err).ToNot(HaveOccurred())
+			output := &strings.Builder{}
+			_, err = libasciidoc.ConvertToHTML(strings.NewReader("{product} {version}"), output, config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal(`<DIV CLASS="PARAGRAPH">
+<P>LIBASCIIDOC 2.0</P>
+</DIV>`))
+		})
+
+		It("should fail to load project file with unknown extension", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc-project")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, configuration.ProjectFilename)
+			Expect(ioutil.WriteFile(path, []byte("extensions:\n- unknown\n"), 0644)).To(Succeed())
+			_, err = configuration.LoadConfiguration(path)
+			Expect(err).To(MatchError("invalid project file '" + path + "': unknown extension: 'unknown'"))
+		})
+
+		It("should find project file in parent directory", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc-project")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+			dir, err = filepath.EvalSymlinks(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.MkdirAll(filepath.Join(dir, "docs", "chapters"), 0755)).To(Succeed())
+			path := filepath.Join(dir, configuration.ProjectFilename)
+			Expect(ioutil.WriteFile(path, []byte("css: main.css\n"), 0644)).To(Succeed())
+			Expect(configuration.FindProjectFile(filepath.Join(dir, "docs", "chapters"))).To(Equal(path))
+		})
+	})
+
+	Context("concurrent conversions", func() {
+
+		It("should convert documents concurrently", func() {
+			// given
+			sources := []string{
+				"test/fixtures/supported/article.adoc",
+				"test/fixtures/supported/lists.adoc",
+				"test/fixtures/supported/master.adoc",
+				"test/fixtures/supported/sample.adoc",
+			}
+			convert := func(source string) (string, error) {
+				output := &strings.Builder{}
+				_, err := libasciidoc.ConvertFileToHTML(output, configuration.NewConfiguration(
+					configuration.WithFilename(source),
+					configuration.WithLastUpdated(lastUpdated),
+					configuration.WithHeaderFooter(true)))
+				return output.String(), err
+			}
+			expected := make([]string, len(sources))
+			for i, source := range sources {
+				var err error
+				expected[i], err = convert(source)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			// when
+			results := make([]string, 4*len(sources))
+			errs := make([]error, 4*len(sources))
+			wg := sync.WaitGroup{}
+			for i := range results {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i], errs[i] = convert(sources[i%len(sources)])
+				}(i)
+			}
+			wg.Wait()
+			// then
+			for i := range results {
+				Expect(errs[i]).ToNot(HaveOccurred())
+				Expect(results[i]).To(Equal(expected[i%len(sources)]))
+			}
+		})
+	})
+
 })
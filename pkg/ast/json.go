@@ -0,0 +1,459 @@
+package ast
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// SchemaVersion the version of the JSON schema of the AST. It is increased each time
+// a node type or a node field is added, renamed or removed (see the golden file of the schema in `testdata`).
+//
+// In this schema, each node of the AST is a JSON object with a `type` member (the name of the node type,
+// eg: `Section` or `Paragraph`), followed by its fields (eg: `attributes`, `elements`, etc.).
+// Strings, booleans, integers and null values are written as-is, decimal numbers are written with a decimal point
+// or an exponent (eg: `1.0`) to distinguish them from integers, arrays of nodes are written as JSON arrays,
+// and values of other types (eg: the kind of an admonition in the attributes of a paragraph) are written
+// as objects with a `type` and a `value` member.
+// The nodes do not have a position (ie, a line and column in the source document) yet.
+const SchemaVersion = "1"
+
+// names of the node types, indexed by their Go type
+var typeNames = map[reflect.Type]string{}
+
+// Go types of the nodes, indexed by their name in the schema
+var nodeTypes = map[string]reflect.Type{}
+
+// names of the values of the 'enum' types, indexed by their Go type
+var enumNames = map[reflect.Type]map[int64]string{}
+
+func init() {
+	// nodes
+	for _, node := range []interface{}{
+		types.Document{},
+		types.DocumentAuthor{},
+		types.DocumentRevision{},
+		types.DocumentAttributeDeclaration{},
+		types.DocumentAttributeReset{},
+		types.DocumentAttributeSubstitution{},
+		types.TableOfContentsPlaceHolder{},
+		types.UserMacro{},
+		types.Preamble{},
+		types.FrontMatter{},
+		types.Section{},
+		types.ContinuedListItemElement{},
+		types.OrderedList{},
+		types.OrderedListItem{},
+		types.UnorderedList{},
+		types.UnorderedListItem{},
+		types.LabeledList{},
+		types.LabeledListItem{},
+		types.Paragraph{},
+		types.InternalCrossReference{},
+		types.ExternalCrossReference{},
+		types.ImageBlock{},
+		types.InlineImage{},
+		types.Footnote{},
+		types.FootnoteReference{},
+		types.DelimitedBlock{},
+		types.Table{},
+		types.TableLine{},
+		types.LiteralBlock{},
+		types.BlankLine{},
+		types.SingleLineComment{},
+		types.StringElement{},
+		types.LineBreak{},
+		types.QuotedText{},
+		types.Passthrough{},
+		types.InlineLink{},
+		types.FileInclusion{},
+		types.LineRange{},
+		types.TagRange{},
+		types.Location{},
+		types.IndexTerm{},
+		types.ConcealedIndexTerm{},
+		// other values
+		types.DocumentAttributes{},
+		types.ElementAttributes{},
+		types.ElementReferences{},
+		types.BlockKind(""),
+		types.MacroKind(""),
+		types.NumberingStyle(""),
+		types.UnorderedListItemCheckStyle(""),
+		types.BulletStyle(""),
+		types.AdmonitionKind(""),
+		types.QuotedTextKind(0),
+		types.PassthroughKind(0),
+		types.LineRanges{},
+		types.TagRanges{},
+		[]types.DocumentAuthor{},
+		map[string]interface{}{},
+	} {
+		register(node)
+	}
+	// enums
+	enumNames[reflect.TypeOf(types.QuotedTextKind(0))] = map[int64]string{
+		int64(types.Bold):        "bold",
+		int64(types.Italic):      "italic",
+		int64(types.Monospace):   "monospace",
+		int64(types.Subscript):   "subscript",
+		int64(types.Superscript): "superscript",
+	}
+	enumNames[reflect.TypeOf(types.PassthroughKind(0))] = map[int64]string{
+		int64(types.SinglePlusPassthrough): "singleplus",
+		int64(types.TriplePlusPassthrough): "tripleplus",
+		int64(types.PassthroughMacro):      "macro",
+	}
+}
+
+// register registers the type of the given value, using its Go name (or a name
+// derived from its Go type if it has no name, eg: `[]DocumentAuthor`)
+func register(value interface{}) {
+	t := reflect.TypeOf(value)
+	name := t.Name()
+	if name == "" {
+		name = strings.Replace(t.String(), "types.", "", -1)
+	}
+	if _, exists := typeNames[t]; exists {
+		return
+	}
+	typeNames[t] = name
+	nodeTypes[name] = t
+}
+
+// ExportJSON writes the AST of the given document in JSON in the given `output`
+func ExportJSON(doc types.Document, output io.Writer) error {
+	node, err := encode(reflect.ValueOf(doc))
+	if err != nil {
+		return errors.Wrap(err, "unable to export document in JSON")
+	}
+	result, err := json.MarshalIndent(object{
+		{"version", SchemaVersion},
+		{"document", node},
+	}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "unable to export document in JSON")
+	}
+	_, err = output.Write(append(result, '\n'))
+	return err
+}
+
+// ImportJSON reads a document from its AST in JSON (as written by `ExportJSON`)
+func ImportJSON(r io.Reader) (types.Document, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber() // to distinguish integers from floats
+	var data map[string]interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return types.Document{}, errors.Wrap(err, "unable to import document from JSON")
+	}
+	if version, ok := data["version"].(string); !ok || version != SchemaVersion {
+		return types.Document{}, errors.Errorf("unable to import document from JSON: unsupported schema version '%v'", data["version"])
+	}
+	doc, err := decode(data["document"], reflect.TypeOf(types.Document{}))
+	if err != nil {
+		return types.Document{}, errors.Wrap(err, "unable to import document from JSON")
+	}
+	return doc.Interface().(types.Document), nil
+}
+
+// member a member of a JSON object
+type member struct {
+	name  string
+	value interface{}
+}
+
+// object a JSON object whose members are written in order (ie, the `type` first)
+type object []member
+
+// MarshalJSON writes the members of the object in order
+func (o object) MarshalJSON() ([]byte, error) {
+	result := bytes.NewBufferString("{")
+	for i, m := range o {
+		if i > 0 {
+			result.WriteString(",")
+		}
+		name, err := json.Marshal(m.name)
+		if err != nil {
+			return nil, err
+		}
+		result.Write(name)
+		result.WriteString(":")
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		result.Write(value)
+	}
+	result.WriteString("}")
+	return result.Bytes(), nil
+}
+
+// fieldName returns the name of the given struct field in the schema, ie, the Go name in "lower camel case"
+// (eg: `Attributes` becomes `attributes` and `ID` becomes `id`)
+func fieldName(name string) string {
+	runes := []rune(name)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// encode returns the JSON representation of the given value, whose type is known from its context
+// (eg: the value of a struct field)
+func encode(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeDynamic(v.Elem())
+	case reflect.Struct:
+		name, found := typeNames[v.Type()]
+		if !found {
+			return nil, errors.Errorf("unsupported type of node: %s", v.Type())
+		}
+		result := object{{"type", name}}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" { // unexported field
+				continue
+			}
+			value, err := encode(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, member{fieldName(f.Name), value})
+		}
+		return result, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, err := encode(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = value
+		}
+		return result, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, errors.Errorf("unsupported type of map: %s", v.Type())
+		}
+		keys := make([]string, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		sort.Strings(keys)
+		result := make(object, 0, len(keys))
+		for _, k := range keys {
+			value, err := encode(v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())))
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, member{k, value})
+		}
+		return result, nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if names, found := enumNames[v.Type()]; found {
+			if name, found := names[v.Int()]; found {
+				return name, nil
+			}
+			return nil, errors.Errorf("unsupported value of %s: %d", v.Type(), v.Int())
+		}
+		return v.Int(), nil
+	case reflect.Float32, reflect.Float64:
+		return encodeFloat(v.Float()), nil
+	default:
+		return nil, errors.Errorf("unsupported type of value: %s", v.Type())
+	}
+}
+
+// encodeFloat returns the JSON representation of the given float, with a decimal point or an exponent
+// so it is not decoded as an integer (eg: `1.0` instead of `1`)
+func encodeFloat(f float64) json.Number {
+	result := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(result, ".eE") {
+		result += ".0"
+	}
+	return json.Number(result)
+}
+
+// encodeDynamic returns the JSON representation of the given value, whose type is not known from its context
+// (eg: an element of a `[]interface{}` or the value of an attribute), along with its type when needed
+func encodeDynamic(v reflect.Value) (interface{}, error) {
+	switch v.Type() {
+	case reflect.TypeOf(""), reflect.TypeOf(true), reflect.TypeOf(0), reflect.TypeOf(0.0), reflect.TypeOf([]interface{}{}):
+		return encode(v)
+	}
+	if v.Kind() == reflect.Struct {
+		return encode(v)
+	}
+	name, found := typeNames[v.Type()]
+	if !found {
+		return nil, errors.Errorf("unsupported type of value: %s", v.Type())
+	}
+	value, err := encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return object{{"type", name}, {"value", value}}, nil
+}
+
+// decode returns the value of the given type from its JSON representation
+func decode(data interface{}, t reflect.Type) (reflect.Value, error) {
+	result := reflect.New(t).Elem()
+	if data == nil {
+		return result, nil
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		value, err := decodeDynamic(data)
+		if err != nil {
+			return result, err
+		}
+		if value.IsValid() {
+			result.Set(value)
+		}
+	case reflect.Struct:
+		fields, ok := data.(map[string]interface{})
+		if !ok {
+			return result, errors.Errorf("expected an object for a node of type '%s'", typeNames[t])
+		}
+		if name := fields["type"]; name != typeNames[t] {
+			return result, errors.Errorf("expected a node of type '%s' but got '%v'", typeNames[t], name)
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" { // unexported field
+				continue
+			}
+			value, err := decode(fields[fieldName(f.Name)], f.Type)
+			if err != nil {
+				return result, err
+			}
+			result.Field(i).Set(value)
+		}
+	case reflect.Slice:
+		elements, ok := data.([]interface{})
+		if !ok {
+			return result, errors.Errorf("expected an array for a value of type '%s'", t)
+		}
+		result.Set(reflect.MakeSlice(t, len(elements), len(elements)))
+		for i, e := range elements {
+			value, err := decode(e, t.Elem())
+			if err != nil {
+				return result, err
+			}
+			result.Index(i).Set(value)
+		}
+	case reflect.Map:
+		entries, ok := data.(map[string]interface{})
+		if !ok {
+			return result, errors.Errorf("expected an object for a value of type '%s'", t)
+		}
+		result.Set(reflect.MakeMapWithSize(t, len(entries)))
+		for k, e := range entries {
+			value, err := decode(e, t.Elem())
+			if err != nil {
+				return result, err
+			}
+			result.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), value)
+		}
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return result, errors.Errorf("expected a string for a value of type '%s'", t)
+		}
+		result.SetString(s)
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			return result, errors.Errorf("expected a boolean for a value of type '%s'", t)
+		}
+		result.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if names, found := enumNames[t]; found {
+			for i, name := range names {
+				if name == data {
+					result.SetInt(i)
+					return result, nil
+				}
+			}
+			return result, errors.Errorf("unsupported value of '%s': '%v'", typeNames[t], data)
+		}
+		n, ok := data.(json.Number)
+		if !ok {
+			return result, errors.Errorf("expected a number for a value of type '%s'", t)
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return result, err
+		}
+		result.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		n, ok := data.(json.Number)
+		if !ok {
+			return result, errors.Errorf("expected a number for a value of type '%s'", t)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return result, err
+		}
+		result.SetFloat(f)
+	default:
+		return result, errors.Errorf("unsupported type of value: %s", t)
+	}
+	return result, nil
+}
+
+// decodeDynamic returns the value from its JSON representation, using its `type` member if it is a JSON object
+func decodeDynamic(data interface{}) (reflect.Value, error) {
+	switch data := data.(type) {
+	case string, bool:
+		return reflect.ValueOf(data), nil
+	case json.Number:
+		// floats are always written with a decimal point or an exponent (see `encodeFloat`)
+		if !strings.ContainsAny(data.String(), ".eE") {
+			i, err := data.Int64()
+			return reflect.ValueOf(int(i)), err
+		}
+		f, err := data.Float64()
+		return reflect.ValueOf(f), err
+	case []interface{}:
+		return decode(data, reflect.TypeOf([]interface{}{}))
+	case map[string]interface{}:
+		name, _ := data["type"].(string)
+		t, found := nodeTypes[name]
+		if !found {
+			return reflect.Value{}, errors.Errorf("unsupported type of node: '%v'", data["type"])
+		}
+		if t.Kind() == reflect.Struct {
+			return decode(data, t)
+		}
+		return decode(data["value"], t)
+	default:
+		return reflect.Value{}, errors.Errorf("unsupported value: '%v'", data)
+	}
+}
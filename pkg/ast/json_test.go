@@ -0,0 +1,179 @@
+package ast_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("json ast", func() {
+
+	Context("export", func() {
+
+		It("paragraph with admonition and quoted text", func() {
+			doc := types.Document{
+				Attributes:        types.DocumentAttributes{},
+				ElementReferences: types.ElementReferences{},
+				Footnotes:         []types.Footnote{},
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{
+							types.AttrAdmonitionKind: types.Note,
+						},
+						Lines: [][]interface{}{
+							{
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: []interface{}{
+										types.StringElement{Content: "hello"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			expected := `{
+  "version": "1",
+  "document": {
+    "type": "Document",
+    "attributes": {},
+    "elements": [
+      {
+        "type": "Paragraph",
+        "attributes": {
+          "admonitionKind": {
+            "type": "AdmonitionKind",
+            "value": "note"
+          }
+        },
+        "lines": [
+          [
+            {
+              "type": "QuotedText",
+              "kind": "bold",
+              "elements": [
+                {
+                  "type": "StringElement",
+                  "content": "hello"
+                }
+              ]
+            }
+          ]
+        ]
+      }
+    ],
+    "elementReferences": {},
+    "footnotes": []
+  }
+}
+`
+			result := bytes.NewBuffer(nil)
+			err := ast.ExportJSON(doc, result)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.String()).To(Equal(expected))
+		})
+	})
+
+	Context("import", func() {
+
+		It("document with a section", func() {
+			source := `{
+  "version": "1",
+  "document": {
+    "type": "Document",
+    "attributes": {},
+    "elements": [
+      {
+        "type": "Section",
+        "level": 1,
+        "attributes": {
+          "id": "_a_section",
+          "customID": false
+        },
+        "title": [
+          {
+            "type": "StringElement",
+            "content": "a section"
+          }
+        ],
+        "elements": []
+      }
+    ]
+  }
+}`
+			expected := types.Document{
+				Attributes: types.DocumentAttributes{},
+				Elements: []interface{}{
+					types.Section{
+						Level: 1,
+						Attributes: types.ElementAttributes{
+							types.AttrID:       "_a_section",
+							types.AttrCustomID: false,
+						},
+						Title: []interface{}{
+							types.StringElement{Content: "a section"},
+						},
+						Elements: []interface{}{},
+					},
+				},
+			}
+			Expect(ast.ImportJSON(strings.NewReader(source))).To(Equal(expected))
+		})
+
+		It("unsupported schema version", func() {
+			source := `{"version": "0", "document": {"type": "Document"}}`
+			_, err := ast.ImportJSON(strings.NewReader(source))
+			Expect(err).To(MatchError("unable to import document from JSON: unsupported schema version '0'"))
+		})
+
+		It("unknown type of node", func() {
+			source := `{"version": "1", "document": {"type": "Document", "elements": [{"type": "Unknown"}]}}`
+			_, err := ast.ImportJSON(strings.NewReader(source))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("round-trip of integer and decimal values", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{
+				"count": 2,
+				"ratio": 1.0,
+				"scale": 0.5,
+				"large": 1e21,
+			},
+			Elements: []interface{}{},
+		}
+		result := bytes.NewBuffer(nil)
+		Expect(ast.ExportJSON(doc, result)).To(Succeed())
+		Expect(result.String()).To(ContainSubstring(`"ratio": 1.0`))
+		Expect(ast.ImportJSON(result)).To(Equal(doc))
+	})
+
+	DescribeTable("round-trip",
+		func(filename string) {
+			f, err := os.Open(filename)
+			Expect(err).NotTo(HaveOccurred())
+			defer f.Close()
+			doc, err := parser.ParseDocument(f, configuration.NewConfiguration(configuration.WithFilename(filename)))
+			Expect(err).NotTo(HaveOccurred())
+			result := bytes.NewBuffer(nil)
+			err = ast.ExportJSON(doc, result)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ast.ImportJSON(result)).To(Equal(doc))
+		},
+		Entry("article", "../../test/fixtures/supported/article.adoc"),
+		Entry("lists", "../../test/fixtures/supported/lists.adoc"),
+		Entry("sample", "../../test/fixtures/supported/sample.adoc"),
+		Entry("handbook", "../../test/epub/handbook.adoc"),
+	)
+})
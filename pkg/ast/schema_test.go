@@ -0,0 +1,55 @@
+package ast
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("json schema", func() {
+
+	// if this test fails, the JSON schema has changed: increase the `SchemaVersion` and update the golden file
+	It("should match the golden file of the current schema version", func() {
+		expected, err := ioutil.ReadFile("testdata/schema-v" + SchemaVersion + ".golden")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(describeSchema()).To(Equal(string(expected)))
+	})
+})
+
+// describeSchema returns the names of the node types and of their fields (with the type of their value),
+// and the names of the values of the 'enum' types, one per line and in alphabetical order
+func describeSchema() string {
+	lines := []string{}
+	for t, name := range typeNames {
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := []string{}
+			for i := 0; i < t.NumField(); i++ {
+				f := t.Field(i)
+				if f.PkgPath != "" { // unexported field
+					continue
+				}
+				fields = append(fields, fmt.Sprintf("%s:%s", fieldName(f.Name), f.Type))
+			}
+			lines = append(lines, fmt.Sprintf("%s {%s}", name, strings.Join(fields, ", ")))
+		default:
+			line := fmt.Sprintf("%s (%s)", name, t.Kind())
+			if names, found := enumNames[t]; found {
+				values := []string{}
+				for v, n := range names {
+					values = append(values, fmt.Sprintf("%s=%d", n, v))
+				}
+				sort.Strings(values)
+				line += " [" + strings.Join(values, ", ") + "]"
+			}
+			lines = append(lines, line)
+		}
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n") + "\n"
+}
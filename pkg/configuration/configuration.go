@@ -8,8 +8,11 @@ import (
 // NewConfiguration returns a new configuration
 func NewConfiguration(settings ...Setting) Configuration {
 	config := Configuration{
-		AttributeOverrides: make(map[string]string),
-		macros:             make(map[string]MacroTemplate),
+		AttributeOverrides:    make(map[string]string),
+		macros:                make(map[string]MacroTemplate),
+		blockMacroProcessors:  make(map[string]BlockMacroProcessor),
+		inlineMacroProcessors: make(map[string]InlineMacroProcessor),
+		blockProcessors:       make(map[string]BlockProcessor),
 	}
 	for _, set := range settings {
 		set(&config)
@@ -24,17 +27,52 @@ type Configuration struct {
 	LastUpdated         time.Time
 	IncludeHeaderFooter bool
 	CSS                 string
+	SafeMode            SafeMode
 	macros              map[string]MacroTemplate
+	preprocessors       []Preprocessor
+	treeProcessors      []TreeProcessor
+	postprocessors      []Postprocessor
+	// the macro and block processors, indexed by name
+	blockMacroProcessors  map[string]BlockMacroProcessor
+	inlineMacroProcessors map[string]InlineMacroProcessor
+	blockProcessors       map[string]BlockProcessor
+	includeResolver       IncludeResolver
+	uriIncludeResolver    URIIncludeResolver
+	baseDir               string
+	maxIncludeDepth       int
+	// the state of the validation rules, indexed by rule ID
+	validationRules map[string]RuleState
+	// the handler of the diagnostics reported during the conversion
+	diagnosticHandler DiagnosticHandler
+	// the handler of the timings of the phases of the conversion
+	timingHandler TimingHandler
+	limits        limits
 }
 
 // Clone return a clone of the current configuration
 func (c Configuration) Clone() Configuration {
 	return Configuration{
-		CSS:                 c.CSS,
-		AttributeOverrides:  c.AttributeOverrides,
-		Filename:            c.Filename,
-		IncludeHeaderFooter: c.IncludeHeaderFooter,
-		LastUpdated:         c.LastUpdated,
+		CSS:                   c.CSS,
+		AttributeOverrides:    c.AttributeOverrides,
+		Filename:              c.Filename,
+		IncludeHeaderFooter:   c.IncludeHeaderFooter,
+		LastUpdated:           c.LastUpdated,
+		SafeMode:              c.SafeMode,
+		macros:                c.macros,
+		preprocessors:         c.preprocessors,
+		treeProcessors:        c.treeProcessors,
+		postprocessors:        c.postprocessors,
+		blockMacroProcessors:  c.blockMacroProcessors,
+		inlineMacroProcessors: c.inlineMacroProcessors,
+		blockProcessors:       c.blockProcessors,
+		includeResolver:       c.includeResolver,
+		uriIncludeResolver:    c.uriIncludeResolver,
+		baseDir:               c.baseDir,
+		maxIncludeDepth:       c.maxIncludeDepth,
+		validationRules:       c.validationRules,
+		diagnosticHandler:     c.diagnosticHandler,
+		timingHandler:         c.timingHandler,
+		limits:                c.limits,
 	}
 }
 
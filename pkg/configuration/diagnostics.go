@@ -0,0 +1,57 @@
+package configuration
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DiagnosticHandler a function which handles the diagnostics reported while parsing, validating and rendering a document
+type DiagnosticHandler func(diagnostic types.Diagnostic)
+
+// WithDiagnosticHandler function to set the handler of the diagnostics reported while parsing, validating and
+// rendering a document. By default, the diagnostics are logged.
+func WithDiagnosticHandler(handler DiagnosticHandler) Setting {
+	return func(config *Configuration) {
+		config.diagnosticHandler = handler
+	}
+}
+
+// DiagnosticHandler returns the handler of the diagnostics, or a handler which logs the diagnostics if none was set
+func (c Configuration) DiagnosticHandler() DiagnosticHandler {
+	if c.diagnosticHandler != nil {
+		return c.diagnosticHandler
+	}
+	return logDiagnostic
+}
+
+// Report reports a diagnostic with the given severity, category, position and message to the handler of this configuration
+func (c Configuration) Report(severity types.DiagnosticSeverity, category types.DiagnosticCategory, position types.Position, format string, args ...interface{}) {
+	c.DiagnosticHandler()(types.Diagnostic{
+		Severity: severity,
+		Category: category,
+		Position: position,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// logDiagnostic logs the message of the given diagnostic, with its position and cause as fields (if known)
+func logDiagnostic(diagnostic types.Diagnostic) {
+	entry := log.NewEntry(log.StandardLogger())
+	if p := diagnostic.Position.String(); p != "" {
+		entry = entry.WithField("position", p)
+	}
+	if diagnostic.Cause != nil {
+		entry = entry.WithError(diagnostic.Cause)
+	}
+	switch diagnostic.Severity {
+	case types.DiagnosticError:
+		entry.Error(diagnostic.Message)
+	case types.DiagnosticWarning:
+		entry.Warn(diagnostic.Message)
+	default:
+		entry.Info(diagnostic.Message)
+	}
+}
@@ -0,0 +1,54 @@
+package configuration
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// Preprocessor a function which rewrites the lines of the source document before it is parsed
+type Preprocessor func(lines []string) ([]string, error)
+
+// TreeProcessor a function which receives the parsed document before it is rendered, and which can modify it
+// (eg: to insert generated sections)
+type TreeProcessor func(doc *types.Document) error
+
+// Postprocessor a function which rewrites the output of the renderer
+type Postprocessor func(output []byte) ([]byte, error)
+
+// WithPreprocessor registers the given preprocessor. The preprocessors are called in the order in which they were registered,
+// and each one receives the lines returned by the previous one.
+func WithPreprocessor(p Preprocessor) Setting {
+	return func(config *Configuration) {
+		config.preprocessors = append(config.preprocessors, p)
+	}
+}
+
+// WithTreeProcessor registers the given tree processor. The tree processors are called in the order in which they were registered,
+// once the document has been parsed.
+func WithTreeProcessor(p TreeProcessor) Setting {
+	return func(config *Configuration) {
+		config.treeProcessors = append(config.treeProcessors, p)
+	}
+}
+
+// WithPostprocessor registers the given postprocessor. The postprocessors are called in the order in which they were registered,
+// and each one receives the output returned by the previous one.
+func WithPostprocessor(p Postprocessor) Setting {
+	return func(config *Configuration) {
+		config.postprocessors = append(config.postprocessors, p)
+	}
+}
+
+// Preprocessors returns the registered preprocessors
+func (c Configuration) Preprocessors() []Preprocessor {
+	return c.preprocessors
+}
+
+// TreeProcessors returns the registered tree processors
+func (c Configuration) TreeProcessors() []TreeProcessor {
+	return c.treeProcessors
+}
+
+// Postprocessors returns the registered postprocessors
+func (c Configuration) Postprocessors() []Postprocessor {
+	return c.postprocessors
+}
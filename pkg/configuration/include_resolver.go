@@ -0,0 +1,176 @@
+package configuration
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// IncludeResolver resolves the content of the files to include
+type IncludeResolver interface {
+	// Resolve returns the content of the file to include, given the `target` of the `include::` directive (in which
+	// the document attributes were substituted), the attributes of the directive and the path of the including file.
+	// Also returns the resolved path of the file to include, which is used to resolve its own inclusions.
+	// The given context is the context of the conversion, which is done when the conversion is cancelled.
+	Resolve(ctx context.Context, target string, attributes types.ElementAttributes, includingFile string) (io.ReadCloser, string, error)
+}
+
+// OSIncludeResolver the default include resolver, which reads the files on the local filesystem.
+// Relative targets are resolved from the directory of the including file, and the resolved paths are absolute.
+type OSIncludeResolver struct {
+	// BaseDir the directory outside of which the files cannot be included (no restriction if empty)
+	BaseDir string
+}
+
+var _ IncludeResolver = OSIncludeResolver{}
+
+// Resolve opens the file to include on the local filesystem
+func (r OSIncludeResolver) Resolve(_ context.Context, target string, _ types.ElementAttributes, includingFile string) (io.ReadCloser, string, error) {
+	p := target
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(filepath.Dir(includingFile), p)
+	}
+	absPath, err := filepath.Abs(p)
+	if err != nil {
+		return nil, "", err
+	}
+	if r.BaseDir != "" {
+		if err := checkWithinDir(absPath, r.BaseDir); err != nil {
+			return nil, absPath, err
+		}
+	}
+	f, err := os.Open(absPath)
+	if err != nil {
+		return nil, absPath, err
+	}
+	return f, absPath, nil
+}
+
+// checkWithinDir returns an error if the given path (once its symbolic links are evaluated) is not in the given directory
+func checkWithinDir(absPath, dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	if d, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = d
+	}
+	p := absPath
+	if resolved, err := filepath.EvalSymlinks(absPath); err == nil {
+		p = resolved
+	} else if resolved, err := filepath.EvalSymlinks(filepath.Dir(absPath)); err == nil {
+		// the file does not exist, but its directory may be a symbolic link
+		p = filepath.Join(resolved, filepath.Base(absPath))
+	}
+	if rel, err := filepath.Rel(dir, p); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("'%s' is outside of the base directory '%s'", absPath, dir)
+	}
+	return nil
+}
+
+// FSIncludeResolver an include resolver which reads the files in a `fs.FS` (eg: an `embed.FS`, a zip archive, etc.)
+// Relative targets are resolved from the directory of the including file, and absolute targets from the root of the
+// filesystem. The resolved paths are slash-separated paths within the filesystem.
+type FSIncludeResolver struct {
+	FS fs.FS
+}
+
+var _ IncludeResolver = FSIncludeResolver{}
+
+// NewFSIncludeResolver returns a new include resolver which reads the files in the given filesystem
+func NewFSIncludeResolver(fsys fs.FS) FSIncludeResolver {
+	return FSIncludeResolver{
+		FS: fsys,
+	}
+}
+
+// Resolve opens the file to include in the filesystem
+func (r FSIncludeResolver) Resolve(_ context.Context, target string, _ types.ElementAttributes, includingFile string) (io.ReadCloser, string, error) {
+	target = filepath.ToSlash(target)
+	p := path.Clean(target)
+	if !path.IsAbs(target) {
+		p = path.Join(path.Dir(filepath.ToSlash(includingFile)), target)
+	}
+	p = strings.TrimPrefix(p, "/")
+	f, err := r.FS.Open(p)
+	if err != nil {
+		return nil, p, err
+	}
+	return f, p, nil
+}
+
+// WithIncludeResolver function to set the resolver of the files to include (default is `OSIncludeResolver`)
+func WithIncludeResolver(r IncludeResolver) Setting {
+	return func(config *Configuration) {
+		config.includeResolver = r
+	}
+}
+
+// WithFS function to read the files to include in the given filesystem
+func WithFS(fsys fs.FS) Setting {
+	return WithIncludeResolver(NewFSIncludeResolver(fsys))
+}
+
+// IncludeResolver returns the resolver of the files to include. By default, the files are read on the
+// local filesystem, and cannot be outside of the base directory when the safe mode is `Safe` or higher
+func (c Configuration) IncludeResolver() IncludeResolver {
+	if c.includeResolver == nil {
+		if c.SafeMode >= Safe {
+			return OSIncludeResolver{BaseDir: c.BaseDir()}
+		}
+		return OSIncludeResolver{}
+	}
+	return c.includeResolver
+}
+
+// WithBaseDir function to set the base directory, outside of which the files cannot be included when the
+// safe mode is `Safe` or higher (default is the directory of the `filename`)
+func WithBaseDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.baseDir = dir
+	}
+}
+
+// BaseDir returns the base directory, ie, the directory set with `WithBaseDir`, or the directory of the `filename`
+func (c Configuration) BaseDir() string {
+	if c.baseDir != "" {
+		return c.baseDir
+	}
+	return filepath.Dir(c.Filename)
+}
+
+const (
+	// AttrMaxIncludeDepth the attribute which overrides the maximum depth of nested file inclusions
+	AttrMaxIncludeDepth = "max-include-depth"
+	// DefaultMaxIncludeDepth the default maximum depth of nested file inclusions (as in Asciidoctor)
+	DefaultMaxIncludeDepth = 64
+)
+
+// WithMaxIncludeDepth function to set the maximum depth of nested file inclusions (default is 64)
+func WithMaxIncludeDepth(depth int) Setting {
+	return func(config *Configuration) {
+		config.maxIncludeDepth = depth
+	}
+}
+
+// MaxIncludeDepth returns the maximum depth of nested file inclusions, ie, the value of the `max-include-depth`
+// attribute override if it is a valid number, or the value set with `WithMaxIncludeDepth`, or 64 by default
+func (c Configuration) MaxIncludeDepth() int {
+	if v, found := c.AttributeOverrides[AttrMaxIncludeDepth]; found {
+		if depth, err := strconv.Atoi(v); err == nil && depth >= 0 {
+			return depth
+		}
+	}
+	if c.maxIncludeDepth > 0 {
+		return c.maxIncludeDepth
+	}
+	return DefaultMaxIncludeDepth
+}
@@ -0,0 +1,87 @@
+package configuration
+
+import (
+	"fmt"
+)
+
+// the limits of the resources used to convert a document. Zero values mean no limit
+type limits struct {
+	maxInputSize     int64
+	maxNestingDepth  int
+	maxNodes         int
+	maxIncludedBytes int64
+}
+
+// WithMaxInputSize function to set the maximum size (in bytes) of the document to convert (default is no limit)
+func WithMaxInputSize(size int64) Setting {
+	return func(config *Configuration) {
+		config.limits.maxInputSize = size
+	}
+}
+
+// MaxInputSize returns the maximum size (in bytes) of the document to convert, or 0 if there is no limit
+func (c Configuration) MaxInputSize() int64 {
+	return c.limits.maxInputSize
+}
+
+// WithMaxNestingDepth function to set the maximum depth of the elements in the document
+// (eg: a paragraph in a list item in a delimited block in a section) (default is no limit)
+func WithMaxNestingDepth(depth int) Setting {
+	return func(config *Configuration) {
+		config.limits.maxNestingDepth = depth
+	}
+}
+
+// MaxNestingDepth returns the maximum depth of the elements in the document, or 0 if there is no limit
+func (c Configuration) MaxNestingDepth() int {
+	return c.limits.maxNestingDepth
+}
+
+// WithMaxNodes function to set the maximum number of elements in the document (default is no limit)
+func WithMaxNodes(count int) Setting {
+	return func(config *Configuration) {
+		config.limits.maxNodes = count
+	}
+}
+
+// MaxNodes returns the maximum number of elements in the document, or 0 if there is no limit
+func (c Configuration) MaxNodes() int {
+	return c.limits.maxNodes
+}
+
+// WithMaxIncludedBytes function to set the maximum total size (in bytes) of the files included in the document,
+// including the nested inclusions (default is no limit)
+func WithMaxIncludedBytes(size int64) Setting {
+	return func(config *Configuration) {
+		config.limits.maxIncludedBytes = size
+	}
+}
+
+// MaxIncludedBytes returns the maximum total size (in bytes) of the files included in the document, or 0 if there is no limit
+func (c Configuration) MaxIncludedBytes() int64 {
+	return c.limits.maxIncludedBytes
+}
+
+// Limit a limit of the resources used to convert a document
+type Limit string
+
+const (
+	// InputSizeLimit the limit of the size of the document to convert
+	InputSizeLimit Limit = "input size"
+	// NestingDepthLimit the limit of the depth of the elements in the document
+	NestingDepthLimit Limit = "nesting depth"
+	// NodesLimit the limit of the number of elements in the document
+	NodesLimit Limit = "number of nodes"
+	// IncludedBytesLimit the limit of the total size of the files included in the document
+	IncludedBytesLimit Limit = "included bytes"
+)
+
+// LimitError the error returned when a document exceeds one of the limits set in the configuration
+type LimitError struct {
+	Limit Limit
+	Max   int64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("document exceeds the maximum %s of %d", e.Limit, e.Max)
+}
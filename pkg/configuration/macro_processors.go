@@ -0,0 +1,88 @@
+package configuration
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// BlockMacroProcessor a processor for the block macros with a given name (eg: `chart::sales.csv[]`), which returns
+// the blocks to render in place of the macro
+type BlockMacroProcessor interface {
+	Process(target string, attributes types.ElementAttributes) ([]interface{}, error)
+}
+
+// BlockMacroProcessorFunc an adapter to use an ordinary function as a BlockMacroProcessor
+type BlockMacroProcessorFunc func(target string, attributes types.ElementAttributes) ([]interface{}, error)
+
+// Process calls f(target, attributes)
+func (f BlockMacroProcessorFunc) Process(target string, attributes types.ElementAttributes) ([]interface{}, error) {
+	return f(target, attributes)
+}
+
+// InlineMacroProcessor a processor for the inline macros with a given name (eg: `jira:PROJ-123[]`), which returns
+// the inline elements to render in place of the macro
+type InlineMacroProcessor interface {
+	Process(target string, attributes types.ElementAttributes) ([]interface{}, error)
+}
+
+// InlineMacroProcessorFunc an adapter to use an ordinary function as an InlineMacroProcessor
+type InlineMacroProcessorFunc func(target string, attributes types.ElementAttributes) ([]interface{}, error)
+
+// Process calls f(target, attributes)
+func (f InlineMacroProcessorFunc) Process(target string, attributes types.ElementAttributes) ([]interface{}, error) {
+	return f(target, attributes)
+}
+
+// BlockProcessor a processor for the literal and listing blocks with a given style (eg: `[chart]` on a `....` block), which
+// receives the lines of the block and returns the blocks to render in place of it
+type BlockProcessor interface {
+	Process(attributes types.ElementAttributes, lines []string) ([]interface{}, error)
+}
+
+// BlockProcessorFunc an adapter to use an ordinary function as a BlockProcessor
+type BlockProcessorFunc func(attributes types.ElementAttributes, lines []string) ([]interface{}, error)
+
+// Process calls f(attributes, lines)
+func (f BlockProcessorFunc) Process(attributes types.ElementAttributes, lines []string) ([]interface{}, error) {
+	return f(attributes, lines)
+}
+
+// WithBlockMacroProcessor registers the given processor for the block macros with the given name.
+// A processor takes precedence over a macro template with the same name.
+func WithBlockMacroProcessor(name string, p BlockMacroProcessor) Setting {
+	return func(config *Configuration) {
+		config.blockMacroProcessors[name] = p
+	}
+}
+
+// WithInlineMacroProcessor registers the given processor for the inline macros with the given name.
+// A processor takes precedence over a macro template with the same name.
+func WithInlineMacroProcessor(name string, p InlineMacroProcessor) Setting {
+	return func(config *Configuration) {
+		config.inlineMacroProcessors[name] = p
+	}
+}
+
+// WithBlockProcessor registers the given processor for the literal and listing blocks with the given style
+func WithBlockProcessor(style string, p BlockProcessor) Setting {
+	return func(config *Configuration) {
+		config.blockProcessors[style] = p
+	}
+}
+
+// BlockMacroProcessor returns the processor for the block macros with the given name, if it exists
+func (c Configuration) BlockMacroProcessor(name string) (BlockMacroProcessor, bool) {
+	p, ok := c.blockMacroProcessors[name]
+	return p, ok
+}
+
+// InlineMacroProcessor returns the processor for the inline macros with the given name, if it exists
+func (c Configuration) InlineMacroProcessor(name string) (InlineMacroProcessor, bool) {
+	p, ok := c.inlineMacroProcessors[name]
+	return p, ok
+}
+
+// BlockProcessor returns the processor for the blocks with the given style, if it exists
+func (c Configuration) BlockProcessor(style string) (BlockProcessor, bool) {
+	p, ok := c.blockProcessors[style]
+	return p, ok
+}
@@ -0,0 +1,229 @@
+package configuration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ProjectFilename the name of the project configuration file, which is looked up in the directory of the source files
+// and in its parent directories
+const ProjectFilename = ".libasciidoc.yml"
+
+// ProjectFile the settings shared by all the documents of a project, eg:
+//
+//	attributes:
+//	  toc: ""
+//	  icons: font
+//	css: /styles/main.css
+//	backend: html5
+//	safe-mode: safe
+//	destination-dir: build
+//	extensions:
+//	  - sections-index
+//	rules:
+//	  source-language: error
+//	inputs:
+//	  - docs/*.adoc
+type ProjectFile struct {
+	// Path the path to the file
+	Path string
+	// Attributes the document attributes to set (a `null` value sets the attribute with an empty value)
+	Attributes map[string]string
+	// CSS the path to the CSS file to link to the documents
+	CSS string
+	// Backend the backend used to convert the documents (eg: `html5`, `slides`, `epub3` or `latex`)
+	Backend string
+	// SafeMode the name of the safe mode (eg: `safe`)
+	SafeMode string
+	// DestinationDir the directory in which the output files are written, relative to the directory of the project file
+	DestinationDir string
+	// Extensions the names of the extensions to enable (see `RegisterExtension`)
+	Extensions []string
+	// Rules the state of the validation rules (eg: `warning`), indexed by rule ID
+	Rules map[string]string
+	// Inputs the glob patterns of the documents to convert, relative to the directory of the project file
+	Inputs []string
+}
+
+type projectFile struct {
+	Attributes     map[string]interface{} `yaml:"attributes"`
+	CSS            string                 `yaml:"css"`
+	Backend        string                 `yaml:"backend"`
+	SafeMode       string                 `yaml:"safe-mode"`
+	DestinationDir string                 `yaml:"destination-dir"`
+	Extensions     []string               `yaml:"extensions"`
+	Rules          map[string]string      `yaml:"rules"`
+	Inputs         []string               `yaml:"inputs"`
+}
+
+// FindProjectFile looks up the project file in the given directory and in its parent directories,
+// and returns its path, or an empty string if there is no such file
+func FindProjectFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		path := filepath.Join(dir, ProjectFilename)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		} else if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// LoadProjectFile reads the project file at the given path. The destination directory is resolved
+// relatively to the directory of the project file
+func LoadProjectFile(path string) (ProjectFile, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ProjectFile{}, errors.Wrapf(err, "unable to read project file '%s'", path)
+	}
+	f := projectFile{}
+	if err := yaml.UnmarshalStrict(content, &f); err != nil {
+		return ProjectFile{}, errors.Wrapf(err, "unable to parse project file '%s'", path)
+	}
+	result := ProjectFile{
+		Path:       path,
+		Attributes: make(map[string]string, len(f.Attributes)),
+		CSS:        f.CSS,
+		Backend:    f.Backend,
+		SafeMode:   f.SafeMode,
+		Extensions: f.Extensions,
+		Rules:      f.Rules,
+		Inputs:     f.Inputs,
+	}
+	for k, v := range f.Attributes {
+		if v == nil {
+			result.Attributes[k] = ""
+		} else {
+			result.Attributes[k] = fmt.Sprint(v)
+		}
+	}
+	if f.DestinationDir != "" {
+		result.DestinationDir = result.resolve(f.DestinationDir)
+	}
+	return result, nil
+}
+
+// resolve returns the given path relatively to the directory of the project file, unless it is absolute
+func (f ProjectFile) resolve(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(f.Path), path)
+}
+
+// InputFiles returns the files matching the input glob patterns, in the order of the patterns (without duplicates)
+func (f ProjectFile) InputFiles() ([]string, error) {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, pattern := range f.Inputs {
+		matches, err := filepath.Glob(f.resolve(pattern))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid input pattern '%s'", pattern)
+		}
+		for _, m := range matches {
+			if !seen[m] {
+				seen[m] = true
+				result = append(result, m)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Settings returns the settings of the configuration defined in the project file (ie, the attributes, CSS, safe mode,
+// extensions and validation rules), or an error if the safe mode, a rule state or an extension is unknown
+func (f ProjectFile) Settings() ([]Setting, error) {
+	attrs := make(map[string]string, len(f.Attributes))
+	for k, v := range f.Attributes {
+		attrs[k] = v
+	}
+	settings := []Setting{
+		WithAttributes(attrs),
+		WithCSS(f.CSS),
+	}
+	if f.SafeMode != "" {
+		mode, err := ParseSafeMode(f.SafeMode)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid project file '%s'", f.Path)
+		}
+		settings = append(settings, WithSafeMode(mode))
+	}
+	ids := make([]string, 0, len(f.Rules))
+	for id := range f.Rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		state, err := ParseRuleState(f.Rules[id])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid project file '%s'", f.Path)
+		}
+		settings = append(settings, WithValidationRule(id, state))
+	}
+	for _, name := range f.Extensions {
+		ext, found := LookupExtension(name)
+		if !found {
+			return nil, errors.Errorf("invalid project file '%s': unknown extension: '%s'", f.Path, name)
+		}
+		settings = append(settings, ext...)
+	}
+	return settings, nil
+}
+
+// LoadConfiguration returns a new configuration with the settings of the project file at the given path,
+// followed by the given settings (which override the settings of the project file)
+func LoadConfiguration(path string, settings ...Setting) (Configuration, error) {
+	f, err := LoadProjectFile(path)
+	if err != nil {
+		return Configuration{}, err
+	}
+	fileSettings, err := f.Settings()
+	if err != nil {
+		return Configuration{}, err
+	}
+	return NewConfiguration(append(fileSettings, settings...)...), nil
+}
+
+var extensions = struct {
+	sync.RWMutex
+	settings map[string][]Setting
+}{
+	settings: map[string][]Setting{},
+}
+
+// RegisterExtension registers the settings of an extension (eg: preprocessors, macro processors, etc.) with the given name,
+// so it can be enabled in the `extensions` of a project file. Returns an error if an extension with the same name
+// is already registered
+func RegisterExtension(name string, settings ...Setting) error {
+	extensions.Lock()
+	defer extensions.Unlock()
+	if _, found := extensions.settings[name]; found {
+		return errors.Errorf("an extension named '%s' is already registered", name)
+	}
+	extensions.settings[name] = settings
+	return nil
+}
+
+// LookupExtension returns the settings of the extension registered with the given name, or `false` if no such extension exists
+func LookupExtension(name string) ([]Setting, bool) {
+	extensions.RLock()
+	defer extensions.RUnlock()
+	settings, found := extensions.settings[name]
+	return settings, found
+}
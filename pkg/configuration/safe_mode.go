@@ -0,0 +1,66 @@
+package configuration
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SafeMode the safe mode, which restricts the features that may be used to access external resources
+// when converting a document
+type SafeMode int
+
+const (
+	// Unsafe no restriction (default)
+	Unsafe SafeMode = 0
+	// Safe prevents access to the files outside of the base directory (ie, the parent directory of the source file by default)
+	Safe SafeMode = 1
+	// Server same as Safe, plus some attributes cannot be set from within the document
+	Server SafeMode = 10
+	// Secure prevents access to the remote resources, even if the `allow-uri-read` attribute is set
+	Secure SafeMode = 20
+)
+
+func (m SafeMode) String() string {
+	switch m {
+	case Unsafe:
+		return "unsafe"
+	case Safe:
+		return "safe"
+	case Server:
+		return "server"
+	case Secure:
+		return "secure"
+	default:
+		return fmt.Sprintf("SafeMode(%d)", int(m))
+	}
+}
+
+// ParseSafeMode returns the safe mode matching the given name (`unsafe`, `safe`, `server` or `secure`)
+func ParseSafeMode(name string) (SafeMode, error) {
+	for _, m := range []SafeMode{Unsafe, Safe, Server, Secure} {
+		if strings.EqualFold(name, m.String()) {
+			return m, nil
+		}
+	}
+	return Unsafe, fmt.Errorf("unknown safe mode: '%s'", name)
+}
+
+// WithSafeMode function to set the safe mode (default is `Unsafe`)
+func WithSafeMode(m SafeMode) Setting {
+	return func(config *Configuration) {
+		config.SafeMode = m
+	}
+}
+
+const (
+	// AttrAllowURIRead the attribute which enables the inclusion of remote files. It is only taken into account
+	// when passed as an attribute override (ie, not when declared within the document) and when the safe mode is
+	// not `Secure`
+	AttrAllowURIRead = "allow-uri-read"
+)
+
+// AllowURIRead returns `true` if the remote files can be included
+func (c Configuration) AllowURIRead() bool {
+	_, found := c.AttributeOverrides[AttrAllowURIRead]
+	return found && c.SafeMode < Secure
+}
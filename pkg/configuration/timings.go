@@ -0,0 +1,49 @@
+package configuration
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// TimingHandler a function which handles the timing of each phase of the conversion of a document
+type TimingHandler func(timing types.Timing)
+
+// WithTimingHandler function to set the handler of the timings of the phases of the conversion (default is none,
+// in which case the phases are not measured)
+func WithTimingHandler(handler TimingHandler) Setting {
+	return func(config *Configuration) {
+		config.timingHandler = handler
+	}
+}
+
+// TimingHandler returns the handler of the timings, or `nil` if none was set
+func (c Configuration) TimingHandler() TimingHandler {
+	return c.timingHandler
+}
+
+// Measure starts measuring the given phase, and returns a function to call at the end of the phase, which reports
+// its duration and its memory allocations to the timing handler. The `path` is the path of the included file, for the
+// `types.IncludePhase`. Does nothing if no timing handler was set.
+func (c Configuration) Measure(phase types.Phase, path string) func() {
+	handler := c.timingHandler
+	if handler == nil {
+		return func() {}
+	}
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	return func() {
+		duration := time.Since(start)
+		var after runtime.MemStats
+		runtime.ReadMemStats(&after)
+		handler(types.Timing{
+			Phase:      phase,
+			Path:       path,
+			Duration:   duration,
+			Allocs:     after.Mallocs - before.Mallocs,
+			AllocBytes: after.TotalAlloc - before.TotalAlloc,
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultURIReadTimeout the default timeout when reading a remote file to include
+	DefaultURIReadTimeout = 10 * time.Second
+	// DefaultURIReadMaxSize the default maximum size (in bytes) of a remote file to include
+	DefaultURIReadMaxSize = 10 * 1024 * 1024
+)
+
+// URIIncludeResolver an include resolver which reads the remote files over HTTP(S).
+// If a cache directory is set, the responses with an `ETag` header are stored in this directory, and
+// are used when the server responds with a `304 Not Modified` status to the subsequent conditional requests.
+type URIIncludeResolver struct {
+	Client   *http.Client
+	Timeout  time.Duration
+	MaxSize  int64
+	CacheDir string
+}
+
+var _ IncludeResolver = URIIncludeResolver{}
+
+// Resolve reads the content of the file at the given `target` URI. The request is aborted when the given
+// context is done, or when the timeout expires.
+func (r URIIncludeResolver) Resolve(ctx context.Context, target string, _ types.ElementAttributes, _ string) (io.ReadCloser, string, error) {
+	timeout := r.Timeout
+	if timeout <= 0 {
+		timeout = DefaultURIReadTimeout
+	}
+	maxSize := r.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultURIReadMaxSize
+	}
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, target, err
+	}
+	cached, etag, found := r.readCache(target)
+	if found {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, target, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotModified && found:
+		log.Debugf("using cached content of '%s'", target)
+		return ioutil.NopCloser(bytes.NewReader(cached)), target, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, target, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	// read one more byte than the limit to detect the content that is too large
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, target, err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, target, fmt.Errorf("content exceeds the maximum size of %d bytes", maxSize)
+	}
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		r.writeCache(target, etag, content)
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), target, nil
+}
+
+// cacheFiles returns the paths to the files containing the ETag and the content of the given target in the cache
+func (r URIIncludeResolver) cacheFiles(target string) (string, string) {
+	key := sha256.Sum256([]byte(target))
+	name := filepath.Join(r.CacheDir, hex.EncodeToString(key[:]))
+	return name + ".etag", name + ".content"
+}
+
+func (r URIIncludeResolver) readCache(target string) ([]byte, string, bool) {
+	if r.CacheDir == "" {
+		return nil, "", false
+	}
+	etagFile, contentFile := r.cacheFiles(target)
+	etag, err := ioutil.ReadFile(etagFile)
+	if err != nil {
+		return nil, "", false
+	}
+	content, err := ioutil.ReadFile(contentFile)
+	if err != nil {
+		return nil, "", false
+	}
+	return content, string(etag), true
+}
+
+// writeCache stores the ETag and the content of the given target in the cache. Failures are logged,
+// but otherwise ignored.
+func (r URIIncludeResolver) writeCache(target, etag string, content []byte) {
+	if r.CacheDir == "" {
+		return
+	}
+	if err := os.MkdirAll(r.CacheDir, 0755); err != nil {
+		log.WithError(err).Warnf("unable to create cache directory '%s'", r.CacheDir)
+		return
+	}
+	etagFile, contentFile := r.cacheFiles(target)
+	if err := ioutil.WriteFile(contentFile, content, 0644); err != nil {
+		log.WithError(err).Warnf("unable to cache content of '%s'", target)
+		return
+	}
+	if err := ioutil.WriteFile(etagFile, []byte(etag), 0644); err != nil {
+		log.WithError(err).Warnf("unable to cache content of '%s'", target)
+	}
+}
+
+// WithHTTPClient function to set the HTTP client used to read the remote files to include (default is `http.DefaultClient`)
+func WithHTTPClient(client *http.Client) Setting {
+	return func(config *Configuration) {
+		config.uriIncludeResolver.Client = client
+	}
+}
+
+// WithURIReadTimeout function to set the timeout when reading a remote file to include (default is `DefaultURIReadTimeout`)
+func WithURIReadTimeout(timeout time.Duration) Setting {
+	return func(config *Configuration) {
+		config.uriIncludeResolver.Timeout = timeout
+	}
+}
+
+// WithURIReadMaxSize function to set the maximum size (in bytes) of a remote file to include (default is `DefaultURIReadMaxSize`)
+func WithURIReadMaxSize(size int64) Setting {
+	return func(config *Configuration) {
+		config.uriIncludeResolver.MaxSize = size
+	}
+}
+
+// WithURIReadCacheDir function to set the directory in which the remote files to include are cached (default is no cache)
+func WithURIReadCacheDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.uriIncludeResolver.CacheDir = dir
+	}
+}
+
+// URIIncludeResolver returns the resolver of the remote files to include
+func (c Configuration) URIIncludeResolver() URIIncludeResolver {
+	return c.uriIncludeResolver
+}
@@ -0,0 +1,49 @@
+package configuration
+
+import (
+	"github.com/pkg/errors"
+)
+
+// RuleState the state of a validation rule
+type RuleState string
+
+const (
+	// RuleOff the state of a disabled validation rule
+	RuleOff RuleState = "off"
+	// RuleOn the state of an enabled validation rule, which reports problems with its default severity
+	RuleOn RuleState = "on"
+	// RuleWarning the state of an enabled validation rule, which reports problems as warnings
+	RuleWarning RuleState = "warning"
+	// RuleError the state of an enabled validation rule, which reports problems as errors
+	RuleError RuleState = "error"
+)
+
+// ParseRuleState returns the RuleState matching the given value, or an error if the value is not a valid state
+func ParseRuleState(value string) (RuleState, error) {
+	switch s := RuleState(value); s {
+	case RuleOff, RuleOn, RuleWarning, RuleError:
+		return s, nil
+	default:
+		return "", errors.Errorf("unknown rule state: '%s'", value)
+	}
+}
+
+// WithValidationRule function to set the state of the validation rule with the given ID
+// (eg: to enable a rule which is disabled by default, or to change its severity)
+func WithValidationRule(id string, state RuleState) Setting {
+	return func(config *Configuration) {
+		if config.validationRules == nil {
+			config.validationRules = map[string]RuleState{}
+		}
+		config.validationRules[id] = state
+	}
+}
+
+// ValidationRules returns the state of the validation rules which were set in this configuration, indexed by rule ID
+func (c Configuration) ValidationRules() map[string]RuleState {
+	result := make(map[string]RuleState, len(c.validationRules))
+	for id, state := range c.validationRules {
+		result[id] = state
+	}
+	return result
+}
@@ -1069,15 +1069,17 @@ v1.0:`
 :_author: Xavier
 :Author: Xavier
 :0Author: Xavier
-:Auth0r: Xavier`
+:Auth0r: Xavier
+:revealjs_theme: moon`
 				expected := types.Document{
 					Attributes: types.DocumentAttributes{
-						"a":       "",
-						"author":  "Xavier",
-						"_author": "Xavier",
-						"Author":  "Xavier",
-						"0Author": "Xavier",
-						"Auth0r":  "Xavier",
+						"a":              "",
+						"author":         "Xavier",
+						"_author":        "Xavier",
+						"Author":         "Xavier",
+						"0Author":        "Xavier",
+						"Auth0r":         "Xavier",
+						"revealjs_theme": "moon",
 					},
 					ElementReferences: types.ElementReferences{},
 					Elements:          []interface{}{},
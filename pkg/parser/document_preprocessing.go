@@ -1,11 +1,17 @@
 package parser
 
 import (
+	"bytes"
+	"context"
 	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/pkg/errors"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -18,21 +24,59 @@ const LevelOffset ContextKey = "leveloffset"
 
 // ParseDraftDocument parses a document's content and applies the preprocessing directives (file inclusions)
 func ParseDraftDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+	return ParseDraftDocumentContext(context.Background(), r, config, options...)
+}
+
+// ParseDraftDocumentContext parses a document's content and applies the preprocessing directives (file inclusions),
+// and stops with the error of the given context if it is done before the end of the parsing
+func ParseDraftDocumentContext(ctx context.Context, r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+	r, err := limitInputSize(r, config)
+	if err != nil {
+		return types.DraftDocument{}, err
+	}
+	return parseRootDraftDocument(ctx, r, config, options...)
+}
+
+func parseRootDraftDocument(ctx context.Context, r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+	res := newResources(ctx, config)
+	options = append(append(options, res.options()...), Entrypoint("AsciidocDocument"))
+	return parseDraftDocument(r, []levelOffset{}, []string{config.Filename}, res, config, options...)
+}
+
+// ParseRawDocument parses a document's content without applying the preprocessing directives, ie, the
+// file inclusions are kept as-is (eg: to convert the document back into Asciidoc)
+func ParseRawDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
 	options = append(options, Entrypoint("AsciidocDocument"))
-	return parseDraftDocument(r, []levelOffset{}, config, options...)
+	d, err := parseReader(config.Filename, r, options...)
+	if err != nil {
+		return types.DraftDocument{}, err
+	}
+	return d.(types.DraftDocument), nil
 }
 
-func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
-	d, err := ParseReader(config.Filename, r, options...)
+// parseDraftDocument parses the document and resolves its file inclusions. The `includes` are the chain of files
+// which led to the inclusion of this document, starting with the root document and ending with this document
+func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, includes []string, res *resources, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+	source, err := ioutil.ReadAll(r)
 	if err != nil {
 		return types.DraftDocument{}, err
 	}
+	d, err := parseReader(config.Filename, bytes.NewReader(source), options...)
+	if err != nil {
+		if ctxErr := res.err(); ctxErr != nil {
+			return types.DraftDocument{}, ctxErr
+		}
+		return types.DraftDocument{}, err
+	}
 	doc := d.(types.DraftDocument)
+	if err := res.checkLimits(doc.Blocks); err != nil {
+		return types.DraftDocument{}, err
+	}
 	attrs := types.DocumentAttributesWithOverrides{
 		Content:   map[string]interface{}{},
 		Overrides: map[string]string{},
 	}
-	blocks, err := parseElements(doc.Blocks, attrs, levelOffsets, config, options...)
+	blocks, err := parseElements(doc.Blocks, attrs, levelOffsets, includes, newIncludePositions(config.Filename, source), res, config, options...)
 	if err != nil {
 		return types.DraftDocument{}, err
 	}
@@ -45,23 +89,42 @@ func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, config configur
 }
 
 // parseElements resolves the file inclusions if any is found in the given elements
-func parseElements(elements []interface{}, attrs types.DocumentAttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) ([]interface{}, error) {
+func parseElements(elements []interface{}, attrs types.DocumentAttributesWithOverrides, levelOffsets []levelOffset, includes []string, positions *includePositions, res *resources, config configuration.Configuration, options ...Option) ([]interface{}, error) {
 	result := []interface{}{}
+	initialOffsets := levelOffsets
 	for _, e := range elements {
 		switch e := e.(type) {
 		case types.DocumentAttributeDeclaration:
 			attrs.Add(e.Name, e.Value)
+			if e.Name == types.AttrLevelOffset {
+				// the `leveloffset` attribute applies on all subsequent sections, including those of the files to include
+				offsets, err := documentLevelOffsets(e.Value, levelOffsets)
+				if err != nil {
+					// do not fail, but instead report the error and keep the current offsets
+					config.Report(types.DiagnosticWarning, types.ParseCategory, types.Position{Filename: config.Filename}, "%v", err)
+				} else {
+					levelOffsets = offsets
+				}
+			}
+			result = append(result, e)
+		case types.DocumentAttributeReset:
+			if e.Name == types.AttrLevelOffset {
+				levelOffsets = initialOffsets
+			}
 			result = append(result, e)
 		case types.FileInclusion:
 			// read the file and include its content
-			embedded, err := parseFileToInclude(e, attrs, levelOffsets, config, options...)
-			if err != nil {
-				// do not fail, but instead report the error in the console
-				log.Errorf("failed to include file '%s': %v", e.Location, err)
+			position := positions.next(e.RawText)
+			embedded, err := parseFileToInclude(e, position, attrs, levelOffsets, includes, res, config, options...)
+			if isFatal(err) {
+				return nil, err
+			} else if err != nil {
+				// do not fail, but instead report the error
+				config.Report(types.DiagnosticError, types.IncludeCategory, position, "failed to include file '%s': %v", e.Location, err)
 			}
 			result = append(result, embedded.Blocks...)
 		case types.DelimitedBlock:
-			elmts, err := parseElements(e.Elements, attrs, levelOffsets, config,
+			elmts, err := parseElements(e.Elements, attrs, levelOffsets, includes, positions, res, config,
 				// use a new var to avoid overridding the current one which needs to stay as-is for the rest of the doc parsing
 				append(options, Entrypoint("AsciidocDocumentWithinDelimitedBlock"))...)
 			if err != nil {
@@ -91,3 +154,17 @@ func parseElements(elements []interface{}, attrs types.DocumentAttributesWithOve
 	}
 	return result, nil
 }
+
+// documentLevelOffsets returns the level offsets to apply after a `leveloffset` document attribute declaration.
+// A relative value (eg: `+1`) is added to the current offsets, while an absolute value (eg: `1`) replaces them.
+func documentLevelOffsets(value string, levelOffsets []levelOffset) ([]levelOffset, error) {
+	offset, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, errors.Errorf("invalid value for the '%s' attribute: '%s'", types.AttrLevelOffset, value)
+	}
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		// use a new slice to avoid altering the offsets of the caller
+		return append(append([]levelOffset{}, levelOffsets...), relativeOffset(offset)), nil
+	}
+	return []levelOffset{relativeOffset(offset)}, nil
+}
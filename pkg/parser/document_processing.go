@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"context"
 	"io"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
@@ -11,10 +12,31 @@ import (
 
 // ParseDocument parses the content of the reader identitied by the filename
 func ParseDocument(r io.Reader, config configuration.Configuration) (types.Document, error) {
-	draftDoc, err := ParseDraftDocument(r, config)
+	return ParseDocumentContext(context.Background(), r, config)
+}
+
+// ParseDocumentContext parses the content of the reader identitied by the filename, and stops with the error
+// of the given context if it is done before the end of the parsing. Returns a `*configuration.LimitError` if
+// the document exceeds one of the limits set in the configuration
+func ParseDocumentContext(ctx context.Context, r io.Reader, config configuration.Configuration) (types.Document, error) {
+	done := config.Measure(types.PreprocessingPhase, "")
+	r, err := limitInputSize(r, config)
+	if err != nil {
+		return types.Document{}, err
+	}
+	// apply the preprocessors on the source lines
+	r, err = preprocess(r, config)
+	if err != nil {
+		return types.Document{}, err
+	}
+	done()
+	done = config.Measure(types.ParsingPhase, "")
+	draftDoc, err := parseRootDraftDocument(ctx, r, config)
 	if err != nil {
 		return types.Document{}, err
 	}
+	done()
+	done = config.Measure(types.SubstitutionPhase, "")
 	attrs := types.DocumentAttributesWithOverrides{
 		Content:   types.DocumentAttributes{},
 		Overrides: config.AttributeOverrides,
@@ -25,10 +47,15 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 	attrs.AddAll(draftDoc.DocumentAttributes())
 
 	// apply document attribute substitutions and re-parse paragraphs that were affected
-	blocks, _, err := applyDocumentAttributeSubstitutions(draftDoc.Blocks, attrs)
+	blocks, _, err := applyDocumentAttributeSubstitutions(draftDoc.Blocks, attrs, config)
 	if err != nil {
 		return types.Document{}, err
 	}
+	// replace the macros and blocks which have a processor with the elements returned by the processor
+	// (once the document attributes were substituted in their target and attributes)
+	blocks = processMacros(blocks, config)
+	done()
+	done = config.Measure(types.RearrangingPhase, "")
 
 	// now, merge list items into proper lists
 	blocks, err = rearrangeListItems(blocks.([]interface{}), false)
@@ -52,7 +79,20 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 	// and add all remaining attributes, too
 	doc.Attributes.AddAll(attrs.All())
 	// also insert the table of contents
-	doc = includeTableOfContentsPlaceHolder(doc)
+	doc = includeTableOfContentsPlaceHolder(doc, config)
+	done()
+	// let the tree processors modify the document
+	done = config.Measure(types.TreeProcessingPhase, "")
+	if err := processTree(&doc, config); err != nil {
+		return types.Document{}, err
+	}
+	done()
+	if err := checkDocumentLimits(doc, config); err != nil {
+		return types.Document{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return types.Document{}, err
+	}
 	// finally
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("final document:")
@@ -3,6 +3,7 @@ package parser
 import (
 	"strings"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -11,7 +12,7 @@ import (
 // applyDocumentAttributeSubstitutions(elements applies the document attribute substitutions
 // and re-parse the paragraphs that were affected
 // nolint: gocyclo
-func applyDocumentAttributeSubstitutions(element interface{}, attrs types.DocumentAttributesWithOverrides) (interface{}, bool, error) {
+func applyDocumentAttributeSubstitutions(element interface{}, attrs types.DocumentAttributesWithOverrides, config configuration.Configuration) (interface{}, bool, error) {
 	// the document attributes, as they are resolved while processing the blocks
 	// log.Debugf("applying document substitutions on block of type %T", element)
 	switch e := element.(type) {
@@ -19,7 +20,7 @@ func applyDocumentAttributeSubstitutions(element interface{}, attrs types.Docume
 		elements := make([]interface{}, 0, len(e)) // maximum capacity cannot exceed initial input
 		applied := false
 		for _, element := range e {
-			r, a, err := applyDocumentAttributeSubstitutions(element, attrs)
+			r, a, err := applyDocumentAttributeSubstitutions(element, attrs, config)
 			if err != nil {
 				return []interface{}{}, false, err
 			}
@@ -44,7 +45,7 @@ func applyDocumentAttributeSubstitutions(element interface{}, attrs types.Docume
 				Content: value,
 			}, true, nil
 		}
-		log.Warnf("unable to find attribute '%s'", e.Name)
+		config.Report(types.DiagnosticWarning, types.ParseCategory, types.Position{Filename: config.Filename}, "unable to find attribute '%s'", e.Name)
 		return types.StringElement{
 			Content: "{" + e.Name + "}",
 		}, false, nil
@@ -54,8 +55,10 @@ func applyDocumentAttributeSubstitutions(element interface{}, attrs types.Docume
 		return e.ResolveLocation(attrs), false, nil
 	case types.ExternalCrossReference:
 		return e.ResolveLocation(attrs), false, nil
+	case types.UserMacro:
+		return e.ResolveValue(attrs), false, nil
 	case types.Section:
-		title, applied, err := applyDocumentAttributeSubstitutions(e.Title, attrs)
+		title, applied, err := applyDocumentAttributeSubstitutions(e.Title, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
@@ -65,42 +68,42 @@ func applyDocumentAttributeSubstitutions(element interface{}, attrs types.Docume
 		e, err = e.ResolveID(attrs)
 		return e, applied, err
 	case types.OrderedListItem:
-		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.UnorderedListItem:
-		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.LabeledListItem:
-		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.QuotedText:
-		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.ContinuedListItemElement:
-		element, applied, err := applyDocumentAttributeSubstitutions(e.Element, attrs)
+		element, applied, err := applyDocumentAttributeSubstitutions(e.Element, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Element = element
 		return e, applied, nil
 	case types.DelimitedBlock:
-		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyDocumentAttributeSubstitutions(e.Elements, attrs, config)
 		if err != nil {
 			return struct{}{}, false, err
 		}
@@ -109,7 +112,7 @@ func applyDocumentAttributeSubstitutions(element interface{}, attrs types.Docume
 	case types.Paragraph:
 		applied := false
 		for i, line := range e.Lines {
-			line, a, err := applyDocumentAttributeSubstitutions(line, attrs)
+			line, a, err := applyDocumentAttributeSubstitutions(line, attrs, config)
 			if err != nil {
 				return struct{}{}, false, err
 			}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	. "github.com/onsi/ginkgo"
@@ -32,7 +33,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, configuration.NewConfiguration())
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -73,7 +74,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, configuration.NewConfiguration())
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -117,7 +118,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, configuration.NewConfiguration())
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -159,7 +160,7 @@ var _ = Describe("document attribute subsititutions", func() {
 		result, applied, err := applyDocumentAttributeSubstitutions(elements, types.DocumentAttributesWithOverrides{
 			Content:   map[string]interface{}{},
 			Overrides: map[string]string{},
-		})
+		}, configuration.NewConfiguration())
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -202,7 +203,7 @@ var _ = Describe("document attribute subsititutions", func() {
 		result, applied, err := applyDocumentAttributeSubstitutions(elements, types.DocumentAttributesWithOverrides{
 			Content:   map[string]interface{}{},
 			Overrides: map[string]string{},
-		})
+		}, configuration.NewConfiguration())
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -255,7 +256,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"host":   "foo.bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, configuration.NewConfiguration())
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -316,7 +317,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -365,7 +366,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -414,7 +415,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -466,7 +467,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -525,7 +526,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -577,7 +578,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -627,7 +628,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				Overrides: map[string]string{
 					"foo": "BAR",
 				},
-			})
+			}, configuration.NewConfiguration())
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// preprocess applies the preprocessors of the given configuration (if any) on the lines of the given reader,
+// and returns a reader on the resulting content
+func preprocess(r io.Reader, config configuration.Configuration) (io.Reader, error) {
+	preprocessors := config.Preprocessors()
+	if len(preprocessors) == 0 {
+		return r, nil
+	}
+	source, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to preprocess the document")
+	}
+	// the lines are split regardless of their length, and the final newline (if any) is retained
+	content := string(source)
+	finalNewline := strings.HasSuffix(content, "\n")
+	lines := []string{}
+	if content = strings.TrimSuffix(content, "\n"); content != "" || finalNewline {
+		lines = strings.Split(content, "\n")
+	}
+	for i, l := range lines {
+		lines[i] = strings.TrimSuffix(l, "\r")
+	}
+	for _, p := range preprocessors {
+		if lines, err = p(lines); err != nil {
+			return nil, errors.Wrap(err, "unable to preprocess the document")
+		}
+	}
+	result := strings.Join(lines, "\n")
+	if finalNewline {
+		result += "\n"
+	}
+	return strings.NewReader(result), nil
+}
+
+// processTree applies the tree processors of the given configuration (if any) on the given document
+func processTree(doc *types.Document, config configuration.Configuration) error {
+	for _, p := range config.TreeProcessors() {
+		if err := p(doc); err != nil {
+			return errors.Wrap(err, "unable to process the document")
+		}
+	}
+	return nil
+}
@@ -1,20 +1,21 @@
 package parser
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	log "github.com/sirupsen/logrus"
 )
 
 // IncludeTableOfContentsPlaceHolder includes a `TableOfContentsPlaceHolder` block in the document
 // if the `toc` attribute is present
-func includeTableOfContentsPlaceHolder(doc types.Document) types.Document {
+func includeTableOfContentsPlaceHolder(doc types.Document, config configuration.Configuration) types.Document {
 	if t, found := doc.Attributes.GetAsString(types.AttrTableOfContents); found {
-		doc = doInsertTableOfContentsPlaceHolder(doc, t)
+		doc = doInsertTableOfContentsPlaceHolder(doc, t, config)
 	}
 	return doc
 }
 
-func doInsertTableOfContentsPlaceHolder(doc types.Document, location string) types.Document {
+func doInsertTableOfContentsPlaceHolder(doc types.Document, location string, config configuration.Configuration) types.Document {
 	log.Debugf("inserting a table of contents at location `%s`", location)
 	// insert a TableOfContentsPlaceHolder element if `toc` value is:
 	// - "auto" (or empty)
@@ -43,7 +44,7 @@ func doInsertTableOfContentsPlaceHolder(doc types.Document, location string) typ
 		}
 	// case "macro":
 	default:
-		log.Warnf("invalid or unsupported value for 'toc' attribute: '%s'", location)
+		config.Report(types.DiagnosticWarning, types.ParseCategory, types.Position{Filename: config.Filename}, "invalid or unsupported value for 'toc' attribute: '%s'", location)
 	}
 	return doc
 }
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	. "github.com/onsi/ginkgo"
@@ -63,7 +64,7 @@ var _ = Describe("include table of contents", func() {
 				section,
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with default placement and a header with content", func() {
@@ -108,7 +109,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with default placement and a header without content", func() {
@@ -151,7 +152,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with preamble placement and no header with content", func() {
@@ -178,7 +179,7 @@ var _ = Describe("include table of contents", func() {
 				section,
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with preamble placement and header with content", func() {
@@ -223,7 +224,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with preamble placement and header without content", func() {
@@ -266,7 +267,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 })
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// processMacros replaces the user macros and the styled literal/listing blocks for which a processor
+// was registered in the given configuration with the elements returned by the processor. The processing errors
+// are reported as diagnostics, in which case the macro or the block is retained
+// nolint: gocyclo
+func processMacros(element interface{}, config configuration.Configuration) interface{} {
+	switch e := element.(type) {
+	case []interface{}:
+		elements := make([]interface{}, 0, len(e))
+		for _, element := range e {
+			r := processMacros(element, config)
+			if replacements, ok := r.(macroReplacement); ok {
+				elements = append(elements, replacements...)
+				continue
+			}
+			elements = append(elements, r)
+		}
+		return types.Merge(elements)
+	case types.UserMacro:
+		return processUserMacro(e, config)
+	case types.LiteralBlock:
+		if p, style, found := blockProcessor(e.Attributes, config); found {
+			return processBlock(e, p, style, e.Attributes, e.Lines, config)
+		}
+		return e
+	case types.DelimitedBlock:
+		if e.Kind == types.Listing || e.Kind == types.Fenced {
+			if p, style, found := blockProcessor(e.Attributes, config); found {
+				return processBlock(e, p, style, e.Attributes, verbatimLines(e.Elements), config)
+			}
+		}
+		e.Elements = processMacros(e.Elements, config).([]interface{})
+		return e
+	case types.Section:
+		e.Title = processMacros(e.Title, config).([]interface{})
+		return e
+	case types.Paragraph:
+		for i, line := range e.Lines {
+			e.Lines[i] = processMacros(line, config).([]interface{})
+		}
+		return e
+	case types.QuotedText:
+		e.Elements = processMacros(e.Elements, config).([]interface{})
+		return e
+	case types.OrderedListItem:
+		e.Elements = processMacros(e.Elements, config).([]interface{})
+		return e
+	case types.UnorderedListItem:
+		e.Elements = processMacros(e.Elements, config).([]interface{})
+		return e
+	case types.LabeledListItem:
+		e.Term = processMacros(e.Term, config).([]interface{})
+		e.Elements = processMacros(e.Elements, config).([]interface{})
+		return e
+	case types.ContinuedListItemElement:
+		element := processMacros(e.Element, config)
+		if replacements, ok := element.(macroReplacement); ok {
+			if len(replacements) != 1 {
+				config.Report(types.DiagnosticError, types.ParseCategory, types.Position{Filename: config.Filename}, "unable to process a list item continuation: the processor must return a single block")
+				return e
+			}
+			element = replacements[0]
+		}
+		e.Element = element
+		return e
+	case types.Table:
+		e.Header = processTableLine(e.Header, config)
+		for i, l := range e.Lines {
+			e.Lines[i] = processTableLine(l, config)
+		}
+		return e
+	default:
+		return e
+	}
+}
+
+// macroReplacement the elements returned by a processor, which replace the macro or block in its parent
+type macroReplacement []interface{}
+
+func processUserMacro(m types.UserMacro, config configuration.Configuration) interface{} {
+	var elements []interface{}
+	var err error
+	switch m.Kind {
+	case types.BlockMacro:
+		p, found := config.BlockMacroProcessor(m.Name)
+		if !found {
+			return m // will be rendered with the macro template, if any
+		}
+		elements, err = p.Process(m.Value, attributesOrEmpty(m.Attributes))
+	default:
+		p, found := config.InlineMacroProcessor(m.Name)
+		if !found {
+			return m // will be rendered with the macro template, if any
+		}
+		elements, err = p.Process(m.Value, attributesOrEmpty(m.Attributes))
+	}
+	if err != nil {
+		config.Report(types.DiagnosticError, types.ParseCategory, types.Position{Filename: config.Filename}, "unable to process the '%s' macro: %v", m.Name, err)
+		return m
+	}
+	return macroReplacement(elements)
+}
+
+// blockProcessor returns the processor registered for the style of a block, ie, for its first positional attribute
+func blockProcessor(attrs types.ElementAttributes, config configuration.Configuration) (configuration.BlockProcessor, string, bool) {
+	style := attrs.GetAsString(types.AttrStyle)
+	if style == "" {
+		return nil, "", false
+	}
+	p, found := config.BlockProcessor(style)
+	return p, style, found
+}
+
+// processBlock returns the elements returned by the given processor for the given block, or the block itself
+// if the processor failed
+func processBlock(block interface{}, p configuration.BlockProcessor, style string, attrs types.ElementAttributes, lines []string, config configuration.Configuration) interface{} {
+	elements, err := p.Process(attrs, lines)
+	if err != nil {
+		config.Report(types.DiagnosticError, types.ParseCategory, types.Position{Filename: config.Filename}, "unable to process the '%s' block: %v", style, err)
+		return block
+	}
+	return macroReplacement(elements)
+}
+
+// verbatimLines returns the lines of a verbatim block (eg: a listing block)
+func verbatimLines(elements []interface{}) []string {
+	lines := []string{}
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.BlankLine:
+			lines = append(lines, "")
+		case types.Paragraph:
+			for _, l := range e.Lines {
+				line := strings.Builder{}
+				for _, s := range l {
+					if s, ok := s.(types.StringElement); ok {
+						line.WriteString(s.Content)
+					}
+				}
+				lines = append(lines, line.String())
+			}
+		}
+	}
+	return lines
+}
+
+func processTableLine(l types.TableLine, config configuration.Configuration) types.TableLine {
+	for i, cell := range l.Cells {
+		l.Cells[i] = processMacros(cell, config).([]interface{})
+	}
+	return l
+}
+
+func attributesOrEmpty(attrs types.ElementAttributes) types.ElementAttributes {
+	if attrs == nil {
+		return types.ElementAttributes{}
+	}
+	return attrs
+}
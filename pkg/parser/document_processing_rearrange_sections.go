@@ -75,7 +75,10 @@ func referenceSection(e types.Section, elementRefs types.ElementReferences) {
 		}
 		if _, found := elementRefs[key]; !found {
 			elementRefs[key] = e.Title
-			// override the element id
+			// override the element id, and keep the original one
+			if key != id {
+				e.Attributes[types.AttrOriginalID] = id
+			}
 			e.Attributes[types.AttrID] = key
 			break
 		}
@@ -3,7 +3,10 @@ package parser
 import (
 	"bufio"
 	"bytes"
-	"os"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -56,39 +59,83 @@ func absoluteOffset(offset int) levelOffset {
 	}
 }
 
-func parseFileToInclude(incl types.FileInclusion, attrs types.DocumentAttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+func parseFileToInclude(incl types.FileInclusion, position types.Position, attrs types.DocumentAttributesWithOverrides, levelOffsets []levelOffset, includes []string, res *resources, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+	if err := res.err(); err != nil {
+		return types.DraftDocument{}, err
+	}
 	path := incl.Location.Resolve(attrs).String()
-	currentDir := filepath.Dir(config.Filename)
 	if log.IsLevelEnabled(log.DebugLevel) {
-		log.Debugf("parsing '%s' from '%s' (%s)", path, currentDir, config.Filename)
+		log.Debugf("parsing '%s' from '%s'", path, config.Filename)
 		log.Debugf("file inclusion attributes: %s", spew.Sdump(incl.Attributes))
 	}
-	f, absPath, done, err := open(filepath.Join(currentDir, path))
-	defer done()
+	var resolver configuration.IncludeResolver = config.IncludeResolver()
+	target := path
+	if isURI(path) || isURI(config.Filename) {
+		if !config.AllowURIRead() {
+			return invalidFileErrMsg(config, position, path, incl.RawText, errors.Errorf("'%s' attribute is not set or safe mode is secure", configuration.AttrAllowURIRead))
+		}
+		var err error
+		if target, err = resolveURI(path, config.Filename); err != nil {
+			return invalidFileErrMsg(config, position, path, incl.RawText, err)
+		}
+		resolver = config.URIIncludeResolver()
+	}
+	f, absPath, err := resolver.Resolve(res.ctx, target, incl.Attributes, config.Filename)
 	if err != nil {
-		return invalidFileErrMsg(config.Filename, path, incl.RawText, err)
+		if isOptional(incl) {
+			log.Debugf("skipping optional file to include '%s': %v", path, err)
+			return types.DraftDocument{}, nil
+		}
+		return invalidFileErrMsg(config, position, path, incl.RawText, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("failed to close file '%s'", absPath)
+		}
+	}()
+	// measure the time spent reading and parsing the file to include (including its own inclusions)
+	defer config.Measure(types.IncludePhase, absPath)()
+	// verify that the file to include is not already being included, and that the include chain is not too long
+	includes = append(append([]string{}, includes...), absPath)
+	if isIncludeCycle(includes) {
+		return invalidFileErrMsg(config, position, path, incl.RawText, errors.Errorf("include cycle detected: %s", strings.Join(includes, " -> ")))
+	}
+	if maxDepth := config.MaxIncludeDepth(); len(includes)-1 > maxDepth {
+		return invalidFileErrMsg(config, position, path, incl.RawText, errors.Errorf("maximum include depth of %d exceeded: %s", maxDepth, strings.Join(includes, " -> ")))
+	}
+	r, err := decode(res.include(f), incl.Attributes.GetAsString(types.AttrEncoding))
+	if isFatal(err) {
+		// eg: the included bytes exceed the limit while the whole content is read to be decoded
+		return types.DraftDocument{}, err
+	} else if err != nil {
+		return invalidFileErrMsg(config, position, path, incl.RawText, err)
 	}
 	content := bytes.NewBuffer(nil)
-	scanner := bufio.NewScanner(bufio.NewReader(f))
+	scanner := bufio.NewScanner(bufio.NewReader(r))
 	if lineRanges, ok := incl.LineRanges(); ok {
 		if err := readWithinLines(scanner, content, lineRanges); err != nil {
-			return invalidFileErrMsg(config.Filename, path, incl.RawText, err)
+			return invalidFileErrMsg(config, position, path, incl.RawText, err)
 		}
 	} else if tagRanges, ok := incl.TagRanges(); ok {
-		if err := readWithinTags(path, scanner, content, tagRanges); err != nil {
-			return invalidFileErrMsg(config.Filename, path, incl.RawText, err)
+		if err := readWithinTags(path, scanner, content, tagRanges, config); err != nil {
+			return invalidFileErrMsg(config, position, path, incl.RawText, err)
 		}
 	} else {
 		if err := readAll(scanner, content); err != nil {
-			return invalidFileErrMsg(config.Filename, path, incl.RawText, err)
+			return invalidFileErrMsg(config, position, path, incl.RawText, err)
 		}
 	}
-	if err := scanner.Err(); err != nil {
-		msg, err2 := invalidFileErrMsg(config.Filename, path, incl.RawText, err)
-		if err2 != nil {
-			return types.DraftDocument{}, err2
+	if err := scanner.Err(); isFatal(err) {
+		return types.DraftDocument{}, err
+	} else if err != nil {
+		return invalidFileErrMsg(config, position, path, incl.RawText, errors.Wrap(err, "unable to read file to include"))
+	}
+	if incl.Attributes.Has(types.AttrIndent) {
+		i, err := strconv.Atoi(incl.Attributes.GetAsString(types.AttrIndent))
+		if err != nil || i < 0 {
+			return invalidFileErrMsg(config, position, path, incl.RawText, errors.Errorf("invalid indent: '%s'", incl.Attributes.GetAsString(types.AttrIndent)))
 		}
-		return msg, errors.Wrap(err, "unable to read file to include")
+		content = indent(content, i)
 	}
 	// parse the content, and returns the corresponding elements
 	l := incl.Attributes.GetAsString(types.AttrLevelOffset)
@@ -110,17 +157,146 @@ func parseFileToInclude(incl types.FileInclusion, attrs types.DocumentAttributes
 	}
 	inclConfig := config.Clone()
 	inclConfig.Filename = absPath
-	return parseDraftDocument(content, levelOffsets, config, options...)
+	// the files included by the included file are restricted to the same base directory
+	configuration.WithBaseDir(config.BaseDir())(&inclConfig)
+	return parseDraftDocument(content, levelOffsets, includes, res, inclConfig, options...)
+}
+
+// includePositions the lines of the include directives of a document, indexed by their raw text, so that
+// the failures to include a file can be reported with the position of their directive
+type includePositions struct {
+	filename string
+	source   []byte
+	lines    map[string][]int
+}
+
+func newIncludePositions(filename string, source []byte) *includePositions {
+	return &includePositions{
+		filename: filename,
+		source:   source,
+	}
+}
+
+// next returns the position of the next include directive with the given raw text (in the document order),
+// or a position with the filename only if the directive was not found
+func (p *includePositions) next(rawText string) types.Position {
+	if p.lines == nil {
+		// the directives are looked up on the first inclusion only
+		p.lines = map[string][]int{}
+		for i, l := range strings.Split(string(p.source), "\n") {
+			if l = strings.TrimRight(l, " \t\r"); strings.HasPrefix(l, "include::") {
+				p.lines[l] = append(p.lines[l], i+1)
+			}
+		}
+	}
+	position := types.Position{Filename: p.filename}
+	if lines := p.lines[rawText]; len(lines) > 0 {
+		position.Line = lines[0]
+		position.Column = 1
+		p.lines[rawText] = lines[1:]
+	}
+	return position
+}
+
+// isIncludeCycle returns true if the last file of the given include chain was already included in the chain
+func isIncludeCycle(includes []string) bool {
+	last := includeKey(includes[len(includes)-1])
+	for _, f := range includes[:len(includes)-1] {
+		if includeKey(f) == last {
+			return true
+		}
+	}
+	return false
+}
+
+// includeKey returns the key of the given file in the include chain, ie, its absolute path (unless it's a remote location)
+func includeKey(path string) string {
+	if isURI(path) {
+		return path
+	}
+	if p, err := filepath.Abs(filepath.FromSlash(path)); err == nil {
+		return p
+	}
+	return path
 }
 
-func invalidFileErrMsg(filename, path, rawText string, err error) (types.DraftDocument, error) {
-	log.WithError(err).Errorf("failed to include '%s'", path)
+// isOptional returns true if the file inclusion has the `optional` option, in which case
+// a missing file is silently skipped
+func isOptional(incl types.FileInclusion) bool {
+	for _, o := range strings.Split(incl.Attributes.GetAsString(types.AttrOptions), ",") {
+		if strings.TrimSpace(o) == "optional" {
+			return true
+		}
+	}
+	return false
+}
+
+// decode returns a reader which converts the content of the given reader from the given encoding into UTF-8.
+// Only UTF-8 (the default) and ISO-8859-1 are supported.
+func decode(r io.Reader, encoding string) (io.Reader, error) {
+	switch strings.ToLower(encoding) {
+	case "", "utf-8", "utf8":
+		return r, nil
+	case "iso-8859-1", "iso8859-1", "latin1":
+		content, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		// each byte maps to the unicode code point of the same value
+		runes := make([]rune, len(content))
+		for i, b := range content {
+			runes[i] = rune(b)
+		}
+		return strings.NewReader(string(runes)), nil
+	default:
+		return nil, errors.Errorf("unsupported encoding: '%s'", encoding)
+	}
+}
+
+// indent removes the common leading whitespace of the (non-blank) lines of the given content,
+// then indents all (non-blank) lines with the given number of spaces
+func indent(content *bytes.Buffer, size int) *bytes.Buffer {
+	if content.Len() == 0 {
+		return content
+	}
+	lines := strings.Split(strings.TrimSuffix(content.String(), "\n"), "\n")
+	margin := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		if m := len(l) - len(strings.TrimLeft(l, " \t")); margin == -1 || m < margin {
+			margin = m
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	prefix := strings.Repeat(" ", size)
+	for _, l := range lines {
+		if strings.TrimSpace(l) != "" {
+			result.WriteString(prefix)
+			result.WriteString(l[margin:])
+		}
+		result.WriteString("\n")
+	}
+	return result
+}
+
+// invalidFileErrMsg reports the failure to include the file at the given path (with the position of its directive),
+// and returns the "Unresolved directive" message which replaces the directive in the document
+func invalidFileErrMsg(config configuration.Configuration, position types.Position, path, rawText string, err error) (types.DraftDocument, error) {
+	config.DiagnosticHandler()(types.Diagnostic{
+		Severity: types.DiagnosticError,
+		Category: types.IncludeCategory,
+		Message:  fmt.Sprintf("failed to include '%s'", path),
+		Position: position,
+		Cause:    err,
+	})
 	buf := bytes.NewBuffer(nil)
 	err = invalidFileTmpl.Execute(buf, struct {
 		Filename string
 		Error    string
 	}{
-		Filename: filename,
+		Filename: config.Filename,
 		Error:    rawText,
 	})
 	if err != nil {
@@ -176,7 +352,7 @@ func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges t
 	return nil
 }
 
-func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer, expectedRanges types.TagRanges) error {
+func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer, expectedRanges types.TagRanges, config configuration.Configuration) error {
 	log.Debugf("limiting to tag ranges: %v", expectedRanges)
 	currentRanges := make(map[string]*types.CurrentTagRange, len(expectedRanges)) // ensure capacity
 	lineNumber := 0
@@ -222,9 +398,9 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 		default:
 			tr, found := currentRanges[tag.Name]
 			if !found {
-				log.Errorf("tag '%s' not found in include file: %s", tag.Name, path)
+				config.Report(types.DiagnosticError, types.IncludeCategory, types.Position{Filename: path}, "tag '%s' not found in include file: %s", tag.Name, path)
 			} else if tr.EndLine == -1 {
-				log.Errorf("detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path)
+				config.Report(types.DiagnosticError, types.IncludeCategory, types.Position{Filename: path, Line: tr.StartLine}, "detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path)
 			}
 		}
 	}
@@ -258,50 +434,25 @@ func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
 	return nil
 }
 
-func open(path string) (*os.File, string, func(), error) {
-	wd, err := os.Getwd()
-	if err != nil {
-		return nil, "", func() {}, err
-	}
-	absPath, err := filepath.Abs(path)
-	log.Debugf("file path: %s", absPath)
-	if err != nil {
-		return nil, "", func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+// isURI returns true if the given path is a remote location
+func isURI(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+// resolveURI resolves the given path relatively to the including file, when the latter is a remote location
+func resolveURI(path, includingFile string) (string, error) {
+	if isURI(path) || !isURI(includingFile) {
+		return path, nil
 	}
-	dir := filepath.Dir(absPath)
-	err = os.Chdir(dir)
+	base, err := url.Parse(includingFile)
 	if err != nil {
-		return nil, "", func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return "", err
 	}
-	// read the file per-se
-	f, err := os.Open(absPath)
+	ref, err := url.Parse(filepath.ToSlash(path))
 	if err != nil {
-		return nil, absPath, func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return "", err
 	}
-	return f, absPath, func() {
-		log.Debugf("restoring current working dir to: %s", wd)
-		if err := os.Chdir(wd); err != nil { // restore the previous working directory
-			log.WithError(err).Error("failed to restore previous working directory")
-		}
-		if err := f.Close(); err != nil {
-			log.WithError(err).Errorf("failed to close file '%s'", absPath)
-		}
-	}, nil
+	return base.ResolveReference(ref).String(), nil
 }
 
 // IsAsciidoc returns true if the file to include is an asciidoc file (based on the file location extension)
@@ -1,8 +1,20 @@
 package parser_test
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"strings"
+	"testing/fstest"
+	"time"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
@@ -1788,6 +1800,71 @@ include::../../test/includes/unknown.adoc[leveloffset=+1]
 		})
 	})
 
+	Context("file inclusions in safe mode", func() {
+
+		It("should not include file outside of the directory of the document", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in foo.adoc - include::../../test/includes/chapter-a.adoc[]",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source,
+				WithFilename("foo.adoc"),
+				configuration.WithSafeMode(configuration.Safe))).To(MatchDraftDocument(expected))
+			// verify error in logs
+			Expect(console).To(
+				ContainMessageWithLevel(
+					log.ErrorLevel,
+					"failed to include '../../test/includes/chapter-a.adoc'",
+				))
+		})
+
+		It("should include file within the base directory", func() {
+			source := "include::../../test/includes/chapter-a.adoc[]"
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Section{
+						Attributes: types.ElementAttributes{},
+						Level:      0,
+						Title: []interface{}{
+							types.StringElement{
+								Content: "Chapter A",
+							},
+						},
+						Elements: []interface{}{},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "content",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source,
+				WithFilename("foo.adoc"),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithBaseDir("../.."))).To(Equal(expected))
+		})
+	})
+
 	Context("inclusion with attribute in path", func() {
 
 		It("should resolve path with attribute in standalone block from local file", func() {
@@ -2982,3 +3059,749 @@ include::../../test/includes/chapter-a.adoc[]
 		})
 	})
 })
+
+var _ = Describe("file inclusions - custom resolver", func() {
+
+	fsys := fstest.MapFS{
+		"docs/chapters/chapter-a.adoc": {
+			Data: []byte("= Chapter A\n\ninclude::../snippets/snippet.adoc[lines=2]\n"),
+		},
+		"docs/snippets/snippet.adoc": {
+			Data: []byte("line 1\nline 2\nline 3\n"),
+		},
+		"docs/snippets/tagged.adoc": {
+			Data: []byte("// tag::a[]\nline a\n// end::a[]\n// tag::b[]\nline b\n// end::b[]\n"),
+		},
+	}
+
+	It("should include nested files with leveloffset and line ranges", func() {
+		source := `include::chapters/chapter-a.adoc[leveloffset=+1]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				types.Section{
+					Attributes: types.ElementAttributes{},
+					Level:      1,
+					Title: []interface{}{
+						types.StringElement{
+							Content: "Chapter A",
+						},
+					},
+					Elements: []interface{}{},
+				},
+				types.BlankLine{},
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "line 2",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+	})
+
+	It("should include file with tags from the root of the filesystem", func() {
+		source := `include::/docs/snippets/tagged.adoc[tag=b]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "line b",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+	})
+
+	It("should replace with string element if file is missing", func() {
+		source := `include::unknown.adoc[]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "Unresolved directive in docs/main.adoc - include::unknown.adoc[]",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+	})
+})
+
+var _ = Describe("file inclusions - options", func() {
+
+	fsys := fstest.MapFS{
+		"docs/hello.go": {
+			Data: []byte("\t\tfunc hello() {\n\t\t\tfmt.Println(\"hello\")\n\n\t\t}\n"),
+		},
+		"docs/legacy.adoc": {
+			Data: []byte("Gregory Rom\xe9\n"), // ISO-8859-1 encoded content
+		},
+		"docs/chapter-a.adoc": {
+			Data: []byte("= Chapter A\n\ncontent\n"),
+		},
+	}
+
+	Context("indent", func() {
+
+		It("should remove the indentation", func() {
+			source := `----
+include::hello.go[indent=0]
+----`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Kind:       types.Listing,
+						Attributes: types.ElementAttributes{},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: `func hello() {`,
+										},
+									},
+									{
+										types.StringElement{
+											Content: `	fmt.Println("hello")`,
+										},
+									},
+								},
+							},
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: `}`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(MatchDraftDocument(expected))
+		})
+
+		It("should replace the indentation", func() {
+			source := `----
+include::hello.go[indent=2]
+----`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.DelimitedBlock{
+						Kind:       types.Listing,
+						Attributes: types.ElementAttributes{},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: `  func hello() {`,
+										},
+									},
+									{
+										types.StringElement{
+											Content: `  	fmt.Println("hello")`,
+										},
+									},
+								},
+							},
+							types.BlankLine{},
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: `  }`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(MatchDraftDocument(expected))
+		})
+
+		It("should not include file with invalid indent", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `include::hello.go[indent=-1]`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in docs/main.adoc - include::hello.go[indent=-1]",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+			// verify error in logs
+			Expect(console).To(
+				ContainMessageWithLevel(log.ErrorLevel, "failed to include 'hello.go'"))
+		})
+	})
+
+	Context("encoding", func() {
+
+		It("should include file encoded in ISO-8859-1", func() {
+			source := `include::legacy.adoc[encoding=iso-8859-1]`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "Gregory Romé",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+		})
+
+		It("should not include file with unsupported encoding", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `include::legacy.adoc[encoding=ebcdic]`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in docs/main.adoc - include::legacy.adoc[encoding=ebcdic]",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+			// verify error in logs
+			Expect(console).To(
+				ContainMessageWithLevel(log.ErrorLevel, "failed to include 'legacy.adoc'"))
+		})
+	})
+
+	Context("optional", func() {
+
+		It("should skip missing optional file", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `before
+
+include::unknown.adoc[opts=optional]
+
+after`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "before",
+								},
+							},
+						},
+					},
+					types.BlankLine{},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "after",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+			// verify no error in logs
+			Expect(console).ToNot(
+				ContainMessageWithLevel(log.ErrorLevel, "failed to include 'unknown.adoc'"))
+		})
+
+		It("should include existing optional file", func() {
+			source := `include::legacy.adoc[opts=optional,encoding=iso-8859-1]`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "Gregory Romé",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+		})
+	})
+
+	Context("leveloffset document attribute", func() {
+
+		chapterA := func(level int) []interface{} {
+			return []interface{}{
+				types.Section{
+					Attributes: types.ElementAttributes{},
+					Level:      level,
+					Title: []interface{}{
+						types.StringElement{
+							Content: "Chapter A",
+						},
+					},
+					Elements: []interface{}{},
+				},
+				types.BlankLine{},
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "content",
+							},
+						},
+					},
+				},
+			}
+		}
+
+		It("should apply relative offset around file inclusion", func() {
+			source := `:leveloffset: +1
+
+include::chapter-a.adoc[]
+
+:leveloffset!:
+
+include::chapter-a.adoc[]`
+			blocks := []interface{}{
+				types.DocumentAttributeDeclaration{
+					Name:  "leveloffset",
+					Value: "+1",
+				},
+				types.BlankLine{},
+			}
+			blocks = append(blocks, chapterA(1)...)
+			blocks = append(blocks, types.BlankLine{},
+				types.DocumentAttributeReset{
+					Name: "leveloffset",
+				},
+				types.BlankLine{},
+			)
+			blocks = append(blocks, chapterA(0)...)
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(types.DraftDocument{
+				Blocks: blocks,
+			}))
+		})
+
+		It("should combine with leveloffset of the file inclusion", func() {
+			source := `:leveloffset: +1
+
+include::chapter-a.adoc[leveloffset=+1]`
+			blocks := []interface{}{
+				types.DocumentAttributeDeclaration{
+					Name:  "leveloffset",
+					Value: "+1",
+				},
+				types.BlankLine{},
+			}
+			blocks = append(blocks, chapterA(2)...)
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(types.DraftDocument{
+				Blocks: blocks,
+			}))
+		})
+
+		It("should apply absolute offset on subsequent sections", func() {
+			source := `:leveloffset: 2
+
+== Section B`
+			Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(types.DraftDocument{
+				Blocks: []interface{}{
+					types.DocumentAttributeDeclaration{
+						Name:  "leveloffset",
+						Value: "2",
+					},
+					types.BlankLine{},
+					types.Section{
+						Attributes: types.ElementAttributes{},
+						Level:      3,
+						Title: []interface{}{
+							types.StringElement{
+								Content: "Section B",
+							},
+						},
+						Elements: []interface{}{},
+					},
+				},
+			}))
+		})
+
+		DescribeTable("should report invalid offset and keep the current offsets",
+			func(value string) {
+				source := `:leveloffset: +1
+
+:leveloffset:` + value + `
+
+== Section B`
+				diagnostics := []types.Diagnostic{}
+				Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys),
+					configuration.WithDiagnosticHandler(func(d types.Diagnostic) {
+						diagnostics = append(diagnostics, d)
+					}))).To(Equal(types.DraftDocument{
+					Blocks: []interface{}{
+						types.DocumentAttributeDeclaration{
+							Name:  "leveloffset",
+							Value: "+1",
+						},
+						types.BlankLine{},
+						types.DocumentAttributeDeclaration{
+							Name:  "leveloffset",
+							Value: strings.TrimSpace(value),
+						},
+						types.BlankLine{},
+						types.Section{
+							Attributes: types.ElementAttributes{},
+							Level:      2,
+							Title: []interface{}{
+								types.StringElement{
+									Content: "Section B",
+								},
+							},
+							Elements: []interface{}{},
+						},
+					},
+				}))
+				Expect(diagnostics).To(Equal([]types.Diagnostic{
+					{
+						Severity: types.DiagnosticWarning,
+						Category: types.ParseCategory,
+						Message:  fmt.Sprintf("invalid value for the 'leveloffset' attribute: '%s'", strings.TrimSpace(value)),
+						Position: types.Position{Filename: "docs/main.adoc"},
+					},
+				}))
+			},
+			Entry("non-integer value", " foo"),
+			Entry("empty value", ""),
+		)
+	})
+})
+
+var _ = Describe("file inclusions - cycles and depth", func() {
+
+	fsys := fstest.MapFS{
+		"docs/a.adoc": {
+			Data: []byte("a\n\ninclude::b.adoc[]\n"),
+		},
+		"docs/b.adoc": {
+			Data: []byte("b\n\ninclude::a.adoc[]\n"),
+		},
+		"docs/c.adoc": {
+			Data: []byte("c\n\ninclude::d.adoc[]\n"),
+		},
+		"docs/d.adoc": {
+			Data: []byte("d\n"),
+		},
+		"docs/self.adoc": {
+			Data: []byte("include::self.adoc[]\n"),
+		},
+	}
+
+	paragraph := func(content string) types.Paragraph {
+		return types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{
+						Content: content,
+					},
+				},
+			},
+		}
+	}
+
+	// loggedError returns the `error` field of the first message logged at the error level
+	loggedError := func(console io.Reader) string {
+		scanner := bufio.NewScanner(console)
+		for scanner.Scan() {
+			out := map[string]interface{}{}
+			Expect(json.Unmarshal(scanner.Bytes(), &out)).To(Succeed())
+			if out["level"] == log.ErrorLevel.String() {
+				return out["error"].(string)
+			}
+		}
+		return ""
+	}
+
+	It("should detect cycle between 2 files", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::b.adoc[]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				paragraph("b"),
+				types.BlankLine{},
+				paragraph("a"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/a.adoc - include::b.adoc[]"),
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+		// verify the chain of files in logs
+		Expect(loggedError(console)).To(Equal("include cycle detected: docs/main.adoc -> docs/b.adoc -> docs/a.adoc -> docs/b.adoc"))
+	})
+
+	It("should detect cycle with the root document", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::self.adoc[]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				paragraph("Unresolved directive in docs/self.adoc - include::self.adoc[]"),
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/self.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+		// verify the chain of files in logs
+		Expect(loggedError(console)).To(Equal("include cycle detected: docs/self.adoc -> docs/self.adoc"))
+	})
+
+	It("should include same file twice", func() {
+		source := `include::d.adoc[]
+
+include::d.adoc[]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				paragraph("d"),
+				types.BlankLine{},
+				paragraph("d"),
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys))).To(Equal(expected))
+	})
+
+	It("should stop at maximum include depth set in configuration", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::c.adoc[]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				paragraph("c"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/c.adoc - include::d.adoc[]"),
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys), configuration.WithMaxIncludeDepth(1))).To(Equal(expected))
+		// verify the chain of files in logs
+		Expect(loggedError(console)).To(Equal("maximum include depth of 1 exceeded: docs/main.adoc -> docs/c.adoc -> docs/d.adoc"))
+	})
+
+	It("should stop at maximum include depth set in attribute", func() {
+		source := `include::c.adoc[]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				paragraph("Unresolved directive in docs/main.adoc - include::c.adoc[]"),
+			},
+		}
+		Expect(ParseDraftDocument(source, WithFilename("docs/main.adoc"), configuration.WithFS(fsys), configuration.WithAttribute(configuration.AttrMaxIncludeDepth, "0"))).To(Equal(expected))
+	})
+})
+
+var _ = Describe("file inclusions - remote files", func() {
+
+	var server *httptest.Server
+	var requests []*http.Request
+
+	BeforeEach(func() {
+		requests = []*http.Request{}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/snippets/license.adoc", func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte("Apache License 2.0\n")) // nolint: errcheck
+		})
+		mux.HandleFunc("/snippets/parent.adoc", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("include::license.adoc[]\n")) // nolint: errcheck
+		})
+		mux.HandleFunc("/snippets/slow.adoc", func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+			w.Write([]byte("slow\n")) // nolint: errcheck
+		})
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	license := types.DraftDocument{
+		Blocks: []interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{
+							Content: "Apache License 2.0",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	unresolved := func(source string) types.DraftDocument {
+		return types.DraftDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Attributes: types.ElementAttributes{},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "Unresolved directive in test.adoc - " + source,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should include remote file", func() {
+		source := "include::" + server.URL + "/snippets/license.adoc[]"
+		Expect(ParseDraftDocument(source, configuration.WithAttribute(configuration.AttrAllowURIRead, ""))).To(Equal(license))
+	})
+
+	It("should include remote file from remote file", func() {
+		source := "include::" + server.URL + "/snippets/parent.adoc[]"
+		Expect(ParseDraftDocument(source, configuration.WithAttribute(configuration.AttrAllowURIRead, ""))).To(Equal(license))
+	})
+
+	It("should not include remote file when attribute is not set", func() {
+		source := "include::" + server.URL + "/snippets/license.adoc[]"
+		Expect(ParseDraftDocument(source)).To(Equal(unresolved(source)))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should not include remote file in secure mode", func() {
+		source := "include::" + server.URL + "/snippets/license.adoc[]"
+		Expect(ParseDraftDocument(source,
+			configuration.WithAttribute(configuration.AttrAllowURIRead, ""),
+			configuration.WithSafeMode(configuration.Secure))).To(Equal(unresolved(source)))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should not include missing remote file", func() {
+		source := "include::" + server.URL + "/snippets/unknown.adoc[]"
+		Expect(ParseDraftDocument(source, configuration.WithAttribute(configuration.AttrAllowURIRead, ""))).To(Equal(unresolved(source)))
+	})
+
+	It("should not include remote file exceeding the maximum size", func() {
+		source := "include::" + server.URL + "/snippets/license.adoc[]"
+		Expect(ParseDraftDocument(source,
+			configuration.WithAttribute(configuration.AttrAllowURIRead, ""),
+			configuration.WithURIReadMaxSize(10))).To(Equal(unresolved(source)))
+	})
+
+	It("should not include remote file after timeout", func() {
+		source := "include::" + server.URL + "/snippets/slow.adoc[]"
+		Expect(ParseDraftDocument(source,
+			configuration.WithAttribute(configuration.AttrAllowURIRead, ""),
+			configuration.WithURIReadTimeout(50*time.Millisecond))).To(Equal(unresolved(source)))
+	})
+
+	It("should abort the request when the context of the conversion is done", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		f, _, err := configuration.URIIncludeResolver{}.Resolve(ctx, server.URL+"/snippets/slow.adoc", types.ElementAttributes{}, "test.adoc")
+		Expect(err).To(HaveOccurred())
+		Expect(f).To(BeNil())
+		Expect(err.Error()).To(HaveSuffix(context.DeadlineExceeded.Error()))
+		Expect(time.Since(start)).To(BeNumerically("<", 200*time.Millisecond))
+	})
+
+	It("should include remote file using the custom HTTP client", func() {
+		source := "include::https://snippets.example.com/snippets/license.adoc[]"
+		client := server.Client()
+		client.Transport = rewriteHostTransport{
+			host:      strings.TrimPrefix(server.URL, "http://"),
+			transport: http.DefaultTransport,
+		}
+		Expect(ParseDraftDocument(source,
+			configuration.WithAttribute(configuration.AttrAllowURIRead, ""),
+			configuration.WithHTTPClient(client))).To(Equal(license))
+	})
+
+	It("should include remote file from cache", func() {
+		cacheDir, err := ioutil.TempDir("", "libasciidoc-cache")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(cacheDir)
+		source := "include::" + server.URL + "/snippets/license.adoc[]"
+		// first call: response is cached
+		Expect(ParseDraftDocument(source,
+			configuration.WithAttribute(configuration.AttrAllowURIRead, ""),
+			configuration.WithURIReadCacheDir(cacheDir))).To(Equal(license))
+		// second call: conditional request
+		Expect(ParseDraftDocument(source,
+			configuration.WithAttribute(configuration.AttrAllowURIRead, ""),
+			configuration.WithURIReadCacheDir(cacheDir))).To(Equal(license))
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Header.Get("If-None-Match")).To(BeEmpty())
+		Expect(requests[1].Header.Get("If-None-Match")).To(Equal(`"v1"`))
+	})
+})
+
+// rewriteHostTransport sends all requests to the given host, over HTTP
+type rewriteHostTransport struct {
+	host      string
+	transport http.RoundTripper
+}
+
+func (t rewriteHostTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme = "http"
+	r.URL.Host = t.host
+	return t.transport.RoundTrip(r)
+}
@@ -122,7 +122,8 @@ var _ = Describe("ordered lists - draft", func() {
 				Blocks: []interface{}{
 					types.OrderedListItem{
 						Attributes: types.ElementAttributes{
-							"lowerroman": nil,
+							types.AttrStyle: "lowerroman",
+							"lowerroman":    nil,
 						},
 						Level:          1,
 						NumberingStyle: types.Arabic,
@@ -166,8 +167,9 @@ var _ = Describe("ordered lists - draft", func() {
 						Level:          1,
 						NumberingStyle: types.Arabic,
 						Attributes: types.ElementAttributes{
-							"lowerroman": nil,
-							"start":      "5",
+							types.AttrStyle: "lowerroman",
+							"lowerroman":    nil,
+							"start":         "5",
 						},
 						Elements: elements,
 					},
@@ -546,7 +548,8 @@ var _ = Describe("ordered lists - draft", func() {
 						Level:          3,
 						NumberingStyle: types.LowerRoman,
 						Attributes: types.ElementAttributes{
-							"upperroman": nil,
+							types.AttrStyle: "upperroman",
+							"upperroman":    nil,
 						},
 						Elements: []interface{}{
 							types.Paragraph{
@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// ParseError an error which occurred while parsing a document, at a given position
+type ParseError struct {
+	// Filename the name of the file being parsed (may be empty)
+	Filename string
+	// Line the line of the error, starting at 1
+	Line int
+	// Column the column of the error, in runes, starting at 1
+	Column int
+	// Offset the offset of the error, in bytes, starting at 0
+	Offset int
+	// Rule the name of the grammar rule which failed (may be empty)
+	Rule string
+	// Expected the tokens which were expected at the position of the error (eg: `"**"`, `[ \t]` or `EOF`)
+	Expected []string
+	// Message the message of the error (eg: `invalid encoding`)
+	Message string
+	// Excerpt the line of the source document at which the error occurred, followed by a line with a caret
+	// below the column of the error
+	Excerpt string
+}
+
+// Error returns the error in the `filename:line:column: rule: message` format
+func (e *ParseError) Error() string {
+	buf := &strings.Builder{}
+	if e.Filename != "" {
+		buf.WriteString(e.Filename + ":")
+	}
+	fmt.Fprintf(buf, "%d:%d: ", e.Line, e.Column)
+	if e.Rule != "" {
+		buf.WriteString("rule " + e.Rule + ": ")
+	}
+	buf.WriteString(e.Message)
+	return buf.String()
+}
+
+// ParseErrors the errors which occurred while parsing a document
+type ParseErrors []*ParseError
+
+// Error returns the errors, one per line
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// As sets the given target with the first error, so that `errors.As(err, &parseErr)` works with a `*ParseError` target
+func (e ParseErrors) As(target interface{}) bool {
+	if t, ok := target.(**ParseError); ok && len(e) > 0 {
+		*t = e[0]
+		return true
+	}
+	return false
+}
+
+// parseReader parses the content of the given reader, and returns `ParseErrors` if the parsing failed
+func parseReader(filename string, r io.Reader, options ...Option) (interface{}, error) {
+	source, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Parse(filename, source, options...)
+	if err != nil {
+		return nil, newParseErrors(filename, source, err)
+	}
+	return result, nil
+}
+
+// newParseErrors converts the errors returned by the generated parser into `ParseErrors`, using the given source
+// to build the excerpts. Other errors are returned as-is
+func newParseErrors(filename string, source []byte, err error) error {
+	list, ok := err.(errList)
+	if !ok {
+		return err
+	}
+	result := make(ParseErrors, 0, len(list))
+	for _, e := range list {
+		pe, ok := e.(*parserError)
+		if !ok {
+			return err
+		}
+		result = append(result, &ParseError{
+			Filename: filename,
+			Line:     pe.pos.line,
+			Column:   pe.pos.col,
+			Offset:   pe.pos.offset,
+			Rule:     ruleName(pe.prefix),
+			Expected: pe.expected,
+			Message:  pe.Inner.Error(),
+			Excerpt:  excerpt(source, pe.pos),
+		})
+	}
+	return result
+}
+
+// ruleName returns the name of the rule in the given prefix of a parser error (eg: `file.adoc:1:9 (8): rule WS`)
+func ruleName(prefix string) string {
+	if i := strings.LastIndex(prefix, ": rule "); i != -1 {
+		return prefix[i+len(": rule "):]
+	}
+	return ""
+}
+
+// excerpt returns the line at the given position in the source, followed by a line with a caret below the column
+func excerpt(source []byte, pos position) string {
+	if pos.offset > len(source) {
+		return ""
+	}
+	start := bytes.LastIndexByte(source[:pos.offset], '\n') + 1
+	end := len(source)
+	if i := bytes.IndexByte(source[pos.offset:], '\n'); i != -1 {
+		end = pos.offset + i
+	}
+	line := strings.TrimSuffix(string(source[start:end]), "\r")
+	// keep the tabs so the caret is aligned with the column of the error
+	caret := &strings.Builder{}
+	for i, r := range []rune(line) {
+		if i >= pos.col-1 {
+			break
+		}
+		if r == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteRune('^')
+	return line + "\n" + caret.String()
+}
@@ -0,0 +1,63 @@
+package parser_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("parse errors", func() {
+
+	It("should return parse errors with position and excerpt", func() {
+		source := "a paragraph\n\thello \xff world"
+		_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+			configuration.WithFilename("doc.adoc")))
+		Expect(err).To(HaveOccurred())
+		parseErrs, ok := err.(parser.ParseErrors)
+		Expect(ok).To(BeTrue())
+		Expect(parseErrs).NotTo(BeEmpty())
+		Expect(*parseErrs[0]).To(Equal(parser.ParseError{
+			Filename: "doc.adoc",
+			Line:     2,
+			Column:   8,
+			Offset:   19,
+			Rule:     "WS",
+			Expected: []string{},
+			Message:  "invalid encoding",
+			Excerpt:  "\thello \xff world\n\t      ^",
+		}))
+		Expect(parseErrs[0]).To(MatchError("doc.adoc:2:8: rule WS: invalid encoding"))
+	})
+
+	It("should inspect parse error with errors.As", func() {
+		_, err := parser.ParseDocument(strings.NewReader("hello \xff world"), configuration.NewConfiguration())
+		var parseErr *parser.ParseError
+		Expect(errors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Line).To(Equal(1))
+		Expect(parseErr.Column).To(Equal(7))
+		Expect(parseErr.Excerpt).To(Equal("hello \xff world\n      ^"))
+	})
+
+	It("should return one error per line", func() {
+		err := parser.ParseErrors{
+			{
+				Filename: "doc.adoc",
+				Line:     1,
+				Column:   7,
+				Rule:     "WS",
+				Message:  "invalid encoding",
+			},
+			{
+				Line:    2,
+				Column:  1,
+				Message: "no match found",
+			},
+		}
+		Expect(err).To(MatchError("doc.adoc:1:7: rule WS: invalid encoding\n2:1: no match found"))
+	})
+})
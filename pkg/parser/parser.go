@@ -4,7 +4,6 @@ package parser
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -17,6 +16,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
 )
 
 var g = &grammar{
@@ -169,66 +169,70 @@ var g = &grammar{
 								name: "EOF",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 41, col: 23, offset: 1234},
+							run: (*parser).callonDocumentBlock5,
+						},
 						&labeledExpr{
-							pos:   position{line: 42, col: 5, offset: 1239},
+							pos:   position{line: 42, col: 5, offset: 1271},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 42, col: 12, offset: 1246},
+								pos: position{line: 42, col: 12, offset: 1278},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 42, col: 12, offset: 1246},
+										pos:  position{line: 42, col: 12, offset: 1278},
 										name: "SimpleParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 43, col: 11, offset: 1272},
+										pos:  position{line: 43, col: 11, offset: 1304},
 										name: "Section",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 44, col: 11, offset: 1291},
+										pos:  position{line: 44, col: 11, offset: 1323},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 45, col: 11, offset: 1316},
+										pos:  position{line: 45, col: 11, offset: 1348},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 46, col: 11, offset: 1340},
+										pos:  position{line: 46, col: 11, offset: 1372},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 47, col: 11, offset: 1394},
+										pos:  position{line: 47, col: 11, offset: 1426},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 48, col: 11, offset: 1416},
+										pos:  position{line: 48, col: 11, offset: 1448},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 49, col: 11, offset: 1435},
+										pos:  position{line: 49, col: 11, offset: 1467},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 50, col: 11, offset: 1486},
+										pos:  position{line: 50, col: 11, offset: 1518},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 51, col: 11, offset: 1510},
+										pos:  position{line: 51, col: 11, offset: 1542},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 52, col: 11, offset: 1550},
+										pos:  position{line: 52, col: 11, offset: 1582},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 53, col: 11, offset: 1584},
+										pos:  position{line: 53, col: 11, offset: 1616},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 54, col: 11, offset: 1621},
+										pos:  position{line: 54, col: 11, offset: 1653},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 55, col: 11, offset: 1646},
+										pos:  position{line: 55, col: 11, offset: 1678},
 										name: "Paragraph",
 									},
 								},
@@ -240,14 +244,14 @@ var g = &grammar{
 		},
 		{
 			name: "AsciidocDocumentBlocksWithinDelimitedBlock",
-			pos:  position{line: 59, col: 1, offset: 1684},
+			pos:  position{line: 59, col: 1, offset: 1716},
 			expr: &labeledExpr{
-				pos:   position{line: 59, col: 47, offset: 1730},
+				pos:   position{line: 59, col: 47, offset: 1762},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 59, col: 54, offset: 1737},
+					pos: position{line: 59, col: 54, offset: 1769},
 					expr: &ruleRefExpr{
-						pos:  position{line: 59, col: 55, offset: 1738},
+						pos:  position{line: 59, col: 55, offset: 1770},
 						name: "DocumentBlockWithinDelimitedBlock",
 					},
 				},
@@ -255,72 +259,76 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlockWithinDelimitedBlock",
-			pos:  position{line: 61, col: 1, offset: 1775},
+			pos:  position{line: 61, col: 1, offset: 1807},
 			expr: &actionExpr{
-				pos: position{line: 61, col: 38, offset: 1812},
+				pos: position{line: 61, col: 38, offset: 1844},
 				run: (*parser).callonDocumentBlockWithinDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 61, col: 38, offset: 1812},
+					pos: position{line: 61, col: 38, offset: 1844},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 61, col: 38, offset: 1812},
+							pos: position{line: 61, col: 38, offset: 1844},
 							expr: &ruleRefExpr{
-								pos:  position{line: 61, col: 39, offset: 1813},
+								pos:  position{line: 61, col: 39, offset: 1845},
 								name: "EOF",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 61, col: 43, offset: 1849},
+							run: (*parser).callonDocumentBlockWithinDelimitedBlock5,
+						},
 						&labeledExpr{
-							pos:   position{line: 62, col: 5, offset: 1822},
+							pos:   position{line: 62, col: 5, offset: 1886},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 62, col: 12, offset: 1829},
+								pos: position{line: 62, col: 12, offset: 1893},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 62, col: 12, offset: 1829},
+										pos:  position{line: 62, col: 12, offset: 1893},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 63, col: 11, offset: 1854},
+										pos:  position{line: 63, col: 11, offset: 1918},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 64, col: 11, offset: 1878},
+										pos:  position{line: 64, col: 11, offset: 1942},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 65, col: 11, offset: 1903},
+										pos:  position{line: 65, col: 11, offset: 1967},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 66, col: 11, offset: 1925},
+										pos:  position{line: 66, col: 11, offset: 1989},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 67, col: 11, offset: 1944},
+										pos:  position{line: 67, col: 11, offset: 2008},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 68, col: 11, offset: 1995},
+										pos:  position{line: 68, col: 11, offset: 2059},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 69, col: 11, offset: 2019},
+										pos:  position{line: 69, col: 11, offset: 2083},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 70, col: 11, offset: 2059},
+										pos:  position{line: 70, col: 11, offset: 2123},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 71, col: 11, offset: 2093},
+										pos:  position{line: 71, col: 11, offset: 2157},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 72, col: 11, offset: 2130},
+										pos:  position{line: 72, col: 11, offset: 2194},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 73, col: 11, offset: 2155},
+										pos:  position{line: 73, col: 11, offset: 2219},
 										name: "Paragraph",
 									},
 								},
@@ -332,14 +340,14 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlocks",
-			pos:  position{line: 77, col: 1, offset: 2193},
+			pos:  position{line: 77, col: 1, offset: 2257},
 			expr: &labeledExpr{
-				pos:   position{line: 77, col: 23, offset: 2215},
+				pos:   position{line: 77, col: 23, offset: 2279},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 77, col: 30, offset: 2222},
+					pos: position{line: 77, col: 30, offset: 2286},
 					expr: &ruleRefExpr{
-						pos:  position{line: 77, col: 31, offset: 2223},
+						pos:  position{line: 77, col: 31, offset: 2287},
 						name: "TextDocumentBlock",
 					},
 				},
@@ -347,32 +355,36 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlock",
-			pos:  position{line: 79, col: 1, offset: 2244},
+			pos:  position{line: 79, col: 1, offset: 2308},
 			expr: &actionExpr{
-				pos: position{line: 79, col: 22, offset: 2265},
+				pos: position{line: 79, col: 22, offset: 2329},
 				run: (*parser).callonTextDocumentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 79, col: 22, offset: 2265},
+					pos: position{line: 79, col: 22, offset: 2329},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 79, col: 22, offset: 2265},
+							pos: position{line: 79, col: 22, offset: 2329},
 							expr: &ruleRefExpr{
-								pos:  position{line: 79, col: 23, offset: 2266},
+								pos:  position{line: 79, col: 23, offset: 2330},
 								name: "EOF",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 79, col: 27, offset: 2334},
+							run: (*parser).callonTextDocumentBlock5,
+						},
 						&labeledExpr{
-							pos:   position{line: 80, col: 5, offset: 2275},
+							pos:   position{line: 80, col: 5, offset: 2371},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 80, col: 12, offset: 2282},
+								pos: position{line: 80, col: 12, offset: 2378},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 12, offset: 2282},
+										pos:  position{line: 80, col: 12, offset: 2378},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 24, offset: 2294},
+										pos:  position{line: 80, col: 24, offset: 2390},
 										name: "Paragraph",
 									},
 								},
@@ -384,38 +396,38 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 87, col: 1, offset: 2440},
+			pos:  position{line: 87, col: 1, offset: 2536},
 			expr: &ruleRefExpr{
-				pos:  position{line: 87, col: 16, offset: 2455},
+				pos:  position{line: 87, col: 16, offset: 2551},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "YamlFrontMatter",
-			pos:  position{line: 89, col: 1, offset: 2473},
+			pos:  position{line: 89, col: 1, offset: 2569},
 			expr: &actionExpr{
-				pos: position{line: 89, col: 20, offset: 2492},
+				pos: position{line: 89, col: 20, offset: 2588},
 				run: (*parser).callonYamlFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 89, col: 20, offset: 2492},
+					pos: position{line: 89, col: 20, offset: 2588},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 89, col: 20, offset: 2492},
+							pos:  position{line: 89, col: 20, offset: 2588},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 89, col: 41, offset: 2513},
+							pos:   position{line: 89, col: 41, offset: 2609},
 							label: "content",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 89, col: 49, offset: 2521},
+								pos: position{line: 89, col: 49, offset: 2617},
 								expr: &ruleRefExpr{
-									pos:  position{line: 89, col: 50, offset: 2522},
+									pos:  position{line: 89, col: 50, offset: 2618},
 									name: "YamlFrontMatterContent",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 89, col: 75, offset: 2547},
+							pos:  position{line: 89, col: 75, offset: 2643},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -424,24 +436,24 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 93, col: 1, offset: 2627},
+			pos:  position{line: 93, col: 1, offset: 2723},
 			expr: &seqExpr{
-				pos: position{line: 93, col: 26, offset: 2652},
+				pos: position{line: 93, col: 26, offset: 2748},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 93, col: 26, offset: 2652},
+						pos:        position{line: 93, col: 26, offset: 2748},
 						val:        "---",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 93, col: 32, offset: 2658},
+						pos: position{line: 93, col: 32, offset: 2754},
 						expr: &ruleRefExpr{
-							pos:  position{line: 93, col: 32, offset: 2658},
+							pos:  position{line: 93, col: 32, offset: 2754},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 36, offset: 2662},
+						pos:  position{line: 93, col: 36, offset: 2758},
 						name: "EOL",
 					},
 				},
@@ -449,26 +461,26 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 95, col: 1, offset: 2667},
+			pos:  position{line: 95, col: 1, offset: 2763},
 			expr: &actionExpr{
-				pos: position{line: 95, col: 27, offset: 2693},
+				pos: position{line: 95, col: 27, offset: 2789},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 95, col: 27, offset: 2693},
+					pos: position{line: 95, col: 27, offset: 2789},
 					expr: &oneOrMoreExpr{
-						pos: position{line: 95, col: 28, offset: 2694},
+						pos: position{line: 95, col: 28, offset: 2790},
 						expr: &seqExpr{
-							pos: position{line: 95, col: 29, offset: 2695},
+							pos: position{line: 95, col: 29, offset: 2791},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 95, col: 29, offset: 2695},
+									pos: position{line: 95, col: 29, offset: 2791},
 									expr: &ruleRefExpr{
-										pos:  position{line: 95, col: 30, offset: 2696},
+										pos:  position{line: 95, col: 30, offset: 2792},
 										name: "YamlFrontMatterToken",
 									},
 								},
 								&anyMatcher{
-									line: 95, col: 51, offset: 2717,
+									line: 95, col: 51, offset: 2813,
 								},
 							},
 						},
@@ -478,98 +490,98 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 102, col: 1, offset: 2883},
+			pos:  position{line: 102, col: 1, offset: 2979},
 			expr: &actionExpr{
-				pos: position{line: 102, col: 19, offset: 2901},
+				pos: position{line: 102, col: 19, offset: 2997},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 102, col: 19, offset: 2901},
+					pos: position{line: 102, col: 19, offset: 2997},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 102, col: 19, offset: 2901},
+							pos:        position{line: 102, col: 19, offset: 2997},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 102, col: 23, offset: 2905},
+							pos: position{line: 102, col: 23, offset: 3001},
 							expr: &ruleRefExpr{
-								pos:  position{line: 102, col: 23, offset: 2905},
+								pos:  position{line: 102, col: 23, offset: 3001},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 102, col: 27, offset: 2909},
+							pos:   position{line: 102, col: 27, offset: 3005},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 102, col: 34, offset: 2916},
+								pos:  position{line: 102, col: 34, offset: 3012},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 102, col: 49, offset: 2931},
+							pos:   position{line: 102, col: 49, offset: 3027},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 102, col: 53, offset: 2935},
+								pos: position{line: 102, col: 53, offset: 3031},
 								expr: &ruleRefExpr{
-									pos:  position{line: 102, col: 53, offset: 2935},
+									pos:  position{line: 102, col: 53, offset: 3031},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 102, col: 71, offset: 2953},
+							pos:  position{line: 102, col: 71, offset: 3049},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 103, col: 9, offset: 2965},
+							pos: position{line: 103, col: 9, offset: 3061},
 							expr: &choiceExpr{
-								pos: position{line: 103, col: 10, offset: 2966},
+								pos: position{line: 103, col: 10, offset: 3062},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 103, col: 10, offset: 2966},
+										pos:  position{line: 103, col: 10, offset: 3062},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 103, col: 30, offset: 2986},
+										pos:  position{line: 103, col: 30, offset: 3082},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 104, col: 9, offset: 3009},
+							pos:   position{line: 104, col: 9, offset: 3105},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 104, col: 18, offset: 3018},
+								pos: position{line: 104, col: 18, offset: 3114},
 								expr: &ruleRefExpr{
-									pos:  position{line: 104, col: 18, offset: 3018},
+									pos:  position{line: 104, col: 18, offset: 3114},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 105, col: 9, offset: 3045},
+							pos: position{line: 105, col: 9, offset: 3141},
 							expr: &choiceExpr{
-								pos: position{line: 105, col: 10, offset: 3046},
+								pos: position{line: 105, col: 10, offset: 3142},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 105, col: 10, offset: 3046},
+										pos:  position{line: 105, col: 10, offset: 3142},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 105, col: 30, offset: 3066},
+										pos:  position{line: 105, col: 30, offset: 3162},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 106, col: 9, offset: 3089},
+							pos:   position{line: 106, col: 9, offset: 3185},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 106, col: 19, offset: 3099},
+								pos: position{line: 106, col: 19, offset: 3195},
 								expr: &ruleRefExpr{
-									pos:  position{line: 106, col: 19, offset: 3099},
+									pos:  position{line: 106, col: 19, offset: 3195},
 									name: "DocumentRevision",
 								},
 							},
@@ -580,16 +592,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 110, col: 1, offset: 3200},
+			pos:  position{line: 110, col: 1, offset: 3296},
 			expr: &choiceExpr{
-				pos: position{line: 110, col: 20, offset: 3219},
+				pos: position{line: 110, col: 20, offset: 3315},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 110, col: 20, offset: 3219},
+						pos:  position{line: 110, col: 20, offset: 3315},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 110, col: 48, offset: 3247},
+						pos:  position{line: 110, col: 48, offset: 3343},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -597,41 +609,41 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 112, col: 1, offset: 3277},
+			pos:  position{line: 112, col: 1, offset: 3373},
 			expr: &actionExpr{
-				pos: position{line: 112, col: 30, offset: 3306},
+				pos: position{line: 112, col: 30, offset: 3402},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 112, col: 30, offset: 3306},
+					pos: position{line: 112, col: 30, offset: 3402},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 112, col: 30, offset: 3306},
+							pos: position{line: 112, col: 30, offset: 3402},
 							expr: &ruleRefExpr{
-								pos:  position{line: 112, col: 30, offset: 3306},
+								pos:  position{line: 112, col: 30, offset: 3402},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 112, col: 34, offset: 3310},
+							pos: position{line: 112, col: 34, offset: 3406},
 							expr: &litMatcher{
-								pos:        position{line: 112, col: 35, offset: 3311},
+								pos:        position{line: 112, col: 35, offset: 3407},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 112, col: 39, offset: 3315},
+							pos:   position{line: 112, col: 39, offset: 3411},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 112, col: 48, offset: 3324},
+								pos: position{line: 112, col: 48, offset: 3420},
 								expr: &ruleRefExpr{
-									pos:  position{line: 112, col: 48, offset: 3324},
+									pos:  position{line: 112, col: 48, offset: 3420},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 112, col: 65, offset: 3341},
+							pos:  position{line: 112, col: 65, offset: 3437},
 							name: "EOL",
 						},
 					},
@@ -640,35 +652,35 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 116, col: 1, offset: 3411},
+			pos:  position{line: 116, col: 1, offset: 3507},
 			expr: &actionExpr{
-				pos: position{line: 116, col: 33, offset: 3443},
+				pos: position{line: 116, col: 33, offset: 3539},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 116, col: 33, offset: 3443},
+					pos: position{line: 116, col: 33, offset: 3539},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 116, col: 33, offset: 3443},
+							pos: position{line: 116, col: 33, offset: 3539},
 							expr: &ruleRefExpr{
-								pos:  position{line: 116, col: 33, offset: 3443},
+								pos:  position{line: 116, col: 33, offset: 3539},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 116, col: 37, offset: 3447},
+							pos:        position{line: 116, col: 37, offset: 3543},
 							val:        ":author:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 116, col: 48, offset: 3458},
+							pos:   position{line: 116, col: 48, offset: 3554},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 116, col: 56, offset: 3466},
+								pos:  position{line: 116, col: 56, offset: 3562},
 								name: "DocumentAuthor",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 72, offset: 3482},
+							pos:  position{line: 116, col: 72, offset: 3578},
 							name: "EOL",
 						},
 					},
@@ -677,58 +689,58 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 120, col: 1, offset: 3561},
+			pos:  position{line: 120, col: 1, offset: 3657},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 19, offset: 3579},
+				pos: position{line: 120, col: 19, offset: 3675},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 120, col: 19, offset: 3579},
+					pos: position{line: 120, col: 19, offset: 3675},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 120, col: 19, offset: 3579},
+							pos: position{line: 120, col: 19, offset: 3675},
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 19, offset: 3579},
+								pos:  position{line: 120, col: 19, offset: 3675},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 23, offset: 3583},
+							pos:   position{line: 120, col: 23, offset: 3679},
 							label: "fullname",
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 33, offset: 3593},
+								pos:  position{line: 120, col: 33, offset: 3689},
 								name: "DocumentAuthorName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 53, offset: 3613},
+							pos:   position{line: 120, col: 53, offset: 3709},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 120, col: 59, offset: 3619},
+								pos: position{line: 120, col: 59, offset: 3715},
 								expr: &ruleRefExpr{
-									pos:  position{line: 120, col: 60, offset: 3620},
+									pos:  position{line: 120, col: 60, offset: 3716},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 120, col: 82, offset: 3642},
+							pos: position{line: 120, col: 82, offset: 3738},
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 82, offset: 3642},
+								pos:  position{line: 120, col: 82, offset: 3738},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 120, col: 86, offset: 3646},
+							pos: position{line: 120, col: 86, offset: 3742},
 							expr: &litMatcher{
-								pos:        position{line: 120, col: 86, offset: 3646},
+								pos:        position{line: 120, col: 86, offset: 3742},
 								val:        ";",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 120, col: 91, offset: 3651},
+							pos: position{line: 120, col: 91, offset: 3747},
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 91, offset: 3651},
+								pos:  position{line: 120, col: 91, offset: 3747},
 								name: "WS",
 							},
 						},
@@ -738,47 +750,47 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorName",
-			pos:  position{line: 125, col: 1, offset: 3793},
+			pos:  position{line: 125, col: 1, offset: 3889},
 			expr: &actionExpr{
-				pos: position{line: 125, col: 23, offset: 3815},
+				pos: position{line: 125, col: 23, offset: 3911},
 				run: (*parser).callonDocumentAuthorName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 125, col: 23, offset: 3815},
+					pos: position{line: 125, col: 23, offset: 3911},
 					expr: &choiceExpr{
-						pos: position{line: 125, col: 24, offset: 3816},
+						pos: position{line: 125, col: 24, offset: 3912},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 125, col: 24, offset: 3816},
+								pos:  position{line: 125, col: 24, offset: 3912},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 125, col: 37, offset: 3829},
+								pos: position{line: 125, col: 37, offset: 3925},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 125, col: 37, offset: 3829},
+										pos: position{line: 125, col: 37, offset: 3925},
 										expr: &litMatcher{
-											pos:        position{line: 125, col: 38, offset: 3830},
+											pos:        position{line: 125, col: 38, offset: 3926},
 											val:        "<",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 125, col: 42, offset: 3834},
+										pos: position{line: 125, col: 42, offset: 3930},
 										expr: &litMatcher{
-											pos:        position{line: 125, col: 43, offset: 3835},
+											pos:        position{line: 125, col: 43, offset: 3931},
 											val:        ";",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 125, col: 47, offset: 3839},
+										pos: position{line: 125, col: 47, offset: 3935},
 										expr: &ruleRefExpr{
-											pos:  position{line: 125, col: 48, offset: 3840},
+											pos:  position{line: 125, col: 48, offset: 3936},
 											name: "Newline",
 										},
 									},
 									&anyMatcher{
-										line: 125, col: 56, offset: 3848,
+										line: 125, col: 56, offset: 3944,
 									},
 								},
 							},
@@ -789,53 +801,53 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 129, col: 1, offset: 3889},
+			pos:  position{line: 129, col: 1, offset: 3985},
 			expr: &actionExpr{
-				pos: position{line: 129, col: 24, offset: 3912},
+				pos: position{line: 129, col: 24, offset: 4008},
 				run: (*parser).callonDocumentAuthorEmail1,
 				expr: &seqExpr{
-					pos: position{line: 129, col: 24, offset: 3912},
+					pos: position{line: 129, col: 24, offset: 4008},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 129, col: 24, offset: 3912},
+							pos:        position{line: 129, col: 24, offset: 4008},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 129, col: 28, offset: 3916},
+							pos:   position{line: 129, col: 28, offset: 4012},
 							label: "email",
 							expr: &actionExpr{
-								pos: position{line: 129, col: 35, offset: 3923},
+								pos: position{line: 129, col: 35, offset: 4019},
 								run: (*parser).callonDocumentAuthorEmail5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 129, col: 35, offset: 3923},
+									pos: position{line: 129, col: 35, offset: 4019},
 									expr: &choiceExpr{
-										pos: position{line: 129, col: 36, offset: 3924},
+										pos: position{line: 129, col: 36, offset: 4020},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 129, col: 36, offset: 3924},
+												pos:  position{line: 129, col: 36, offset: 4020},
 												name: "Alphanums",
 											},
 											&seqExpr{
-												pos: position{line: 129, col: 49, offset: 3937},
+												pos: position{line: 129, col: 49, offset: 4033},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 129, col: 49, offset: 3937},
+														pos: position{line: 129, col: 49, offset: 4033},
 														expr: &litMatcher{
-															pos:        position{line: 129, col: 50, offset: 3938},
+															pos:        position{line: 129, col: 50, offset: 4034},
 															val:        ">",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 129, col: 54, offset: 3942},
+														pos: position{line: 129, col: 54, offset: 4038},
 														expr: &ruleRefExpr{
-															pos:  position{line: 129, col: 55, offset: 3943},
+															pos:  position{line: 129, col: 55, offset: 4039},
 															name: "EOL",
 														},
 													},
 													&anyMatcher{
-														line: 129, col: 60, offset: 3948,
+														line: 129, col: 60, offset: 4044,
 													},
 												},
 											},
@@ -845,7 +857,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 131, col: 4, offset: 3989},
+							pos:        position{line: 131, col: 4, offset: 4085},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -855,82 +867,82 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 137, col: 1, offset: 4150},
+			pos:  position{line: 137, col: 1, offset: 4246},
 			expr: &actionExpr{
-				pos: position{line: 137, col: 21, offset: 4170},
+				pos: position{line: 137, col: 21, offset: 4266},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 137, col: 21, offset: 4170},
+					pos: position{line: 137, col: 21, offset: 4266},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 137, col: 21, offset: 4170},
+							pos: position{line: 137, col: 21, offset: 4266},
 							expr: &ruleRefExpr{
-								pos:  position{line: 137, col: 21, offset: 4170},
+								pos:  position{line: 137, col: 21, offset: 4266},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 137, col: 25, offset: 4174},
+							pos: position{line: 137, col: 25, offset: 4270},
 							expr: &litMatcher{
-								pos:        position{line: 137, col: 26, offset: 4175},
+								pos:        position{line: 137, col: 26, offset: 4271},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 137, col: 30, offset: 4179},
+							pos:   position{line: 137, col: 30, offset: 4275},
 							label: "revision",
 							expr: &choiceExpr{
-								pos: position{line: 138, col: 9, offset: 4198},
+								pos: position{line: 138, col: 9, offset: 4294},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 138, col: 10, offset: 4199},
+										pos: position{line: 138, col: 10, offset: 4295},
 										run: (*parser).callonDocumentRevision9,
 										expr: &seqExpr{
-											pos: position{line: 138, col: 10, offset: 4199},
+											pos: position{line: 138, col: 10, offset: 4295},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 138, col: 10, offset: 4199},
+													pos:   position{line: 138, col: 10, offset: 4295},
 													label: "revnumber",
 													expr: &ruleRefExpr{
-														pos:  position{line: 138, col: 21, offset: 4210},
+														pos:  position{line: 138, col: 21, offset: 4306},
 														name: "DocumentRevisionNumber",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 138, col: 45, offset: 4234},
+													pos: position{line: 138, col: 45, offset: 4330},
 													expr: &litMatcher{
-														pos:        position{line: 138, col: 45, offset: 4234},
+														pos:        position{line: 138, col: 45, offset: 4330},
 														val:        ",",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 138, col: 50, offset: 4239},
+													pos:   position{line: 138, col: 50, offset: 4335},
 													label: "revdate",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 138, col: 58, offset: 4247},
+														pos: position{line: 138, col: 58, offset: 4343},
 														expr: &ruleRefExpr{
-															pos:  position{line: 138, col: 59, offset: 4248},
+															pos:  position{line: 138, col: 59, offset: 4344},
 															name: "DocumentRevisionDate",
 														},
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 138, col: 82, offset: 4271},
+													pos: position{line: 138, col: 82, offset: 4367},
 													expr: &litMatcher{
-														pos:        position{line: 138, col: 82, offset: 4271},
+														pos:        position{line: 138, col: 82, offset: 4367},
 														val:        ":",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 138, col: 87, offset: 4276},
+													pos:   position{line: 138, col: 87, offset: 4372},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 138, col: 97, offset: 4286},
+														pos: position{line: 138, col: 97, offset: 4382},
 														expr: &ruleRefExpr{
-															pos:  position{line: 138, col: 98, offset: 4287},
+															pos:  position{line: 138, col: 98, offset: 4383},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -939,34 +951,34 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 140, col: 15, offset: 4404},
+										pos: position{line: 140, col: 15, offset: 4500},
 										run: (*parser).callonDocumentRevision23,
 										expr: &seqExpr{
-											pos: position{line: 140, col: 15, offset: 4404},
+											pos: position{line: 140, col: 15, offset: 4500},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 140, col: 15, offset: 4404},
+													pos:   position{line: 140, col: 15, offset: 4500},
 													label: "revdate",
 													expr: &ruleRefExpr{
-														pos:  position{line: 140, col: 24, offset: 4413},
+														pos:  position{line: 140, col: 24, offset: 4509},
 														name: "DocumentRevisionDate",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 140, col: 46, offset: 4435},
+													pos: position{line: 140, col: 46, offset: 4531},
 													expr: &litMatcher{
-														pos:        position{line: 140, col: 46, offset: 4435},
+														pos:        position{line: 140, col: 46, offset: 4531},
 														val:        ":",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 140, col: 51, offset: 4440},
+													pos:   position{line: 140, col: 51, offset: 4536},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 140, col: 61, offset: 4450},
+														pos: position{line: 140, col: 61, offset: 4546},
 														expr: &ruleRefExpr{
-															pos:  position{line: 140, col: 62, offset: 4451},
+															pos:  position{line: 140, col: 62, offset: 4547},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -978,7 +990,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 142, col: 13, offset: 4560},
+							pos:  position{line: 142, col: 13, offset: 4656},
 							name: "EOL",
 						},
 					},
@@ -987,66 +999,66 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 147, col: 1, offset: 4690},
+			pos:  position{line: 147, col: 1, offset: 4786},
 			expr: &choiceExpr{
-				pos: position{line: 147, col: 27, offset: 4716},
+				pos: position{line: 147, col: 27, offset: 4812},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 147, col: 27, offset: 4716},
+						pos: position{line: 147, col: 27, offset: 4812},
 						run: (*parser).callonDocumentRevisionNumber2,
 						expr: &seqExpr{
-							pos: position{line: 147, col: 27, offset: 4716},
+							pos: position{line: 147, col: 27, offset: 4812},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 147, col: 27, offset: 4716},
+									pos:        position{line: 147, col: 27, offset: 4812},
 									val:        "v",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 147, col: 32, offset: 4721},
+									pos:  position{line: 147, col: 32, offset: 4817},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 147, col: 39, offset: 4728},
+									pos: position{line: 147, col: 39, offset: 4824},
 									expr: &choiceExpr{
-										pos: position{line: 147, col: 40, offset: 4729},
+										pos: position{line: 147, col: 40, offset: 4825},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 147, col: 40, offset: 4729},
+												pos:  position{line: 147, col: 40, offset: 4825},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 147, col: 52, offset: 4741},
+												pos:  position{line: 147, col: 52, offset: 4837},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 147, col: 62, offset: 4751},
+												pos: position{line: 147, col: 62, offset: 4847},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 147, col: 62, offset: 4751},
+														pos: position{line: 147, col: 62, offset: 4847},
 														expr: &ruleRefExpr{
-															pos:  position{line: 147, col: 63, offset: 4752},
+															pos:  position{line: 147, col: 63, offset: 4848},
 															name: "EOL",
 														},
 													},
 													&notExpr{
-														pos: position{line: 147, col: 67, offset: 4756},
+														pos: position{line: 147, col: 67, offset: 4852},
 														expr: &litMatcher{
-															pos:        position{line: 147, col: 68, offset: 4757},
+															pos:        position{line: 147, col: 68, offset: 4853},
 															val:        ",",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 147, col: 72, offset: 4761},
+														pos: position{line: 147, col: 72, offset: 4857},
 														expr: &litMatcher{
-															pos:        position{line: 147, col: 73, offset: 4762},
+															pos:        position{line: 147, col: 73, offset: 4858},
 															val:        ":",
 															ignoreCase: false,
 														},
 													},
 													&anyMatcher{
-														line: 147, col: 78, offset: 4767,
+														line: 147, col: 78, offset: 4863,
 													},
 												},
 											},
@@ -1057,64 +1069,64 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 149, col: 5, offset: 4809},
+						pos: position{line: 149, col: 5, offset: 4905},
 						run: (*parser).callonDocumentRevisionNumber18,
 						expr: &seqExpr{
-							pos: position{line: 149, col: 5, offset: 4809},
+							pos: position{line: 149, col: 5, offset: 4905},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 149, col: 5, offset: 4809},
+									pos: position{line: 149, col: 5, offset: 4905},
 									expr: &litMatcher{
-										pos:        position{line: 149, col: 5, offset: 4809},
+										pos:        position{line: 149, col: 5, offset: 4905},
 										val:        "v",
 										ignoreCase: true,
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 149, col: 11, offset: 4815},
+									pos:  position{line: 149, col: 11, offset: 4911},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 149, col: 18, offset: 4822},
+									pos: position{line: 149, col: 18, offset: 4918},
 									expr: &choiceExpr{
-										pos: position{line: 149, col: 19, offset: 4823},
+										pos: position{line: 149, col: 19, offset: 4919},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 149, col: 19, offset: 4823},
+												pos:  position{line: 149, col: 19, offset: 4919},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 149, col: 31, offset: 4835},
+												pos:  position{line: 149, col: 31, offset: 4931},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 149, col: 41, offset: 4845},
+												pos: position{line: 149, col: 41, offset: 4941},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 149, col: 41, offset: 4845},
+														pos: position{line: 149, col: 41, offset: 4941},
 														expr: &ruleRefExpr{
-															pos:  position{line: 149, col: 42, offset: 4846},
+															pos:  position{line: 149, col: 42, offset: 4942},
 															name: "EOL",
 														},
 													},
 													&notExpr{
-														pos: position{line: 149, col: 46, offset: 4850},
+														pos: position{line: 149, col: 46, offset: 4946},
 														expr: &litMatcher{
-															pos:        position{line: 149, col: 47, offset: 4851},
+															pos:        position{line: 149, col: 47, offset: 4947},
 															val:        ",",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 149, col: 51, offset: 4855},
+														pos: position{line: 149, col: 51, offset: 4951},
 														expr: &litMatcher{
-															pos:        position{line: 149, col: 52, offset: 4856},
+															pos:        position{line: 149, col: 52, offset: 4952},
 															val:        ":",
 															ignoreCase: false,
 														},
 													},
 													&anyMatcher{
-														line: 149, col: 57, offset: 4861,
+														line: 149, col: 57, offset: 4957,
 													},
 												},
 											},
@@ -1122,16 +1134,16 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 149, col: 62, offset: 4866},
+									pos: position{line: 149, col: 62, offset: 4962},
 									expr: &ruleRefExpr{
-										pos:  position{line: 149, col: 62, offset: 4866},
+										pos:  position{line: 149, col: 62, offset: 4962},
 										name: "WS",
 									},
 								},
 								&andExpr{
-									pos: position{line: 149, col: 66, offset: 4870},
+									pos: position{line: 149, col: 66, offset: 4966},
 									expr: &litMatcher{
-										pos:        position{line: 149, col: 67, offset: 4871},
+										pos:        position{line: 149, col: 67, offset: 4967},
 										val:        ",",
 										ignoreCase: false,
 									},
@@ -1144,43 +1156,43 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 153, col: 1, offset: 4911},
+			pos:  position{line: 153, col: 1, offset: 5007},
 			expr: &actionExpr{
-				pos: position{line: 153, col: 25, offset: 4935},
+				pos: position{line: 153, col: 25, offset: 5031},
 				run: (*parser).callonDocumentRevisionDate1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 153, col: 25, offset: 4935},
+					pos: position{line: 153, col: 25, offset: 5031},
 					expr: &choiceExpr{
-						pos: position{line: 153, col: 26, offset: 4936},
+						pos: position{line: 153, col: 26, offset: 5032},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 153, col: 26, offset: 4936},
+								pos:  position{line: 153, col: 26, offset: 5032},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 153, col: 38, offset: 4948},
+								pos:  position{line: 153, col: 38, offset: 5044},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 153, col: 48, offset: 4958},
+								pos: position{line: 153, col: 48, offset: 5054},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 153, col: 48, offset: 4958},
+										pos: position{line: 153, col: 48, offset: 5054},
 										expr: &ruleRefExpr{
-											pos:  position{line: 153, col: 49, offset: 4959},
+											pos:  position{line: 153, col: 49, offset: 5055},
 											name: "EOL",
 										},
 									},
 									&notExpr{
-										pos: position{line: 153, col: 53, offset: 4963},
+										pos: position{line: 153, col: 53, offset: 5059},
 										expr: &litMatcher{
-											pos:        position{line: 153, col: 54, offset: 4964},
+											pos:        position{line: 153, col: 54, offset: 5060},
 											val:        ":",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 153, col: 59, offset: 4969,
+										line: 153, col: 59, offset: 5065,
 									},
 								},
 							},
@@ -1191,35 +1203,35 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 157, col: 1, offset: 5010},
+			pos:  position{line: 157, col: 1, offset: 5106},
 			expr: &actionExpr{
-				pos: position{line: 157, col: 27, offset: 5036},
+				pos: position{line: 157, col: 27, offset: 5132},
 				run: (*parser).callonDocumentRevisionRemark1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 157, col: 27, offset: 5036},
+					pos: position{line: 157, col: 27, offset: 5132},
 					expr: &choiceExpr{
-						pos: position{line: 157, col: 28, offset: 5037},
+						pos: position{line: 157, col: 28, offset: 5133},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 157, col: 28, offset: 5037},
+								pos:  position{line: 157, col: 28, offset: 5133},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 157, col: 40, offset: 5049},
+								pos:  position{line: 157, col: 40, offset: 5145},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 157, col: 50, offset: 5059},
+								pos: position{line: 157, col: 50, offset: 5155},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 157, col: 50, offset: 5059},
+										pos: position{line: 157, col: 50, offset: 5155},
 										expr: &ruleRefExpr{
-											pos:  position{line: 157, col: 51, offset: 5060},
+											pos:  position{line: 157, col: 51, offset: 5156},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 157, col: 56, offset: 5065,
+										line: 157, col: 56, offset: 5161,
 									},
 								},
 							},
@@ -1230,54 +1242,54 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeDeclaration",
-			pos:  position{line: 164, col: 1, offset: 5221},
+			pos:  position{line: 164, col: 1, offset: 5317},
 			expr: &actionExpr{
-				pos: position{line: 164, col: 33, offset: 5253},
+				pos: position{line: 164, col: 33, offset: 5349},
 				run: (*parser).callonDocumentAttributeDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 164, col: 33, offset: 5253},
+					pos: position{line: 164, col: 33, offset: 5349},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 164, col: 33, offset: 5253},
+							pos:        position{line: 164, col: 33, offset: 5349},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 164, col: 37, offset: 5257},
+							pos:   position{line: 164, col: 37, offset: 5353},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 164, col: 43, offset: 5263},
+								pos:  position{line: 164, col: 43, offset: 5359},
 								name: "DocumentAttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 164, col: 66, offset: 5286},
+							pos:        position{line: 164, col: 66, offset: 5382},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 164, col: 70, offset: 5290},
+							pos:   position{line: 164, col: 70, offset: 5386},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 164, col: 76, offset: 5296},
+								pos: position{line: 164, col: 76, offset: 5392},
 								expr: &actionExpr{
-									pos: position{line: 164, col: 77, offset: 5297},
+									pos: position{line: 164, col: 77, offset: 5393},
 									run: (*parser).callonDocumentAttributeDeclaration9,
 									expr: &seqExpr{
-										pos: position{line: 164, col: 78, offset: 5298},
+										pos: position{line: 164, col: 78, offset: 5394},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 164, col: 78, offset: 5298},
+												pos: position{line: 164, col: 78, offset: 5394},
 												expr: &ruleRefExpr{
-													pos:  position{line: 164, col: 78, offset: 5298},
+													pos:  position{line: 164, col: 78, offset: 5394},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 164, col: 82, offset: 5302},
+												pos:   position{line: 164, col: 82, offset: 5398},
 												label: "value",
 												expr: &ruleRefExpr{
-													pos:  position{line: 164, col: 89, offset: 5309},
+													pos:  position{line: 164, col: 89, offset: 5405},
 													name: "DocumentAttributeValue",
 												},
 											},
@@ -1287,14 +1299,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 164, col: 138, offset: 5358},
+							pos: position{line: 164, col: 138, offset: 5454},
 							expr: &ruleRefExpr{
-								pos:  position{line: 164, col: 138, offset: 5358},
+								pos:  position{line: 164, col: 138, offset: 5454},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 164, col: 142, offset: 5362},
+							pos:  position{line: 164, col: 142, offset: 5458},
 							name: "EOL",
 						},
 					},
@@ -1303,72 +1315,77 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeName",
-			pos:  position{line: 171, col: 1, offset: 5610},
+			pos:  position{line: 171, col: 1, offset: 5706},
 			expr: &actionExpr{
-				pos: position{line: 171, col: 26, offset: 5635},
+				pos: position{line: 171, col: 26, offset: 5731},
 				run: (*parser).callonDocumentAttributeName1,
 				expr: &seqExpr{
-					pos: position{line: 171, col: 26, offset: 5635},
+					pos: position{line: 171, col: 26, offset: 5731},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 171, col: 27, offset: 5636},
+							pos: position{line: 171, col: 27, offset: 5732},
 							alternatives: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 171, col: 27, offset: 5636},
+									pos:        position{line: 171, col: 27, offset: 5732},
 									val:        "[A-Z]",
 									ranges:     []rune{'A', 'Z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 171, col: 35, offset: 5644},
+									pos:        position{line: 171, col: 35, offset: 5740},
 									val:        "[a-z]",
 									ranges:     []rune{'a', 'z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 171, col: 43, offset: 5652},
+									pos:        position{line: 171, col: 43, offset: 5748},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 171, col: 51, offset: 5660},
+									pos:        position{line: 171, col: 51, offset: 5756},
 									val:        "_",
 									ignoreCase: false,
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 171, col: 56, offset: 5665},
+							pos: position{line: 171, col: 56, offset: 5761},
 							expr: &choiceExpr{
-								pos: position{line: 171, col: 57, offset: 5666},
+								pos: position{line: 171, col: 57, offset: 5762},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 171, col: 57, offset: 5666},
+										pos:        position{line: 171, col: 57, offset: 5762},
 										val:        "[A-Z]",
 										ranges:     []rune{'A', 'Z'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 171, col: 65, offset: 5674},
+										pos:        position{line: 171, col: 65, offset: 5770},
 										val:        "[a-z]",
 										ranges:     []rune{'a', 'z'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 171, col: 73, offset: 5682},
+										pos:        position{line: 171, col: 73, offset: 5778},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&litMatcher{
-										pos:        position{line: 171, col: 81, offset: 5690},
+										pos:        position{line: 171, col: 81, offset: 5786},
+										val:        "_",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 171, col: 87, offset: 5792},
 										val:        "-",
 										ignoreCase: false,
 									},
@@ -1381,24 +1398,24 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeValue",
-			pos:  position{line: 175, col: 1, offset: 5732},
+			pos:  position{line: 175, col: 1, offset: 5834},
 			expr: &actionExpr{
-				pos: position{line: 175, col: 27, offset: 5758},
+				pos: position{line: 175, col: 27, offset: 5860},
 				run: (*parser).callonDocumentAttributeValue1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 175, col: 27, offset: 5758},
+					pos: position{line: 175, col: 27, offset: 5860},
 					expr: &seqExpr{
-						pos: position{line: 175, col: 28, offset: 5759},
+						pos: position{line: 175, col: 28, offset: 5861},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 175, col: 28, offset: 5759},
+								pos: position{line: 175, col: 28, offset: 5861},
 								expr: &ruleRefExpr{
-									pos:  position{line: 175, col: 29, offset: 5760},
+									pos:  position{line: 175, col: 29, offset: 5862},
 									name: "Newline",
 								},
 							},
 							&anyMatcher{
-								line: 175, col: 37, offset: 5768,
+								line: 175, col: 37, offset: 5870,
 							},
 						},
 					},
@@ -1407,81 +1424,81 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeReset",
-			pos:  position{line: 179, col: 1, offset: 5808},
+			pos:  position{line: 179, col: 1, offset: 5910},
 			expr: &choiceExpr{
-				pos: position{line: 179, col: 27, offset: 5834},
+				pos: position{line: 179, col: 27, offset: 5936},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 179, col: 27, offset: 5834},
+						pos: position{line: 179, col: 27, offset: 5936},
 						run: (*parser).callonDocumentAttributeReset2,
 						expr: &seqExpr{
-							pos: position{line: 179, col: 27, offset: 5834},
+							pos: position{line: 179, col: 27, offset: 5936},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 179, col: 27, offset: 5834},
+									pos:        position{line: 179, col: 27, offset: 5936},
 									val:        ":!",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 179, col: 32, offset: 5839},
+									pos:   position{line: 179, col: 32, offset: 5941},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 179, col: 38, offset: 5845},
+										pos:  position{line: 179, col: 38, offset: 5947},
 										name: "DocumentAttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 179, col: 61, offset: 5868},
+									pos:        position{line: 179, col: 61, offset: 5970},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 179, col: 65, offset: 5872},
+									pos: position{line: 179, col: 65, offset: 5974},
 									expr: &ruleRefExpr{
-										pos:  position{line: 179, col: 65, offset: 5872},
+										pos:  position{line: 179, col: 65, offset: 5974},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 179, col: 69, offset: 5876},
+									pos:  position{line: 179, col: 69, offset: 5978},
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 181, col: 5, offset: 5944},
+						pos: position{line: 181, col: 5, offset: 6046},
 						run: (*parser).callonDocumentAttributeReset11,
 						expr: &seqExpr{
-							pos: position{line: 181, col: 5, offset: 5944},
+							pos: position{line: 181, col: 5, offset: 6046},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 181, col: 5, offset: 5944},
+									pos:        position{line: 181, col: 5, offset: 6046},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 181, col: 9, offset: 5948},
+									pos:   position{line: 181, col: 9, offset: 6050},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 181, col: 15, offset: 5954},
+										pos:  position{line: 181, col: 15, offset: 6056},
 										name: "DocumentAttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 181, col: 38, offset: 5977},
+									pos:        position{line: 181, col: 38, offset: 6079},
 									val:        "!:",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 181, col: 43, offset: 5982},
+									pos: position{line: 181, col: 43, offset: 6084},
 									expr: &ruleRefExpr{
-										pos:  position{line: 181, col: 43, offset: 5982},
+										pos:  position{line: 181, col: 43, offset: 6084},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 181, col: 47, offset: 5986},
+									pos:  position{line: 181, col: 47, offset: 6088},
 									name: "EOL",
 								},
 							},
@@ -1492,28 +1509,28 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeSubstitution",
-			pos:  position{line: 185, col: 1, offset: 6053},
+			pos:  position{line: 185, col: 1, offset: 6155},
 			expr: &actionExpr{
-				pos: position{line: 185, col: 34, offset: 6086},
+				pos: position{line: 185, col: 34, offset: 6188},
 				run: (*parser).callonDocumentAttributeSubstitution1,
 				expr: &seqExpr{
-					pos: position{line: 185, col: 34, offset: 6086},
+					pos: position{line: 185, col: 34, offset: 6188},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 185, col: 34, offset: 6086},
+							pos:        position{line: 185, col: 34, offset: 6188},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 185, col: 38, offset: 6090},
+							pos:   position{line: 185, col: 38, offset: 6192},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 185, col: 44, offset: 6096},
+								pos:  position{line: 185, col: 44, offset: 6198},
 								name: "DocumentAttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 185, col: 67, offset: 6119},
+							pos:        position{line: 185, col: 67, offset: 6221},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -1523,28 +1540,28 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttributes",
-			pos:  position{line: 192, col: 1, offset: 6307},
+			pos:  position{line: 192, col: 1, offset: 6409},
 			expr: &actionExpr{
-				pos: position{line: 192, col: 22, offset: 6328},
+				pos: position{line: 192, col: 22, offset: 6430},
 				run: (*parser).callonElementAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 192, col: 22, offset: 6328},
+					pos: position{line: 192, col: 22, offset: 6430},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 192, col: 22, offset: 6328},
+							pos:   position{line: 192, col: 22, offset: 6430},
 							label: "attrs",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 192, col: 28, offset: 6334},
+								pos: position{line: 192, col: 28, offset: 6436},
 								expr: &ruleRefExpr{
-									pos:  position{line: 192, col: 29, offset: 6335},
+									pos:  position{line: 192, col: 29, offset: 6437},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 192, col: 48, offset: 6354},
+							pos: position{line: 192, col: 48, offset: 6456},
 							expr: &ruleRefExpr{
-								pos:  position{line: 192, col: 48, offset: 6354},
+								pos:  position{line: 192, col: 48, offset: 6456},
 								name: "BlankLine",
 							},
 						},
@@ -1554,30 +1571,30 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttribute",
-			pos:  position{line: 196, col: 1, offset: 6436},
+			pos:  position{line: 196, col: 1, offset: 6533},
 			expr: &actionExpr{
-				pos: position{line: 196, col: 21, offset: 6456},
+				pos: position{line: 196, col: 21, offset: 6553},
 				run: (*parser).callonElementAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 196, col: 21, offset: 6456},
+					pos: position{line: 196, col: 21, offset: 6553},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 196, col: 21, offset: 6456},
+							pos: position{line: 196, col: 21, offset: 6553},
 							expr: &choiceExpr{
-								pos: position{line: 196, col: 23, offset: 6458},
+								pos: position{line: 196, col: 23, offset: 6555},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 196, col: 23, offset: 6458},
+										pos:        position{line: 196, col: 23, offset: 6555},
 										val:        "[",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 196, col: 29, offset: 6464},
+										pos:        position{line: 196, col: 29, offset: 6561},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 196, col: 35, offset: 6470},
+										pos:        position{line: 196, col: 35, offset: 6567},
 										val:        "#",
 										ignoreCase: false,
 									},
@@ -1585,49 +1602,49 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 197, col: 5, offset: 6546},
+							pos:   position{line: 197, col: 5, offset: 6643},
 							label: "attr",
 							expr: &choiceExpr{
-								pos: position{line: 197, col: 11, offset: 6552},
+								pos: position{line: 197, col: 11, offset: 6649},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 197, col: 11, offset: 6552},
+										pos:  position{line: 197, col: 11, offset: 6649},
 										name: "ElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 198, col: 9, offset: 6573},
+										pos:  position{line: 198, col: 9, offset: 6670},
 										name: "ElementTitle",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 199, col: 9, offset: 6597},
+										pos:  position{line: 199, col: 9, offset: 6694},
 										name: "ElementRole",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 200, col: 9, offset: 6620},
+										pos:  position{line: 200, col: 9, offset: 6717},
 										name: "LiteralAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 201, col: 9, offset: 6648},
+										pos:  position{line: 201, col: 9, offset: 6745},
 										name: "SourceAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 202, col: 9, offset: 6676},
+										pos:  position{line: 202, col: 9, offset: 6773},
 										name: "QuoteAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 203, col: 9, offset: 6703},
+										pos:  position{line: 203, col: 9, offset: 6800},
 										name: "VerseAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 204, col: 9, offset: 6730},
+										pos:  position{line: 204, col: 9, offset: 6827},
 										name: "AdmonitionMarkerAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 205, col: 9, offset: 6767},
+										pos:  position{line: 205, col: 9, offset: 6864},
 										name: "HorizontalLayout",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 206, col: 9, offset: 6795},
+										pos:  position{line: 206, col: 9, offset: 6892},
 										name: "AttributeGroup",
 									},
 								},
@@ -1639,16 +1656,16 @@ var g = &grammar{
 		},
 		{
 			name: "MasqueradeAttribute",
-			pos:  position{line: 211, col: 1, offset: 6978},
+			pos:  position{line: 211, col: 1, offset: 7075},
 			expr: &choiceExpr{
-				pos: position{line: 211, col: 24, offset: 7001},
+				pos: position{line: 211, col: 24, offset: 7098},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 211, col: 24, offset: 7001},
+						pos:  position{line: 211, col: 24, offset: 7098},
 						name: "QuoteAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 211, col: 42, offset: 7019},
+						pos:  position{line: 211, col: 42, offset: 7116},
 						name: "VerseAttributes",
 					},
 				},
@@ -1656,81 +1673,81 @@ var g = &grammar{
 		},
 		{
 			name: "ElementID",
-			pos:  position{line: 213, col: 1, offset: 7036},
+			pos:  position{line: 213, col: 1, offset: 7133},
 			expr: &choiceExpr{
-				pos: position{line: 213, col: 14, offset: 7049},
+				pos: position{line: 213, col: 14, offset: 7146},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 213, col: 14, offset: 7049},
+						pos: position{line: 213, col: 14, offset: 7146},
 						run: (*parser).callonElementID2,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 14, offset: 7049},
+							pos: position{line: 213, col: 14, offset: 7146},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 213, col: 14, offset: 7049},
+									pos:        position{line: 213, col: 14, offset: 7146},
 									val:        "[[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 19, offset: 7054},
+									pos:   position{line: 213, col: 19, offset: 7151},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 23, offset: 7058},
+										pos:  position{line: 213, col: 23, offset: 7155},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 213, col: 27, offset: 7062},
+									pos:        position{line: 213, col: 27, offset: 7159},
 									val:        "]]",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 213, col: 32, offset: 7067},
+									pos: position{line: 213, col: 32, offset: 7164},
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 32, offset: 7067},
+										pos:  position{line: 213, col: 32, offset: 7164},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 36, offset: 7071},
+									pos:  position{line: 213, col: 36, offset: 7168},
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 215, col: 5, offset: 7124},
+						pos: position{line: 215, col: 5, offset: 7221},
 						run: (*parser).callonElementID11,
 						expr: &seqExpr{
-							pos: position{line: 215, col: 5, offset: 7124},
+							pos: position{line: 215, col: 5, offset: 7221},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 215, col: 5, offset: 7124},
+									pos:        position{line: 215, col: 5, offset: 7221},
 									val:        "[#",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 215, col: 10, offset: 7129},
+									pos:   position{line: 215, col: 10, offset: 7226},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 215, col: 14, offset: 7133},
+										pos:  position{line: 215, col: 14, offset: 7230},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 215, col: 18, offset: 7137},
+									pos:        position{line: 215, col: 18, offset: 7234},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 215, col: 23, offset: 7142},
+									pos: position{line: 215, col: 23, offset: 7239},
 									expr: &ruleRefExpr{
-										pos:  position{line: 215, col: 23, offset: 7142},
+										pos:  position{line: 215, col: 23, offset: 7239},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 215, col: 27, offset: 7146},
+									pos:  position{line: 215, col: 27, offset: 7243},
 									name: "EOL",
 								},
 							},
@@ -1741,35 +1758,35 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementID",
-			pos:  position{line: 219, col: 1, offset: 7198},
+			pos:  position{line: 219, col: 1, offset: 7295},
 			expr: &actionExpr{
-				pos: position{line: 219, col: 20, offset: 7217},
+				pos: position{line: 219, col: 20, offset: 7314},
 				run: (*parser).callonInlineElementID1,
 				expr: &seqExpr{
-					pos: position{line: 219, col: 20, offset: 7217},
+					pos: position{line: 219, col: 20, offset: 7314},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 219, col: 20, offset: 7217},
+							pos:        position{line: 219, col: 20, offset: 7314},
 							val:        "[[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 219, col: 25, offset: 7222},
+							pos:   position{line: 219, col: 25, offset: 7319},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 219, col: 29, offset: 7226},
+								pos:  position{line: 219, col: 29, offset: 7323},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 219, col: 33, offset: 7230},
+							pos:        position{line: 219, col: 33, offset: 7327},
 							val:        "]]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 219, col: 38, offset: 7235},
+							pos: position{line: 219, col: 38, offset: 7332},
 							expr: &ruleRefExpr{
-								pos:  position{line: 219, col: 38, offset: 7235},
+								pos:  position{line: 219, col: 38, offset: 7332},
 								name: "WS",
 							},
 						},
@@ -1779,56 +1796,56 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitle",
-			pos:  position{line: 225, col: 1, offset: 7509},
+			pos:  position{line: 225, col: 1, offset: 7606},
 			expr: &actionExpr{
-				pos: position{line: 225, col: 17, offset: 7525},
+				pos: position{line: 225, col: 17, offset: 7622},
 				run: (*parser).callonElementTitle1,
 				expr: &seqExpr{
-					pos: position{line: 225, col: 17, offset: 7525},
+					pos: position{line: 225, col: 17, offset: 7622},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 225, col: 17, offset: 7525},
+							pos:        position{line: 225, col: 17, offset: 7622},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 225, col: 21, offset: 7529},
+							pos:   position{line: 225, col: 21, offset: 7626},
 							label: "title",
 							expr: &actionExpr{
-								pos: position{line: 225, col: 28, offset: 7536},
+								pos: position{line: 225, col: 28, offset: 7633},
 								run: (*parser).callonElementTitle5,
 								expr: &seqExpr{
-									pos: position{line: 225, col: 28, offset: 7536},
+									pos: position{line: 225, col: 28, offset: 7633},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 225, col: 28, offset: 7536},
+											pos:  position{line: 225, col: 28, offset: 7633},
 											name: "Alphanums",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 225, col: 38, offset: 7546},
+											pos: position{line: 225, col: 38, offset: 7643},
 											expr: &choiceExpr{
-												pos: position{line: 225, col: 39, offset: 7547},
+												pos: position{line: 225, col: 39, offset: 7644},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 225, col: 39, offset: 7547},
+														pos:  position{line: 225, col: 39, offset: 7644},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 225, col: 51, offset: 7559},
+														pos:  position{line: 225, col: 51, offset: 7656},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 225, col: 61, offset: 7569},
+														pos: position{line: 225, col: 61, offset: 7666},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 225, col: 61, offset: 7569},
+																pos: position{line: 225, col: 61, offset: 7666},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 225, col: 62, offset: 7570},
+																	pos:  position{line: 225, col: 62, offset: 7667},
 																	name: "Newline",
 																},
 															},
 															&anyMatcher{
-																line: 225, col: 70, offset: 7578,
+																line: 225, col: 70, offset: 7675,
 															},
 														},
 													},
@@ -1840,7 +1857,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 227, col: 4, offset: 7619},
+							pos:  position{line: 227, col: 4, offset: 7716},
 							name: "EOL",
 						},
 					},
@@ -1849,64 +1866,64 @@ var g = &grammar{
 		},
 		{
 			name: "ElementRole",
-			pos:  position{line: 233, col: 1, offset: 7771},
+			pos:  position{line: 233, col: 1, offset: 7868},
 			expr: &actionExpr{
-				pos: position{line: 233, col: 16, offset: 7786},
+				pos: position{line: 233, col: 16, offset: 7883},
 				run: (*parser).callonElementRole1,
 				expr: &seqExpr{
-					pos: position{line: 233, col: 16, offset: 7786},
+					pos: position{line: 233, col: 16, offset: 7883},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 233, col: 16, offset: 7786},
+							pos:        position{line: 233, col: 16, offset: 7883},
 							val:        "[.",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 233, col: 21, offset: 7791},
+							pos:   position{line: 233, col: 21, offset: 7888},
 							label: "role",
 							expr: &actionExpr{
-								pos: position{line: 233, col: 27, offset: 7797},
+								pos: position{line: 233, col: 27, offset: 7894},
 								run: (*parser).callonElementRole5,
 								expr: &seqExpr{
-									pos: position{line: 233, col: 27, offset: 7797},
+									pos: position{line: 233, col: 27, offset: 7894},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 233, col: 27, offset: 7797},
+											pos:  position{line: 233, col: 27, offset: 7894},
 											name: "Alphanums",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 233, col: 37, offset: 7807},
+											pos: position{line: 233, col: 37, offset: 7904},
 											expr: &choiceExpr{
-												pos: position{line: 233, col: 38, offset: 7808},
+												pos: position{line: 233, col: 38, offset: 7905},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 233, col: 38, offset: 7808},
+														pos:  position{line: 233, col: 38, offset: 7905},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 233, col: 50, offset: 7820},
+														pos:  position{line: 233, col: 50, offset: 7917},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 233, col: 60, offset: 7830},
+														pos: position{line: 233, col: 60, offset: 7927},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 233, col: 60, offset: 7830},
+																pos: position{line: 233, col: 60, offset: 7927},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 233, col: 61, offset: 7831},
+																	pos:  position{line: 233, col: 61, offset: 7928},
 																	name: "Newline",
 																},
 															},
 															&notExpr{
-																pos: position{line: 233, col: 69, offset: 7839},
+																pos: position{line: 233, col: 69, offset: 7936},
 																expr: &litMatcher{
-																	pos:        position{line: 233, col: 70, offset: 7840},
+																	pos:        position{line: 233, col: 70, offset: 7937},
 																	val:        "]",
 																	ignoreCase: false,
 																},
 															},
 															&anyMatcher{
-																line: 233, col: 74, offset: 7844,
+																line: 233, col: 74, offset: 7941,
 															},
 														},
 													},
@@ -1918,19 +1935,19 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 235, col: 4, offset: 7885},
+							pos:        position{line: 235, col: 4, offset: 7982},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 235, col: 8, offset: 7889},
+							pos: position{line: 235, col: 8, offset: 7986},
 							expr: &ruleRefExpr{
-								pos:  position{line: 235, col: 8, offset: 7889},
+								pos:  position{line: 235, col: 8, offset: 7986},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 235, col: 12, offset: 7893},
+							pos:  position{line: 235, col: 12, offset: 7990},
 							name: "EOL",
 						},
 					},
@@ -1939,27 +1956,27 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralAttribute",
-			pos:  position{line: 239, col: 1, offset: 7949},
+			pos:  position{line: 239, col: 1, offset: 8046},
 			expr: &actionExpr{
-				pos: position{line: 239, col: 21, offset: 7969},
+				pos: position{line: 239, col: 21, offset: 8066},
 				run: (*parser).callonLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 239, col: 21, offset: 7969},
+					pos: position{line: 239, col: 21, offset: 8066},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 239, col: 21, offset: 7969},
+							pos:        position{line: 239, col: 21, offset: 8066},
 							val:        "[literal]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 239, col: 33, offset: 7981},
+							pos: position{line: 239, col: 33, offset: 8078},
 							expr: &ruleRefExpr{
-								pos:  position{line: 239, col: 33, offset: 7981},
+								pos:  position{line: 239, col: 33, offset: 8078},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 239, col: 37, offset: 7985},
+							pos:  position{line: 239, col: 37, offset: 8082},
 							name: "Newline",
 						},
 					},
@@ -1968,40 +1985,40 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionMarkerAttribute",
-			pos:  position{line: 244, col: 1, offset: 8117},
+			pos:  position{line: 244, col: 1, offset: 8214},
 			expr: &actionExpr{
-				pos: position{line: 244, col: 30, offset: 8146},
+				pos: position{line: 244, col: 30, offset: 8243},
 				run: (*parser).callonAdmonitionMarkerAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 244, col: 30, offset: 8146},
+					pos: position{line: 244, col: 30, offset: 8243},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 244, col: 30, offset: 8146},
+							pos:        position{line: 244, col: 30, offset: 8243},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 244, col: 34, offset: 8150},
+							pos:   position{line: 244, col: 34, offset: 8247},
 							label: "k",
 							expr: &ruleRefExpr{
-								pos:  position{line: 244, col: 37, offset: 8153},
+								pos:  position{line: 244, col: 37, offset: 8250},
 								name: "AdmonitionKind",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 244, col: 53, offset: 8169},
+							pos:        position{line: 244, col: 53, offset: 8266},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 244, col: 57, offset: 8173},
+							pos: position{line: 244, col: 57, offset: 8270},
 							expr: &ruleRefExpr{
-								pos:  position{line: 244, col: 57, offset: 8173},
+								pos:  position{line: 244, col: 57, offset: 8270},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 244, col: 61, offset: 8177},
+							pos:  position{line: 244, col: 61, offset: 8274},
 							name: "EOL",
 						},
 					},
@@ -2010,41 +2027,41 @@ var g = &grammar{
 		},
 		{
 			name: "SourceAttributes",
-			pos:  position{line: 249, col: 1, offset: 8332},
+			pos:  position{line: 249, col: 1, offset: 8429},
 			expr: &actionExpr{
-				pos: position{line: 249, col: 21, offset: 8352},
+				pos: position{line: 249, col: 21, offset: 8449},
 				run: (*parser).callonSourceAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 249, col: 21, offset: 8352},
+					pos: position{line: 249, col: 21, offset: 8449},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 249, col: 21, offset: 8352},
+							pos:        position{line: 249, col: 21, offset: 8449},
 							val:        "[source",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 250, col: 5, offset: 8367},
+							pos:   position{line: 250, col: 5, offset: 8464},
 							label: "language",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 250, col: 14, offset: 8376},
+								pos: position{line: 250, col: 14, offset: 8473},
 								expr: &actionExpr{
-									pos: position{line: 250, col: 15, offset: 8377},
+									pos: position{line: 250, col: 15, offset: 8474},
 									run: (*parser).callonSourceAttributes6,
 									expr: &seqExpr{
-										pos: position{line: 250, col: 15, offset: 8377},
+										pos: position{line: 250, col: 15, offset: 8474},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 250, col: 15, offset: 8377},
+												pos:        position{line: 250, col: 15, offset: 8474},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 250, col: 19, offset: 8381},
+												pos:   position{line: 250, col: 19, offset: 8478},
 												label: "attr",
 												expr: &zeroOrOneExpr{
-													pos: position{line: 250, col: 24, offset: 8386},
+													pos: position{line: 250, col: 24, offset: 8483},
 													expr: &ruleRefExpr{
-														pos:  position{line: 250, col: 25, offset: 8387},
+														pos:  position{line: 250, col: 25, offset: 8484},
 														name: "StandaloneAttributeValue",
 													},
 												},
@@ -2055,28 +2072,28 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 251, col: 5, offset: 8442},
+							pos:   position{line: 251, col: 5, offset: 8539},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 251, col: 12, offset: 8449},
+								pos: position{line: 251, col: 12, offset: 8546},
 								expr: &actionExpr{
-									pos: position{line: 251, col: 13, offset: 8450},
+									pos: position{line: 251, col: 13, offset: 8547},
 									run: (*parser).callonSourceAttributes14,
 									expr: &seqExpr{
-										pos: position{line: 251, col: 13, offset: 8450},
+										pos: position{line: 251, col: 13, offset: 8547},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 251, col: 13, offset: 8450},
+												pos:        position{line: 251, col: 13, offset: 8547},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 251, col: 17, offset: 8454},
+												pos:   position{line: 251, col: 17, offset: 8551},
 												label: "attr",
 												expr: &zeroOrOneExpr{
-													pos: position{line: 251, col: 22, offset: 8459},
+													pos: position{line: 251, col: 22, offset: 8556},
 													expr: &ruleRefExpr{
-														pos:  position{line: 251, col: 23, offset: 8460},
+														pos:  position{line: 251, col: 23, offset: 8557},
 														name: "GenericAttribute",
 													},
 												},
@@ -2087,19 +2104,19 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 252, col: 5, offset: 8507},
+							pos:        position{line: 252, col: 5, offset: 8604},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 252, col: 9, offset: 8511},
+							pos: position{line: 252, col: 9, offset: 8608},
 							expr: &ruleRefExpr{
-								pos:  position{line: 252, col: 9, offset: 8511},
+								pos:  position{line: 252, col: 9, offset: 8608},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 252, col: 13, offset: 8515},
+							pos:  position{line: 252, col: 13, offset: 8612},
 							name: "EOL",
 						},
 					},
@@ -2108,43 +2125,43 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeGroup",
-			pos:  position{line: 257, col: 1, offset: 8666},
+			pos:  position{line: 257, col: 1, offset: 8763},
 			expr: &actionExpr{
-				pos: position{line: 257, col: 19, offset: 8684},
+				pos: position{line: 257, col: 19, offset: 8781},
 				run: (*parser).callonAttributeGroup1,
 				expr: &seqExpr{
-					pos: position{line: 257, col: 19, offset: 8684},
+					pos: position{line: 257, col: 19, offset: 8781},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 257, col: 19, offset: 8684},
+							pos:        position{line: 257, col: 19, offset: 8781},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 257, col: 23, offset: 8688},
+							pos:   position{line: 257, col: 23, offset: 8785},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 257, col: 34, offset: 8699},
+								pos: position{line: 257, col: 34, offset: 8796},
 								expr: &ruleRefExpr{
-									pos:  position{line: 257, col: 35, offset: 8700},
+									pos:  position{line: 257, col: 35, offset: 8797},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 257, col: 54, offset: 8719},
+							pos:        position{line: 257, col: 54, offset: 8816},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 257, col: 58, offset: 8723},
+							pos: position{line: 257, col: 58, offset: 8820},
 							expr: &ruleRefExpr{
-								pos:  position{line: 257, col: 58, offset: 8723},
+								pos:  position{line: 257, col: 58, offset: 8820},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 257, col: 62, offset: 8727},
+							pos:  position{line: 257, col: 62, offset: 8824},
 							name: "EOL",
 						},
 					},
@@ -2153,16 +2170,16 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttribute",
-			pos:  position{line: 261, col: 1, offset: 8799},
+			pos:  position{line: 261, col: 1, offset: 8896},
 			expr: &choiceExpr{
-				pos: position{line: 261, col: 21, offset: 8819},
+				pos: position{line: 261, col: 21, offset: 8916},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 261, col: 21, offset: 8819},
+						pos:  position{line: 261, col: 21, offset: 8916},
 						name: "GenericAttributeWithValue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 261, col: 49, offset: 8847},
+						pos:  position{line: 261, col: 49, offset: 8944},
 						name: "GenericAttributeWithoutValue",
 					},
 				},
@@ -2170,49 +2187,49 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithValue",
-			pos:  position{line: 263, col: 1, offset: 8877},
+			pos:  position{line: 263, col: 1, offset: 8974},
 			expr: &actionExpr{
-				pos: position{line: 263, col: 30, offset: 8906},
+				pos: position{line: 263, col: 30, offset: 9003},
 				run: (*parser).callonGenericAttributeWithValue1,
 				expr: &seqExpr{
-					pos: position{line: 263, col: 30, offset: 8906},
+					pos: position{line: 263, col: 30, offset: 9003},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 263, col: 30, offset: 8906},
+							pos:   position{line: 263, col: 30, offset: 9003},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 263, col: 35, offset: 8911},
+								pos:  position{line: 263, col: 35, offset: 9008},
 								name: "AttributeKey",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 263, col: 49, offset: 8925},
+							pos:        position{line: 263, col: 49, offset: 9022},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 263, col: 53, offset: 8929},
+							pos:   position{line: 263, col: 53, offset: 9026},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 263, col: 59, offset: 8935},
+								pos: position{line: 263, col: 59, offset: 9032},
 								expr: &ruleRefExpr{
-									pos:  position{line: 263, col: 60, offset: 8936},
+									pos:  position{line: 263, col: 60, offset: 9033},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 263, col: 77, offset: 8953},
+							pos: position{line: 263, col: 77, offset: 9050},
 							expr: &litMatcher{
-								pos:        position{line: 263, col: 77, offset: 8953},
+								pos:        position{line: 263, col: 77, offset: 9050},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 263, col: 82, offset: 8958},
+							pos: position{line: 263, col: 82, offset: 9055},
 							expr: &ruleRefExpr{
-								pos:  position{line: 263, col: 82, offset: 8958},
+								pos:  position{line: 263, col: 82, offset: 9055},
 								name: "WS",
 							},
 						},
@@ -2222,33 +2239,33 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithoutValue",
-			pos:  position{line: 267, col: 1, offset: 9054},
+			pos:  position{line: 267, col: 1, offset: 9151},
 			expr: &actionExpr{
-				pos: position{line: 267, col: 33, offset: 9086},
+				pos: position{line: 267, col: 33, offset: 9183},
 				run: (*parser).callonGenericAttributeWithoutValue1,
 				expr: &seqExpr{
-					pos: position{line: 267, col: 33, offset: 9086},
+					pos: position{line: 267, col: 33, offset: 9183},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 267, col: 33, offset: 9086},
+							pos:   position{line: 267, col: 33, offset: 9183},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 267, col: 38, offset: 9091},
+								pos:  position{line: 267, col: 38, offset: 9188},
 								name: "AttributeKey",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 267, col: 52, offset: 9105},
+							pos: position{line: 267, col: 52, offset: 9202},
 							expr: &litMatcher{
-								pos:        position{line: 267, col: 52, offset: 9105},
+								pos:        position{line: 267, col: 52, offset: 9202},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 267, col: 57, offset: 9110},
+							pos: position{line: 267, col: 57, offset: 9207},
 							expr: &ruleRefExpr{
-								pos:  position{line: 267, col: 57, offset: 9110},
+								pos:  position{line: 267, col: 57, offset: 9207},
 								name: "WS",
 							},
 						},
@@ -2258,58 +2275,58 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeKey",
-			pos:  position{line: 271, col: 1, offset: 9195},
+			pos:  position{line: 271, col: 1, offset: 9292},
 			expr: &actionExpr{
-				pos: position{line: 271, col: 17, offset: 9211},
+				pos: position{line: 271, col: 17, offset: 9308},
 				run: (*parser).callonAttributeKey1,
 				expr: &seqExpr{
-					pos: position{line: 271, col: 17, offset: 9211},
+					pos: position{line: 271, col: 17, offset: 9308},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 271, col: 17, offset: 9211},
+							pos: position{line: 271, col: 17, offset: 9308},
 							expr: &litMatcher{
-								pos:        position{line: 271, col: 18, offset: 9212},
+								pos:        position{line: 271, col: 18, offset: 9309},
 								val:        "quote",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 271, col: 26, offset: 9220},
+							pos: position{line: 271, col: 26, offset: 9317},
 							expr: &litMatcher{
-								pos:        position{line: 271, col: 27, offset: 9221},
+								pos:        position{line: 271, col: 27, offset: 9318},
 								val:        "verse",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 271, col: 35, offset: 9229},
+							pos: position{line: 271, col: 35, offset: 9326},
 							expr: &litMatcher{
-								pos:        position{line: 271, col: 36, offset: 9230},
+								pos:        position{line: 271, col: 36, offset: 9327},
 								val:        "literal",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 271, col: 46, offset: 9240},
+							pos: position{line: 271, col: 46, offset: 9337},
 							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 47, offset: 9241},
+								pos:  position{line: 271, col: 47, offset: 9338},
 								name: "Spaces",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 271, col: 54, offset: 9248},
+							pos:   position{line: 271, col: 54, offset: 9345},
 							label: "key",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 271, col: 58, offset: 9252},
+								pos: position{line: 271, col: 58, offset: 9349},
 								expr: &choiceExpr{
-									pos: position{line: 271, col: 59, offset: 9253},
+									pos: position{line: 271, col: 59, offset: 9350},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 271, col: 59, offset: 9253},
+											pos:  position{line: 271, col: 59, offset: 9350},
 											name: "Alphanums",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 271, col: 71, offset: 9265},
+											pos:  position{line: 271, col: 71, offset: 9362},
 											name: "OtherAttributeChar",
 										},
 									},
@@ -2317,9 +2334,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 271, col: 92, offset: 9286},
+							pos: position{line: 271, col: 92, offset: 9383},
 							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 92, offset: 9286},
+								pos:  position{line: 271, col: 92, offset: 9383},
 								name: "WS",
 							},
 						},
@@ -2329,28 +2346,28 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeValue",
-			pos:  position{line: 275, col: 1, offset: 9326},
+			pos:  position{line: 275, col: 1, offset: 9423},
 			expr: &actionExpr{
-				pos: position{line: 275, col: 19, offset: 9344},
+				pos: position{line: 275, col: 19, offset: 9441},
 				run: (*parser).callonAttributeValue1,
 				expr: &labeledExpr{
-					pos:   position{line: 275, col: 19, offset: 9344},
+					pos:   position{line: 275, col: 19, offset: 9441},
 					label: "value",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 275, col: 25, offset: 9350},
+						pos: position{line: 275, col: 25, offset: 9447},
 						expr: &choiceExpr{
-							pos: position{line: 275, col: 26, offset: 9351},
+							pos: position{line: 275, col: 26, offset: 9448},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 275, col: 26, offset: 9351},
+									pos:  position{line: 275, col: 26, offset: 9448},
 									name: "Alphanums",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 275, col: 38, offset: 9363},
+									pos:  position{line: 275, col: 38, offset: 9460},
 									name: "Spaces",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 275, col: 47, offset: 9372},
+									pos:  position{line: 275, col: 47, offset: 9469},
 									name: "OtherAttributeChar",
 								},
 							},
@@ -2361,31 +2378,31 @@ var g = &grammar{
 		},
 		{
 			name: "StandaloneAttributeValue",
-			pos:  position{line: 279, col: 1, offset: 9430},
+			pos:  position{line: 279, col: 1, offset: 9527},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 29, offset: 9458},
+				pos: position{line: 279, col: 29, offset: 9555},
 				run: (*parser).callonStandaloneAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 29, offset: 9458},
+					pos: position{line: 279, col: 29, offset: 9555},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 279, col: 29, offset: 9458},
+							pos:   position{line: 279, col: 29, offset: 9555},
 							label: "value",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 279, col: 35, offset: 9464},
+								pos: position{line: 279, col: 35, offset: 9561},
 								expr: &choiceExpr{
-									pos: position{line: 279, col: 36, offset: 9465},
+									pos: position{line: 279, col: 36, offset: 9562},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 279, col: 36, offset: 9465},
+											pos:  position{line: 279, col: 36, offset: 9562},
 											name: "Alphanums",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 279, col: 48, offset: 9477},
+											pos:  position{line: 279, col: 48, offset: 9574},
 											name: "Spaces",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 279, col: 57, offset: 9486},
+											pos:  position{line: 279, col: 57, offset: 9583},
 											name: "OtherAttributeChar",
 										},
 									},
@@ -2393,9 +2410,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 279, col: 78, offset: 9507},
+							pos: position{line: 279, col: 78, offset: 9604},
 							expr: &litMatcher{
-								pos:        position{line: 279, col: 79, offset: 9508},
+								pos:        position{line: 279, col: 79, offset: 9605},
 								val:        "=",
 								ignoreCase: false,
 							},
@@ -2406,70 +2423,70 @@ var g = &grammar{
 		},
 		{
 			name: "OtherAttributeChar",
-			pos:  position{line: 283, col: 1, offset: 9674},
+			pos:  position{line: 283, col: 1, offset: 9771},
 			expr: &seqExpr{
-				pos: position{line: 283, col: 24, offset: 9697},
+				pos: position{line: 283, col: 24, offset: 9794},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 283, col: 24, offset: 9697},
+						pos: position{line: 283, col: 24, offset: 9794},
 						expr: &ruleRefExpr{
-							pos:  position{line: 283, col: 25, offset: 9698},
+							pos:  position{line: 283, col: 25, offset: 9795},
 							name: "Newline",
 						},
 					},
 					&notExpr{
-						pos: position{line: 283, col: 33, offset: 9706},
+						pos: position{line: 283, col: 33, offset: 9803},
 						expr: &litMatcher{
-							pos:        position{line: 283, col: 34, offset: 9707},
+							pos:        position{line: 283, col: 34, offset: 9804},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 283, col: 38, offset: 9711},
+						pos: position{line: 283, col: 38, offset: 9808},
 						expr: &litMatcher{
-							pos:        position{line: 283, col: 39, offset: 9712},
+							pos:        position{line: 283, col: 39, offset: 9809},
 							val:        ",",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 283, col: 43, offset: 9716},
+						pos: position{line: 283, col: 43, offset: 9813},
 						expr: &litMatcher{
-							pos:        position{line: 283, col: 44, offset: 9717},
+							pos:        position{line: 283, col: 44, offset: 9814},
 							val:        "]",
 							ignoreCase: false,
 						},
 					},
 					&anyMatcher{
-						line: 283, col: 48, offset: 9721,
+						line: 283, col: 48, offset: 9818,
 					},
 				},
 			},
 		},
 		{
 			name: "HorizontalLayout",
-			pos:  position{line: 285, col: 1, offset: 9725},
+			pos:  position{line: 285, col: 1, offset: 9822},
 			expr: &actionExpr{
-				pos: position{line: 285, col: 21, offset: 9745},
+				pos: position{line: 285, col: 21, offset: 9842},
 				run: (*parser).callonHorizontalLayout1,
 				expr: &seqExpr{
-					pos: position{line: 285, col: 21, offset: 9745},
+					pos: position{line: 285, col: 21, offset: 9842},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 285, col: 21, offset: 9745},
+							pos:        position{line: 285, col: 21, offset: 9842},
 							val:        "[horizontal]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 285, col: 36, offset: 9760},
+							pos: position{line: 285, col: 36, offset: 9857},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 36, offset: 9760},
+								pos:  position{line: 285, col: 36, offset: 9857},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 285, col: 40, offset: 9764},
+							pos:  position{line: 285, col: 40, offset: 9861},
 							name: "EOL",
 						},
 					},
@@ -2478,77 +2495,77 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttributes",
-			pos:  position{line: 289, col: 1, offset: 9837},
+			pos:  position{line: 289, col: 1, offset: 9934},
 			expr: &actionExpr{
-				pos: position{line: 289, col: 20, offset: 9856},
+				pos: position{line: 289, col: 20, offset: 9953},
 				run: (*parser).callonQuoteAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 289, col: 20, offset: 9856},
+					pos: position{line: 289, col: 20, offset: 9953},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 289, col: 20, offset: 9856},
+							pos:        position{line: 289, col: 20, offset: 9953},
 							val:        "[quote",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 289, col: 29, offset: 9865},
+							pos: position{line: 289, col: 29, offset: 9962},
 							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 29, offset: 9865},
+								pos:  position{line: 289, col: 29, offset: 9962},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 289, col: 33, offset: 9869},
+							pos: position{line: 289, col: 33, offset: 9966},
 							expr: &litMatcher{
-								pos:        position{line: 289, col: 33, offset: 9869},
+								pos:        position{line: 289, col: 33, offset: 9966},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 289, col: 38, offset: 9874},
+							pos:   position{line: 289, col: 38, offset: 9971},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 289, col: 45, offset: 9881},
+								pos: position{line: 289, col: 45, offset: 9978},
 								expr: &ruleRefExpr{
-									pos:  position{line: 289, col: 46, offset: 9882},
+									pos:  position{line: 289, col: 46, offset: 9979},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 289, col: 63, offset: 9899},
+							pos: position{line: 289, col: 63, offset: 9996},
 							expr: &litMatcher{
-								pos:        position{line: 289, col: 63, offset: 9899},
+								pos:        position{line: 289, col: 63, offset: 9996},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 289, col: 68, offset: 9904},
+							pos:   position{line: 289, col: 68, offset: 10001},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 289, col: 74, offset: 9910},
+								pos: position{line: 289, col: 74, offset: 10007},
 								expr: &ruleRefExpr{
-									pos:  position{line: 289, col: 75, offset: 9911},
+									pos:  position{line: 289, col: 75, offset: 10008},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 289, col: 92, offset: 9928},
+							pos:        position{line: 289, col: 92, offset: 10025},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 289, col: 96, offset: 9932},
+							pos: position{line: 289, col: 96, offset: 10029},
 							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 96, offset: 9932},
+								pos:  position{line: 289, col: 96, offset: 10029},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 289, col: 100, offset: 9936},
+							pos:  position{line: 289, col: 100, offset: 10033},
 							name: "EOL",
 						},
 					},
@@ -2557,77 +2574,77 @@ var g = &grammar{
 		},
 		{
 			name: "VerseAttributes",
-			pos:  position{line: 293, col: 1, offset: 10005},
+			pos:  position{line: 293, col: 1, offset: 10102},
 			expr: &actionExpr{
-				pos: position{line: 293, col: 20, offset: 10024},
+				pos: position{line: 293, col: 20, offset: 10121},
 				run: (*parser).callonVerseAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 293, col: 20, offset: 10024},
+					pos: position{line: 293, col: 20, offset: 10121},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 293, col: 20, offset: 10024},
+							pos:        position{line: 293, col: 20, offset: 10121},
 							val:        "[verse",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 293, col: 29, offset: 10033},
+							pos: position{line: 293, col: 29, offset: 10130},
 							expr: &ruleRefExpr{
-								pos:  position{line: 293, col: 29, offset: 10033},
+								pos:  position{line: 293, col: 29, offset: 10130},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 293, col: 33, offset: 10037},
+							pos: position{line: 293, col: 33, offset: 10134},
 							expr: &litMatcher{
-								pos:        position{line: 293, col: 33, offset: 10037},
+								pos:        position{line: 293, col: 33, offset: 10134},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 293, col: 38, offset: 10042},
+							pos:   position{line: 293, col: 38, offset: 10139},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 293, col: 45, offset: 10049},
+								pos: position{line: 293, col: 45, offset: 10146},
 								expr: &ruleRefExpr{
-									pos:  position{line: 293, col: 46, offset: 10050},
+									pos:  position{line: 293, col: 46, offset: 10147},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 293, col: 63, offset: 10067},
+							pos: position{line: 293, col: 63, offset: 10164},
 							expr: &litMatcher{
-								pos:        position{line: 293, col: 63, offset: 10067},
+								pos:        position{line: 293, col: 63, offset: 10164},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 293, col: 68, offset: 10072},
+							pos:   position{line: 293, col: 68, offset: 10169},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 293, col: 74, offset: 10078},
+								pos: position{line: 293, col: 74, offset: 10175},
 								expr: &ruleRefExpr{
-									pos:  position{line: 293, col: 75, offset: 10079},
+									pos:  position{line: 293, col: 75, offset: 10176},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 293, col: 92, offset: 10096},
+							pos:        position{line: 293, col: 92, offset: 10193},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 293, col: 96, offset: 10100},
+							pos: position{line: 293, col: 96, offset: 10197},
 							expr: &ruleRefExpr{
-								pos:  position{line: 293, col: 96, offset: 10100},
+								pos:  position{line: 293, col: 96, offset: 10197},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 293, col: 100, offset: 10104},
+							pos:  position{line: 293, col: 100, offset: 10201},
 							name: "EOL",
 						},
 					},
@@ -2636,51 +2653,51 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttribute",
-			pos:  position{line: 297, col: 1, offset: 10191},
+			pos:  position{line: 297, col: 1, offset: 10288},
 			expr: &actionExpr{
-				pos: position{line: 297, col: 19, offset: 10209},
+				pos: position{line: 297, col: 19, offset: 10306},
 				run: (*parser).callonQuoteAttribute1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 297, col: 19, offset: 10209},
+					pos: position{line: 297, col: 19, offset: 10306},
 					expr: &choiceExpr{
-						pos: position{line: 297, col: 20, offset: 10210},
+						pos: position{line: 297, col: 20, offset: 10307},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 297, col: 20, offset: 10210},
+								pos:  position{line: 297, col: 20, offset: 10307},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 297, col: 32, offset: 10222},
+								pos:  position{line: 297, col: 32, offset: 10319},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 297, col: 42, offset: 10232},
+								pos: position{line: 297, col: 42, offset: 10329},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 297, col: 42, offset: 10232},
+										pos: position{line: 297, col: 42, offset: 10329},
 										expr: &litMatcher{
-											pos:        position{line: 297, col: 43, offset: 10233},
+											pos:        position{line: 297, col: 43, offset: 10330},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 297, col: 47, offset: 10237},
+										pos: position{line: 297, col: 47, offset: 10334},
 										expr: &litMatcher{
-											pos:        position{line: 297, col: 48, offset: 10238},
+											pos:        position{line: 297, col: 48, offset: 10335},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 297, col: 52, offset: 10242},
+										pos: position{line: 297, col: 52, offset: 10339},
 										expr: &ruleRefExpr{
-											pos:  position{line: 297, col: 53, offset: 10243},
+											pos:  position{line: 297, col: 53, offset: 10340},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 297, col: 57, offset: 10247,
+										line: 297, col: 57, offset: 10344,
 									},
 								},
 							},
@@ -2691,31 +2708,31 @@ var g = &grammar{
 		},
 		{
 			name: "InlineAttributes",
-			pos:  position{line: 301, col: 1, offset: 10288},
+			pos:  position{line: 301, col: 1, offset: 10385},
 			expr: &actionExpr{
-				pos: position{line: 301, col: 21, offset: 10308},
+				pos: position{line: 301, col: 21, offset: 10405},
 				run: (*parser).callonInlineAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 301, col: 21, offset: 10308},
+					pos: position{line: 301, col: 21, offset: 10405},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 301, col: 21, offset: 10308},
+							pos:        position{line: 301, col: 21, offset: 10405},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 301, col: 25, offset: 10312},
+							pos:   position{line: 301, col: 25, offset: 10409},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 301, col: 31, offset: 10318},
+								pos: position{line: 301, col: 31, offset: 10415},
 								expr: &ruleRefExpr{
-									pos:  position{line: 301, col: 32, offset: 10319},
+									pos:  position{line: 301, col: 32, offset: 10416},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 301, col: 51, offset: 10338},
+							pos:        position{line: 301, col: 51, offset: 10435},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2725,34 +2742,34 @@ var g = &grammar{
 		},
 		{
 			name: "Section",
-			pos:  position{line: 308, col: 1, offset: 10512},
+			pos:  position{line: 308, col: 1, offset: 10609},
 			expr: &actionExpr{
-				pos: position{line: 308, col: 12, offset: 10523},
+				pos: position{line: 308, col: 12, offset: 10620},
 				run: (*parser).callonSection1,
 				expr: &seqExpr{
-					pos: position{line: 308, col: 12, offset: 10523},
+					pos: position{line: 308, col: 12, offset: 10620},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 308, col: 12, offset: 10523},
+							pos:   position{line: 308, col: 12, offset: 10620},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 308, col: 23, offset: 10534},
+								pos: position{line: 308, col: 23, offset: 10631},
 								expr: &ruleRefExpr{
-									pos:  position{line: 308, col: 24, offset: 10535},
+									pos:  position{line: 308, col: 24, offset: 10632},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 309, col: 5, offset: 10559},
+							pos:   position{line: 309, col: 5, offset: 10656},
 							label: "level",
 							expr: &actionExpr{
-								pos: position{line: 309, col: 12, offset: 10566},
+								pos: position{line: 309, col: 12, offset: 10663},
 								run: (*parser).callonSection7,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 309, col: 12, offset: 10566},
+									pos: position{line: 309, col: 12, offset: 10663},
 									expr: &litMatcher{
-										pos:        position{line: 309, col: 13, offset: 10567},
+										pos:        position{line: 309, col: 13, offset: 10664},
 										val:        "=",
 										ignoreCase: false,
 									},
@@ -2760,37 +2777,37 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 313, col: 5, offset: 10658},
+							pos: position{line: 313, col: 5, offset: 10755},
 							run: (*parser).callonSection10,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 317, col: 5, offset: 10810},
+							pos: position{line: 317, col: 5, offset: 10907},
 							expr: &ruleRefExpr{
-								pos:  position{line: 317, col: 5, offset: 10810},
+								pos:  position{line: 317, col: 5, offset: 10907},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 317, col: 9, offset: 10814},
+							pos:   position{line: 317, col: 9, offset: 10911},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 317, col: 16, offset: 10821},
+								pos:  position{line: 317, col: 16, offset: 10918},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 317, col: 31, offset: 10836},
+							pos:   position{line: 317, col: 31, offset: 10933},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 317, col: 35, offset: 10840},
+								pos: position{line: 317, col: 35, offset: 10937},
 								expr: &ruleRefExpr{
-									pos:  position{line: 317, col: 35, offset: 10840},
+									pos:  position{line: 317, col: 35, offset: 10937},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 53, offset: 10858},
+							pos:  position{line: 317, col: 53, offset: 10955},
 							name: "EOL",
 						},
 					},
@@ -2799,34 +2816,34 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElements",
-			pos:  position{line: 321, col: 1, offset: 10964},
+			pos:  position{line: 321, col: 1, offset: 11061},
 			expr: &actionExpr{
-				pos: position{line: 321, col: 18, offset: 10981},
+				pos: position{line: 321, col: 18, offset: 11078},
 				run: (*parser).callonTitleElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 321, col: 18, offset: 10981},
+					pos:   position{line: 321, col: 18, offset: 11078},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 321, col: 27, offset: 10990},
+						pos: position{line: 321, col: 27, offset: 11087},
 						expr: &seqExpr{
-							pos: position{line: 321, col: 28, offset: 10991},
+							pos: position{line: 321, col: 28, offset: 11088},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 321, col: 28, offset: 10991},
+									pos: position{line: 321, col: 28, offset: 11088},
 									expr: &ruleRefExpr{
-										pos:  position{line: 321, col: 29, offset: 10992},
+										pos:  position{line: 321, col: 29, offset: 11089},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 321, col: 37, offset: 11000},
+									pos: position{line: 321, col: 37, offset: 11097},
 									expr: &ruleRefExpr{
-										pos:  position{line: 321, col: 38, offset: 11001},
+										pos:  position{line: 321, col: 38, offset: 11098},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 321, col: 54, offset: 11017},
+									pos:  position{line: 321, col: 54, offset: 11114},
 									name: "TitleElement",
 								},
 							},
@@ -2837,66 +2854,66 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElement",
-			pos:  position{line: 325, col: 1, offset: 11138},
+			pos:  position{line: 325, col: 1, offset: 11235},
 			expr: &actionExpr{
-				pos: position{line: 325, col: 17, offset: 11154},
+				pos: position{line: 325, col: 17, offset: 11251},
 				run: (*parser).callonTitleElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 325, col: 17, offset: 11154},
+					pos:   position{line: 325, col: 17, offset: 11251},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 325, col: 26, offset: 11163},
+						pos: position{line: 325, col: 26, offset: 11260},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 325, col: 26, offset: 11163},
+								pos:  position{line: 325, col: 26, offset: 11260},
 								name: "SimpleWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 326, col: 11, offset: 11184},
+								pos:  position{line: 326, col: 11, offset: 11281},
 								name: "Spaces",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 327, col: 11, offset: 11202},
+								pos:  position{line: 327, col: 11, offset: 11299},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 328, col: 11, offset: 11227},
+								pos:  position{line: 328, col: 11, offset: 11324},
 								name: "Passthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 329, col: 11, offset: 11249},
+								pos:  position{line: 329, col: 11, offset: 11346},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 330, col: 11, offset: 11272},
+								pos:  position{line: 330, col: 11, offset: 11369},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 331, col: 11, offset: 11287},
+								pos:  position{line: 331, col: 11, offset: 11384},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 332, col: 11, offset: 11312},
+								pos:  position{line: 332, col: 11, offset: 11409},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 333, col: 11, offset: 11333},
+								pos:  position{line: 333, col: 11, offset: 11430},
 								name: "DocumentAttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 334, col: 11, offset: 11373},
+								pos:  position{line: 334, col: 11, offset: 11470},
 								name: "LineBreak",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 335, col: 11, offset: 11393},
+								pos:  position{line: 335, col: 11, offset: 11490},
 								name: "Parenthesis",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 11, offset: 11415},
+								pos:  position{line: 336, col: 11, offset: 11512},
 								name: "AnyChars",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 337, col: 11, offset: 11434},
+								pos:  position{line: 337, col: 11, offset: 11531},
 								name: "AnyChar",
 							},
 						},
@@ -2906,17 +2923,17 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsPlaceHolder",
-			pos:  position{line: 344, col: 1, offset: 11586},
+			pos:  position{line: 344, col: 1, offset: 11683},
 			expr: &seqExpr{
-				pos: position{line: 344, col: 31, offset: 11616},
+				pos: position{line: 344, col: 31, offset: 11713},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 344, col: 31, offset: 11616},
+						pos:        position{line: 344, col: 31, offset: 11713},
 						val:        "toc::[]",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 344, col: 41, offset: 11626},
+						pos:  position{line: 344, col: 41, offset: 11723},
 						name: "EOL",
 					},
 				},
@@ -2924,39 +2941,39 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroBlock",
-			pos:  position{line: 349, col: 1, offset: 11737},
+			pos:  position{line: 349, col: 1, offset: 11834},
 			expr: &actionExpr{
-				pos: position{line: 349, col: 19, offset: 11755},
+				pos: position{line: 349, col: 19, offset: 11852},
 				run: (*parser).callonUserMacroBlock1,
 				expr: &seqExpr{
-					pos: position{line: 349, col: 19, offset: 11755},
+					pos: position{line: 349, col: 19, offset: 11852},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 349, col: 19, offset: 11755},
+							pos:   position{line: 349, col: 19, offset: 11852},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 349, col: 25, offset: 11761},
+								pos:  position{line: 349, col: 25, offset: 11858},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 349, col: 40, offset: 11776},
+							pos:        position{line: 349, col: 40, offset: 11873},
 							val:        "::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 349, col: 45, offset: 11781},
+							pos:   position{line: 349, col: 45, offset: 11878},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 349, col: 52, offset: 11788},
+								pos:  position{line: 349, col: 52, offset: 11885},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 349, col: 68, offset: 11804},
+							pos:   position{line: 349, col: 68, offset: 11901},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 349, col: 75, offset: 11811},
+								pos:  position{line: 349, col: 75, offset: 11908},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2966,39 +2983,39 @@ var g = &grammar{
 		},
 		{
 			name: "InlineUserMacro",
-			pos:  position{line: 353, col: 1, offset: 11952},
+			pos:  position{line: 353, col: 1, offset: 12049},
 			expr: &actionExpr{
-				pos: position{line: 353, col: 20, offset: 11971},
+				pos: position{line: 353, col: 20, offset: 12068},
 				run: (*parser).callonInlineUserMacro1,
 				expr: &seqExpr{
-					pos: position{line: 353, col: 20, offset: 11971},
+					pos: position{line: 353, col: 20, offset: 12068},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 353, col: 20, offset: 11971},
+							pos:   position{line: 353, col: 20, offset: 12068},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 353, col: 26, offset: 11977},
+								pos:  position{line: 353, col: 26, offset: 12074},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 353, col: 41, offset: 11992},
+							pos:        position{line: 353, col: 41, offset: 12089},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 353, col: 45, offset: 11996},
+							pos:   position{line: 353, col: 45, offset: 12093},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 353, col: 52, offset: 12003},
+								pos:  position{line: 353, col: 52, offset: 12100},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 353, col: 68, offset: 12019},
+							pos:   position{line: 353, col: 68, offset: 12116},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 353, col: 75, offset: 12026},
+								pos:  position{line: 353, col: 75, offset: 12123},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -3008,29 +3025,29 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroName",
-			pos:  position{line: 357, col: 1, offset: 12168},
+			pos:  position{line: 357, col: 1, offset: 12265},
 			expr: &actionExpr{
-				pos: position{line: 357, col: 18, offset: 12185},
+				pos: position{line: 357, col: 18, offset: 12282},
 				run: (*parser).callonUserMacroName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 357, col: 18, offset: 12185},
+					pos: position{line: 357, col: 18, offset: 12282},
 					expr: &choiceExpr{
-						pos: position{line: 357, col: 19, offset: 12186},
+						pos: position{line: 357, col: 19, offset: 12283},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 357, col: 19, offset: 12186},
+								pos:        position{line: 357, col: 19, offset: 12283},
 								val:        "[a-zA-Z0-9]",
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&litMatcher{
-								pos:        position{line: 357, col: 33, offset: 12200},
+								pos:        position{line: 357, col: 33, offset: 12297},
 								val:        "_",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 357, col: 39, offset: 12206},
+								pos:        position{line: 357, col: 39, offset: 12303},
 								val:        "-",
 								ignoreCase: false,
 							},
@@ -3041,54 +3058,54 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroValue",
-			pos:  position{line: 361, col: 1, offset: 12248},
+			pos:  position{line: 361, col: 1, offset: 12345},
 			expr: &actionExpr{
-				pos: position{line: 361, col: 19, offset: 12266},
+				pos: position{line: 361, col: 19, offset: 12363},
 				run: (*parser).callonUserMacroValue1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 361, col: 19, offset: 12266},
+					pos: position{line: 361, col: 19, offset: 12363},
 					expr: &choiceExpr{
-						pos: position{line: 361, col: 20, offset: 12267},
+						pos: position{line: 361, col: 20, offset: 12364},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 361, col: 20, offset: 12267},
+								pos:  position{line: 361, col: 20, offset: 12364},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 361, col: 33, offset: 12280},
+								pos: position{line: 361, col: 33, offset: 12377},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 361, col: 33, offset: 12280},
+										pos: position{line: 361, col: 33, offset: 12377},
 										expr: &ruleRefExpr{
-											pos:  position{line: 361, col: 34, offset: 12281},
+											pos:  position{line: 361, col: 34, offset: 12378},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 361, col: 37, offset: 12284},
+										pos: position{line: 361, col: 37, offset: 12381},
 										expr: &litMatcher{
-											pos:        position{line: 361, col: 38, offset: 12285},
+											pos:        position{line: 361, col: 38, offset: 12382},
 											val:        ":",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 361, col: 42, offset: 12289},
+										pos: position{line: 361, col: 42, offset: 12386},
 										expr: &litMatcher{
-											pos:        position{line: 361, col: 43, offset: 12290},
+											pos:        position{line: 361, col: 43, offset: 12387},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 361, col: 47, offset: 12294},
+										pos: position{line: 361, col: 47, offset: 12391},
 										expr: &ruleRefExpr{
-											pos:  position{line: 361, col: 48, offset: 12295},
+											pos:  position{line: 361, col: 48, offset: 12392},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 361, col: 52, offset: 12299,
+										line: 361, col: 52, offset: 12396,
 									},
 								},
 							},
@@ -3099,31 +3116,31 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroAttributes",
-			pos:  position{line: 365, col: 1, offset: 12340},
+			pos:  position{line: 365, col: 1, offset: 12437},
 			expr: &actionExpr{
-				pos: position{line: 365, col: 24, offset: 12363},
+				pos: position{line: 365, col: 24, offset: 12460},
 				run: (*parser).callonUserMacroAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 365, col: 24, offset: 12363},
+					pos: position{line: 365, col: 24, offset: 12460},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 365, col: 24, offset: 12363},
+							pos:        position{line: 365, col: 24, offset: 12460},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 365, col: 28, offset: 12367},
+							pos:   position{line: 365, col: 28, offset: 12464},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 365, col: 34, offset: 12373},
+								pos: position{line: 365, col: 34, offset: 12470},
 								expr: &ruleRefExpr{
-									pos:  position{line: 365, col: 35, offset: 12374},
+									pos:  position{line: 365, col: 35, offset: 12471},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 365, col: 54, offset: 12393},
+							pos:        position{line: 365, col: 54, offset: 12490},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -3133,40 +3150,40 @@ var g = &grammar{
 		},
 		{
 			name: "FileInclusion",
-			pos:  position{line: 372, col: 1, offset: 12573},
+			pos:  position{line: 372, col: 1, offset: 12670},
 			expr: &actionExpr{
-				pos: position{line: 372, col: 18, offset: 12590},
+				pos: position{line: 372, col: 18, offset: 12687},
 				run: (*parser).callonFileInclusion1,
 				expr: &seqExpr{
-					pos: position{line: 372, col: 18, offset: 12590},
+					pos: position{line: 372, col: 18, offset: 12687},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 372, col: 18, offset: 12590},
+							pos:   position{line: 372, col: 18, offset: 12687},
 							label: "incl",
 							expr: &actionExpr{
-								pos: position{line: 372, col: 24, offset: 12596},
+								pos: position{line: 372, col: 24, offset: 12693},
 								run: (*parser).callonFileInclusion4,
 								expr: &seqExpr{
-									pos: position{line: 372, col: 24, offset: 12596},
+									pos: position{line: 372, col: 24, offset: 12693},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 372, col: 24, offset: 12596},
+											pos:        position{line: 372, col: 24, offset: 12693},
 											val:        "include::",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 372, col: 36, offset: 12608},
+											pos:   position{line: 372, col: 36, offset: 12705},
 											label: "path",
 											expr: &ruleRefExpr{
-												pos:  position{line: 372, col: 42, offset: 12614},
+												pos:  position{line: 372, col: 42, offset: 12711},
 												name: "FileLocation",
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 372, col: 56, offset: 12628},
+											pos:   position{line: 372, col: 56, offset: 12725},
 											label: "inlineAttributes",
 											expr: &ruleRefExpr{
-												pos:  position{line: 372, col: 74, offset: 12646},
+												pos:  position{line: 372, col: 74, offset: 12743},
 												name: "FileIncludeAttributes",
 											},
 										},
@@ -3175,14 +3192,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 374, col: 8, offset: 12800},
+							pos: position{line: 374, col: 8, offset: 12897},
 							expr: &ruleRefExpr{
-								pos:  position{line: 374, col: 8, offset: 12800},
+								pos:  position{line: 374, col: 8, offset: 12897},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 374, col: 12, offset: 12804},
+							pos:  position{line: 374, col: 12, offset: 12901},
 							name: "EOL",
 						},
 					},
@@ -3191,36 +3208,36 @@ var g = &grammar{
 		},
 		{
 			name: "FileIncludeAttributes",
-			pos:  position{line: 378, col: 1, offset: 12856},
+			pos:  position{line: 378, col: 1, offset: 12953},
 			expr: &actionExpr{
-				pos: position{line: 378, col: 26, offset: 12881},
+				pos: position{line: 378, col: 26, offset: 12978},
 				run: (*parser).callonFileIncludeAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 378, col: 26, offset: 12881},
+					pos: position{line: 378, col: 26, offset: 12978},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 378, col: 26, offset: 12881},
+							pos:        position{line: 378, col: 26, offset: 12978},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 378, col: 30, offset: 12885},
+							pos:   position{line: 378, col: 30, offset: 12982},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 378, col: 36, offset: 12891},
+								pos: position{line: 378, col: 36, offset: 12988},
 								expr: &choiceExpr{
-									pos: position{line: 378, col: 37, offset: 12892},
+									pos: position{line: 378, col: 37, offset: 12989},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 378, col: 37, offset: 12892},
+											pos:  position{line: 378, col: 37, offset: 12989},
 											name: "LineRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 378, col: 59, offset: 12914},
+											pos:  position{line: 378, col: 59, offset: 13011},
 											name: "TagRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 378, col: 80, offset: 12935},
+											pos:  position{line: 378, col: 80, offset: 13032},
 											name: "GenericAttribute",
 										},
 									},
@@ -3228,7 +3245,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 378, col: 99, offset: 12954},
+							pos:        position{line: 378, col: 99, offset: 13051},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -3238,30 +3255,30 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttribute",
-			pos:  position{line: 382, col: 1, offset: 13024},
+			pos:  position{line: 382, col: 1, offset: 13121},
 			expr: &actionExpr{
-				pos: position{line: 382, col: 24, offset: 13047},
+				pos: position{line: 382, col: 24, offset: 13144},
 				run: (*parser).callonLineRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 382, col: 24, offset: 13047},
+					pos: position{line: 382, col: 24, offset: 13144},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 382, col: 24, offset: 13047},
+							pos:        position{line: 382, col: 24, offset: 13144},
 							val:        "lines=",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 382, col: 33, offset: 13056},
+							pos:   position{line: 382, col: 33, offset: 13153},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 382, col: 40, offset: 13063},
+								pos:  position{line: 382, col: 40, offset: 13160},
 								name: "LineRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 382, col: 66, offset: 13089},
+							pos: position{line: 382, col: 66, offset: 13186},
 							expr: &litMatcher{
-								pos:        position{line: 382, col: 66, offset: 13089},
+								pos:        position{line: 382, col: 66, offset: 13186},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -3272,72 +3289,72 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttributeValue",
-			pos:  position{line: 386, col: 1, offset: 13148},
+			pos:  position{line: 386, col: 1, offset: 13245},
 			expr: &actionExpr{
-				pos: position{line: 386, col: 29, offset: 13176},
+				pos: position{line: 386, col: 29, offset: 13273},
 				run: (*parser).callonLineRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 386, col: 29, offset: 13176},
+					pos: position{line: 386, col: 29, offset: 13273},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 386, col: 29, offset: 13176},
+							pos:   position{line: 386, col: 29, offset: 13273},
 							label: "value",
 							expr: &choiceExpr{
-								pos: position{line: 386, col: 36, offset: 13183},
+								pos: position{line: 386, col: 36, offset: 13280},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 386, col: 36, offset: 13183},
+										pos:  position{line: 386, col: 36, offset: 13280},
 										name: "MultipleLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 387, col: 11, offset: 13300},
+										pos:  position{line: 387, col: 11, offset: 13397},
 										name: "MultipleQuotedLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 388, col: 11, offset: 13336},
+										pos:  position{line: 388, col: 11, offset: 13433},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 389, col: 11, offset: 13362},
+										pos:  position{line: 389, col: 11, offset: 13459},
 										name: "MultiLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 390, col: 11, offset: 13394},
+										pos:  position{line: 390, col: 11, offset: 13491},
 										name: "SingleLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 391, col: 11, offset: 13426},
+										pos:  position{line: 391, col: 11, offset: 13523},
 										name: "SingleLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 392, col: 11, offset: 13453},
+										pos:  position{line: 392, col: 11, offset: 13550},
 										name: "UndefinedLineRange",
 									},
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 392, col: 31, offset: 13473},
+							pos: position{line: 392, col: 31, offset: 13570},
 							expr: &ruleRefExpr{
-								pos:  position{line: 392, col: 31, offset: 13473},
+								pos:  position{line: 392, col: 31, offset: 13570},
 								name: "WS",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 392, col: 36, offset: 13478},
+							pos: position{line: 392, col: 36, offset: 13575},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 392, col: 36, offset: 13478},
+									pos: position{line: 392, col: 36, offset: 13575},
 									expr: &litMatcher{
-										pos:        position{line: 392, col: 37, offset: 13479},
+										pos:        position{line: 392, col: 37, offset: 13576},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&andExpr{
-									pos: position{line: 392, col: 43, offset: 13485},
+									pos: position{line: 392, col: 43, offset: 13582},
 									expr: &litMatcher{
-										pos:        position{line: 392, col: 44, offset: 13486},
+										pos:        position{line: 392, col: 44, offset: 13583},
 										val:        "]",
 										ignoreCase: false,
 									},
@@ -3350,58 +3367,58 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleLineRanges",
-			pos:  position{line: 396, col: 1, offset: 13518},
+			pos:  position{line: 396, col: 1, offset: 13615},
 			expr: &actionExpr{
-				pos: position{line: 396, col: 23, offset: 13540},
+				pos: position{line: 396, col: 23, offset: 13637},
 				run: (*parser).callonMultipleLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 396, col: 23, offset: 13540},
+					pos: position{line: 396, col: 23, offset: 13637},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 396, col: 23, offset: 13540},
+							pos:   position{line: 396, col: 23, offset: 13637},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 396, col: 30, offset: 13547},
+								pos: position{line: 396, col: 30, offset: 13644},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 396, col: 30, offset: 13547},
+										pos:  position{line: 396, col: 30, offset: 13644},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 396, col: 47, offset: 13564},
+										pos:  position{line: 396, col: 47, offset: 13661},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 5, offset: 13586},
+							pos:   position{line: 397, col: 5, offset: 13683},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 397, col: 12, offset: 13593},
+								pos: position{line: 397, col: 12, offset: 13690},
 								expr: &actionExpr{
-									pos: position{line: 397, col: 13, offset: 13594},
+									pos: position{line: 397, col: 13, offset: 13691},
 									run: (*parser).callonMultipleLineRanges9,
 									expr: &seqExpr{
-										pos: position{line: 397, col: 13, offset: 13594},
+										pos: position{line: 397, col: 13, offset: 13691},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 397, col: 13, offset: 13594},
+												pos:        position{line: 397, col: 13, offset: 13691},
 												val:        ";",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 397, col: 17, offset: 13598},
+												pos:   position{line: 397, col: 17, offset: 13695},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 397, col: 24, offset: 13605},
+													pos: position{line: 397, col: 24, offset: 13702},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 397, col: 24, offset: 13605},
+															pos:  position{line: 397, col: 24, offset: 13702},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 397, col: 41, offset: 13622},
+															pos:  position{line: 397, col: 41, offset: 13719},
 															name: "SingleLineRange",
 														},
 													},
@@ -3418,63 +3435,63 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleQuotedLineRanges",
-			pos:  position{line: 403, col: 1, offset: 13760},
+			pos:  position{line: 403, col: 1, offset: 13857},
 			expr: &actionExpr{
-				pos: position{line: 403, col: 29, offset: 13788},
+				pos: position{line: 403, col: 29, offset: 13885},
 				run: (*parser).callonMultipleQuotedLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 403, col: 29, offset: 13788},
+					pos: position{line: 403, col: 29, offset: 13885},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 403, col: 29, offset: 13788},
+							pos:        position{line: 403, col: 29, offset: 13885},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 403, col: 34, offset: 13793},
+							pos:   position{line: 403, col: 34, offset: 13890},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 403, col: 41, offset: 13800},
+								pos: position{line: 403, col: 41, offset: 13897},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 403, col: 41, offset: 13800},
+										pos:  position{line: 403, col: 41, offset: 13897},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 403, col: 58, offset: 13817},
+										pos:  position{line: 403, col: 58, offset: 13914},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 404, col: 5, offset: 13839},
+							pos:   position{line: 404, col: 5, offset: 13936},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 404, col: 12, offset: 13846},
+								pos: position{line: 404, col: 12, offset: 13943},
 								expr: &actionExpr{
-									pos: position{line: 404, col: 13, offset: 13847},
+									pos: position{line: 404, col: 13, offset: 13944},
 									run: (*parser).callonMultipleQuotedLineRanges10,
 									expr: &seqExpr{
-										pos: position{line: 404, col: 13, offset: 13847},
+										pos: position{line: 404, col: 13, offset: 13944},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 404, col: 13, offset: 13847},
+												pos:        position{line: 404, col: 13, offset: 13944},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 404, col: 17, offset: 13851},
+												pos:   position{line: 404, col: 17, offset: 13948},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 404, col: 24, offset: 13858},
+													pos: position{line: 404, col: 24, offset: 13955},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 404, col: 24, offset: 13858},
+															pos:  position{line: 404, col: 24, offset: 13955},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 404, col: 41, offset: 13875},
+															pos:  position{line: 404, col: 41, offset: 13972},
 															name: "SingleLineRange",
 														},
 													},
@@ -3486,7 +3503,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 406, col: 9, offset: 13928},
+							pos:        position{line: 406, col: 9, offset: 14025},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3496,31 +3513,31 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineRange",
-			pos:  position{line: 410, col: 1, offset: 14018},
+			pos:  position{line: 410, col: 1, offset: 14115},
 			expr: &actionExpr{
-				pos: position{line: 410, col: 19, offset: 14036},
+				pos: position{line: 410, col: 19, offset: 14133},
 				run: (*parser).callonMultiLineRange1,
 				expr: &seqExpr{
-					pos: position{line: 410, col: 19, offset: 14036},
+					pos: position{line: 410, col: 19, offset: 14133},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 410, col: 19, offset: 14036},
+							pos:   position{line: 410, col: 19, offset: 14133},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 410, col: 26, offset: 14043},
+								pos:  position{line: 410, col: 26, offset: 14140},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 410, col: 34, offset: 14051},
+							pos:        position{line: 410, col: 34, offset: 14148},
 							val:        "..",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 410, col: 39, offset: 14056},
+							pos:   position{line: 410, col: 39, offset: 14153},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 410, col: 44, offset: 14061},
+								pos:  position{line: 410, col: 44, offset: 14158},
 								name: "NUMBER",
 							},
 						},
@@ -3530,41 +3547,41 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineQuotedRange",
-			pos:  position{line: 414, col: 1, offset: 14149},
+			pos:  position{line: 414, col: 1, offset: 14246},
 			expr: &actionExpr{
-				pos: position{line: 414, col: 25, offset: 14173},
+				pos: position{line: 414, col: 25, offset: 14270},
 				run: (*parser).callonMultiLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 414, col: 25, offset: 14173},
+					pos: position{line: 414, col: 25, offset: 14270},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 414, col: 25, offset: 14173},
+							pos:        position{line: 414, col: 25, offset: 14270},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 414, col: 30, offset: 14178},
+							pos:   position{line: 414, col: 30, offset: 14275},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 414, col: 37, offset: 14185},
+								pos:  position{line: 414, col: 37, offset: 14282},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 414, col: 45, offset: 14193},
+							pos:        position{line: 414, col: 45, offset: 14290},
 							val:        "..",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 414, col: 50, offset: 14198},
+							pos:   position{line: 414, col: 50, offset: 14295},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 414, col: 55, offset: 14203},
+								pos:  position{line: 414, col: 55, offset: 14300},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 414, col: 63, offset: 14211},
+							pos:        position{line: 414, col: 63, offset: 14308},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3574,15 +3591,15 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineRange",
-			pos:  position{line: 418, col: 1, offset: 14296},
+			pos:  position{line: 418, col: 1, offset: 14393},
 			expr: &actionExpr{
-				pos: position{line: 418, col: 20, offset: 14315},
+				pos: position{line: 418, col: 20, offset: 14412},
 				run: (*parser).callonSingleLineRange1,
 				expr: &labeledExpr{
-					pos:   position{line: 418, col: 20, offset: 14315},
+					pos:   position{line: 418, col: 20, offset: 14412},
 					label: "singleline",
 					expr: &ruleRefExpr{
-						pos:  position{line: 418, col: 32, offset: 14327},
+						pos:  position{line: 418, col: 32, offset: 14424},
 						name: "NUMBER",
 					},
 				},
@@ -3590,28 +3607,28 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineQuotedRange",
-			pos:  position{line: 422, col: 1, offset: 14422},
+			pos:  position{line: 422, col: 1, offset: 14519},
 			expr: &actionExpr{
-				pos: position{line: 422, col: 26, offset: 14447},
+				pos: position{line: 422, col: 26, offset: 14544},
 				run: (*parser).callonSingleLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 422, col: 26, offset: 14447},
+					pos: position{line: 422, col: 26, offset: 14544},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 422, col: 26, offset: 14447},
+							pos:        position{line: 422, col: 26, offset: 14544},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 422, col: 31, offset: 14452},
+							pos:   position{line: 422, col: 31, offset: 14549},
 							label: "singleline",
 							expr: &ruleRefExpr{
-								pos:  position{line: 422, col: 43, offset: 14464},
+								pos:  position{line: 422, col: 43, offset: 14561},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 422, col: 51, offset: 14472},
+							pos:        position{line: 422, col: 51, offset: 14569},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3621,40 +3638,40 @@ var g = &grammar{
 		},
 		{
 			name: "UndefinedLineRange",
-			pos:  position{line: 426, col: 1, offset: 14564},
+			pos:  position{line: 426, col: 1, offset: 14661},
 			expr: &actionExpr{
-				pos: position{line: 426, col: 23, offset: 14586},
+				pos: position{line: 426, col: 23, offset: 14683},
 				run: (*parser).callonUndefinedLineRange1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 426, col: 23, offset: 14586},
+					pos: position{line: 426, col: 23, offset: 14683},
 					expr: &seqExpr{
-						pos: position{line: 426, col: 24, offset: 14587},
+						pos: position{line: 426, col: 24, offset: 14684},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 426, col: 24, offset: 14587},
+								pos: position{line: 426, col: 24, offset: 14684},
 								expr: &litMatcher{
-									pos:        position{line: 426, col: 25, offset: 14588},
+									pos:        position{line: 426, col: 25, offset: 14685},
 									val:        "]",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 426, col: 29, offset: 14592},
+								pos: position{line: 426, col: 29, offset: 14689},
 								expr: &litMatcher{
-									pos:        position{line: 426, col: 30, offset: 14593},
+									pos:        position{line: 426, col: 30, offset: 14690},
 									val:        ",",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 426, col: 34, offset: 14597},
+								pos: position{line: 426, col: 34, offset: 14694},
 								expr: &ruleRefExpr{
-									pos:  position{line: 426, col: 35, offset: 14598},
+									pos:  position{line: 426, col: 35, offset: 14695},
 									name: "WS",
 								},
 							},
 							&anyMatcher{
-								line: 426, col: 38, offset: 14601,
+								line: 426, col: 38, offset: 14698,
 							},
 						},
 					},
@@ -3663,40 +3680,40 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttribute",
-			pos:  position{line: 430, col: 1, offset: 14641},
+			pos:  position{line: 430, col: 1, offset: 14738},
 			expr: &actionExpr{
-				pos: position{line: 430, col: 23, offset: 14663},
+				pos: position{line: 430, col: 23, offset: 14760},
 				run: (*parser).callonTagRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 430, col: 23, offset: 14663},
+					pos: position{line: 430, col: 23, offset: 14760},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 430, col: 24, offset: 14664},
+							pos: position{line: 430, col: 24, offset: 14761},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 430, col: 24, offset: 14664},
+									pos:        position{line: 430, col: 24, offset: 14761},
 									val:        "tags=",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 430, col: 34, offset: 14674},
+									pos:        position{line: 430, col: 34, offset: 14771},
 									val:        "tag=",
 									ignoreCase: false,
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 430, col: 42, offset: 14682},
+							pos:   position{line: 430, col: 42, offset: 14779},
 							label: "tags",
 							expr: &ruleRefExpr{
-								pos:  position{line: 430, col: 48, offset: 14688},
+								pos:  position{line: 430, col: 48, offset: 14785},
 								name: "TagRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 430, col: 73, offset: 14713},
+							pos: position{line: 430, col: 73, offset: 14810},
 							expr: &litMatcher{
-								pos:        position{line: 430, col: 73, offset: 14713},
+								pos:        position{line: 430, col: 73, offset: 14810},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -3707,43 +3724,43 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttributeValue",
-			pos:  position{line: 434, col: 1, offset: 14862},
+			pos:  position{line: 434, col: 1, offset: 14959},
 			expr: &actionExpr{
-				pos: position{line: 434, col: 28, offset: 14889},
+				pos: position{line: 434, col: 28, offset: 14986},
 				run: (*parser).callonTagRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 434, col: 28, offset: 14889},
+					pos: position{line: 434, col: 28, offset: 14986},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 434, col: 28, offset: 14889},
+							pos:   position{line: 434, col: 28, offset: 14986},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 434, col: 35, offset: 14896},
+								pos:  position{line: 434, col: 35, offset: 14993},
 								name: "MultipleTagRanges",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 434, col: 54, offset: 14915},
+							pos: position{line: 434, col: 54, offset: 15012},
 							expr: &ruleRefExpr{
-								pos:  position{line: 434, col: 54, offset: 14915},
+								pos:  position{line: 434, col: 54, offset: 15012},
 								name: "WS",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 434, col: 59, offset: 14920},
+							pos: position{line: 434, col: 59, offset: 15017},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 434, col: 59, offset: 14920},
+									pos: position{line: 434, col: 59, offset: 15017},
 									expr: &litMatcher{
-										pos:        position{line: 434, col: 60, offset: 14921},
+										pos:        position{line: 434, col: 60, offset: 15018},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&andExpr{
-									pos: position{line: 434, col: 66, offset: 14927},
+									pos: position{line: 434, col: 66, offset: 15024},
 									expr: &litMatcher{
-										pos:        position{line: 434, col: 67, offset: 14928},
+										pos:        position{line: 434, col: 67, offset: 15025},
 										val:        "]",
 										ignoreCase: false,
 									},
@@ -3756,42 +3773,42 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleTagRanges",
-			pos:  position{line: 438, col: 1, offset: 14960},
+			pos:  position{line: 438, col: 1, offset: 15057},
 			expr: &actionExpr{
-				pos: position{line: 438, col: 22, offset: 14981},
+				pos: position{line: 438, col: 22, offset: 15078},
 				run: (*parser).callonMultipleTagRanges1,
 				expr: &seqExpr{
-					pos: position{line: 438, col: 22, offset: 14981},
+					pos: position{line: 438, col: 22, offset: 15078},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 438, col: 22, offset: 14981},
+							pos:   position{line: 438, col: 22, offset: 15078},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 438, col: 29, offset: 14988},
+								pos:  position{line: 438, col: 29, offset: 15085},
 								name: "TagRange",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 439, col: 5, offset: 15002},
+							pos:   position{line: 439, col: 5, offset: 15099},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 439, col: 12, offset: 15009},
+								pos: position{line: 439, col: 12, offset: 15106},
 								expr: &actionExpr{
-									pos: position{line: 439, col: 13, offset: 15010},
+									pos: position{line: 439, col: 13, offset: 15107},
 									run: (*parser).callonMultipleTagRanges7,
 									expr: &seqExpr{
-										pos: position{line: 439, col: 13, offset: 15010},
+										pos: position{line: 439, col: 13, offset: 15107},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 439, col: 13, offset: 15010},
+												pos:        position{line: 439, col: 13, offset: 15107},
 												val:        ";",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 439, col: 17, offset: 15014},
+												pos:   position{line: 439, col: 17, offset: 15111},
 												label: "other",
 												expr: &ruleRefExpr{
-													pos:  position{line: 439, col: 24, offset: 15021},
+													pos:  position{line: 439, col: 24, offset: 15118},
 													name: "TagRange",
 												},
 											},
@@ -3806,25 +3823,25 @@ var g = &grammar{
 		},
 		{
 			name: "TagRange",
-			pos:  position{line: 445, col: 1, offset: 15152},
+			pos:  position{line: 445, col: 1, offset: 15249},
 			expr: &choiceExpr{
-				pos: position{line: 445, col: 13, offset: 15164},
+				pos: position{line: 445, col: 13, offset: 15261},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 445, col: 13, offset: 15164},
+						pos: position{line: 445, col: 13, offset: 15261},
 						run: (*parser).callonTagRange2,
 						expr: &labeledExpr{
-							pos:   position{line: 445, col: 13, offset: 15164},
+							pos:   position{line: 445, col: 13, offset: 15261},
 							label: "tag",
 							expr: &choiceExpr{
-								pos: position{line: 445, col: 18, offset: 15169},
+								pos: position{line: 445, col: 18, offset: 15266},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 445, col: 18, offset: 15169},
+										pos:  position{line: 445, col: 18, offset: 15266},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 445, col: 30, offset: 15181},
+										pos:  position{line: 445, col: 30, offset: 15278},
 										name: "TagWildcard",
 									},
 								},
@@ -3832,28 +3849,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 447, col: 5, offset: 15249},
+						pos: position{line: 447, col: 5, offset: 15346},
 						run: (*parser).callonTagRange7,
 						expr: &seqExpr{
-							pos: position{line: 447, col: 5, offset: 15249},
+							pos: position{line: 447, col: 5, offset: 15346},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 447, col: 5, offset: 15249},
+									pos:        position{line: 447, col: 5, offset: 15346},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 447, col: 9, offset: 15253},
+									pos:   position{line: 447, col: 9, offset: 15350},
 									label: "tag",
 									expr: &choiceExpr{
-										pos: position{line: 447, col: 14, offset: 15258},
+										pos: position{line: 447, col: 14, offset: 15355},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 447, col: 14, offset: 15258},
+												pos:  position{line: 447, col: 14, offset: 15355},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 447, col: 26, offset: 15270},
+												pos:  position{line: 447, col: 26, offset: 15367},
 												name: "TagWildcard",
 											},
 										},
@@ -3867,23 +3884,23 @@ var g = &grammar{
 		},
 		{
 			name: "TagWildcard",
-			pos:  position{line: 451, col: 1, offset: 15338},
+			pos:  position{line: 451, col: 1, offset: 15435},
 			expr: &actionExpr{
-				pos: position{line: 451, col: 16, offset: 15353},
+				pos: position{line: 451, col: 16, offset: 15450},
 				run: (*parser).callonTagWildcard1,
 				expr: &seqExpr{
-					pos: position{line: 451, col: 16, offset: 15353},
+					pos: position{line: 451, col: 16, offset: 15450},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 451, col: 16, offset: 15353},
+							pos:   position{line: 451, col: 16, offset: 15450},
 							label: "stars",
 							expr: &actionExpr{
-								pos: position{line: 451, col: 23, offset: 15360},
+								pos: position{line: 451, col: 23, offset: 15457},
 								run: (*parser).callonTagWildcard4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 451, col: 23, offset: 15360},
+									pos: position{line: 451, col: 23, offset: 15457},
 									expr: &litMatcher{
-										pos:        position{line: 451, col: 24, offset: 15361},
+										pos:        position{line: 451, col: 24, offset: 15458},
 										val:        "*",
 										ignoreCase: false,
 									},
@@ -3891,7 +3908,7 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 454, col: 5, offset: 15415},
+							pos: position{line: 454, col: 5, offset: 15512},
 							run: (*parser).callonTagWildcard7,
 						},
 					},
@@ -3900,34 +3917,34 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileLine",
-			pos:  position{line: 464, col: 1, offset: 15709},
+			pos:  position{line: 464, col: 1, offset: 15806},
 			expr: &actionExpr{
-				pos: position{line: 464, col: 21, offset: 15729},
+				pos: position{line: 464, col: 21, offset: 15826},
 				run: (*parser).callonIncludedFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 464, col: 21, offset: 15729},
+					pos: position{line: 464, col: 21, offset: 15826},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 464, col: 21, offset: 15729},
+							pos:   position{line: 464, col: 21, offset: 15826},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 464, col: 29, offset: 15737},
+								pos: position{line: 464, col: 29, offset: 15834},
 								expr: &choiceExpr{
-									pos: position{line: 464, col: 30, offset: 15738},
+									pos: position{line: 464, col: 30, offset: 15835},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 464, col: 30, offset: 15738},
+											pos:  position{line: 464, col: 30, offset: 15835},
 											name: "IncludedFileStartTag",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 464, col: 53, offset: 15761},
+											pos:  position{line: 464, col: 53, offset: 15858},
 											name: "IncludedFileEndTag",
 										},
 										&actionExpr{
-											pos: position{line: 464, col: 74, offset: 15782},
+											pos: position{line: 464, col: 74, offset: 15879},
 											run: (*parser).callonIncludedFileLine8,
 											expr: &anyMatcher{
-												line: 464, col: 74, offset: 15782,
+												line: 464, col: 74, offset: 15879,
 											},
 										},
 									},
@@ -3935,7 +3952,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 107, offset: 15815},
+							pos:  position{line: 464, col: 107, offset: 15912},
 							name: "EOL",
 						},
 					},
@@ -3944,32 +3961,32 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileStartTag",
-			pos:  position{line: 468, col: 1, offset: 15886},
+			pos:  position{line: 468, col: 1, offset: 15983},
 			expr: &actionExpr{
-				pos: position{line: 468, col: 25, offset: 15910},
+				pos: position{line: 468, col: 25, offset: 16007},
 				run: (*parser).callonIncludedFileStartTag1,
 				expr: &seqExpr{
-					pos: position{line: 468, col: 25, offset: 15910},
+					pos: position{line: 468, col: 25, offset: 16007},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 468, col: 25, offset: 15910},
+							pos:        position{line: 468, col: 25, offset: 16007},
 							val:        "tag::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 468, col: 33, offset: 15918},
+							pos:   position{line: 468, col: 33, offset: 16015},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 468, col: 38, offset: 15923},
+								pos: position{line: 468, col: 38, offset: 16020},
 								run: (*parser).callonIncludedFileStartTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 468, col: 38, offset: 15923},
+									pos:  position{line: 468, col: 38, offset: 16020},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 78, offset: 15963},
+							pos:        position{line: 468, col: 78, offset: 16060},
 							val:        "[]",
 							ignoreCase: false,
 						},
@@ -3979,32 +3996,32 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileEndTag",
-			pos:  position{line: 472, col: 1, offset: 16028},
+			pos:  position{line: 472, col: 1, offset: 16125},
 			expr: &actionExpr{
-				pos: position{line: 472, col: 23, offset: 16050},
+				pos: position{line: 472, col: 23, offset: 16147},
 				run: (*parser).callonIncludedFileEndTag1,
 				expr: &seqExpr{
-					pos: position{line: 472, col: 23, offset: 16050},
+					pos: position{line: 472, col: 23, offset: 16147},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 472, col: 23, offset: 16050},
+							pos:        position{line: 472, col: 23, offset: 16147},
 							val:        "end::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 472, col: 31, offset: 16058},
+							pos:   position{line: 472, col: 31, offset: 16155},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 472, col: 36, offset: 16063},
+								pos: position{line: 472, col: 36, offset: 16160},
 								run: (*parser).callonIncludedFileEndTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 472, col: 36, offset: 16063},
+									pos:  position{line: 472, col: 36, offset: 16160},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 472, col: 76, offset: 16103},
+							pos:        position{line: 472, col: 76, offset: 16200},
 							val:        "[]",
 							ignoreCase: false,
 						},
@@ -4014,35 +4031,35 @@ var g = &grammar{
 		},
 		{
 			name: "ListItems",
-			pos:  position{line: 479, col: 1, offset: 16267},
+			pos:  position{line: 479, col: 1, offset: 16364},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 479, col: 14, offset: 16280},
+				pos: position{line: 479, col: 14, offset: 16377},
 				expr: &ruleRefExpr{
-					pos:  position{line: 479, col: 14, offset: 16280},
+					pos:  position{line: 479, col: 14, offset: 16377},
 					name: "ListItem",
 				},
 			},
 		},
 		{
 			name: "ListItem",
-			pos:  position{line: 481, col: 1, offset: 16291},
+			pos:  position{line: 481, col: 1, offset: 16388},
 			expr: &choiceExpr{
-				pos: position{line: 481, col: 13, offset: 16303},
+				pos: position{line: 481, col: 13, offset: 16400},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 481, col: 13, offset: 16303},
+						pos:  position{line: 481, col: 13, offset: 16400},
 						name: "OrderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 481, col: 31, offset: 16321},
+						pos:  position{line: 481, col: 31, offset: 16418},
 						name: "UnorderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 481, col: 51, offset: 16341},
+						pos:  position{line: 481, col: 51, offset: 16438},
 						name: "LabeledListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 481, col: 69, offset: 16359},
+						pos:  position{line: 481, col: 69, offset: 16456},
 						name: "ContinuedListItemElement",
 					},
 				},
@@ -4050,32 +4067,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 483, col: 1, offset: 16385},
+			pos:  position{line: 483, col: 1, offset: 16482},
 			expr: &choiceExpr{
-				pos: position{line: 483, col: 18, offset: 16402},
+				pos: position{line: 483, col: 18, offset: 16499},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 483, col: 18, offset: 16402},
+						pos: position{line: 483, col: 18, offset: 16499},
 						run: (*parser).callonListParagraph2,
 						expr: &labeledExpr{
-							pos:   position{line: 483, col: 18, offset: 16402},
+							pos:   position{line: 483, col: 18, offset: 16499},
 							label: "comment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 483, col: 27, offset: 16411},
+								pos:  position{line: 483, col: 27, offset: 16508},
 								name: "SingleLineComment",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 485, col: 9, offset: 16468},
+						pos: position{line: 485, col: 9, offset: 16565},
 						run: (*parser).callonListParagraph5,
 						expr: &labeledExpr{
-							pos:   position{line: 485, col: 9, offset: 16468},
+							pos:   position{line: 485, col: 9, offset: 16565},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 485, col: 15, offset: 16474},
+								pos: position{line: 485, col: 15, offset: 16571},
 								expr: &ruleRefExpr{
-									pos:  position{line: 485, col: 16, offset: 16475},
+									pos:  position{line: 485, col: 16, offset: 16572},
 									name: "ListParagraphLine",
 								},
 							},
@@ -4086,112 +4103,112 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraphLine",
-			pos:  position{line: 489, col: 1, offset: 16567},
+			pos:  position{line: 489, col: 1, offset: 16664},
 			expr: &actionExpr{
-				pos: position{line: 489, col: 22, offset: 16588},
+				pos: position{line: 489, col: 22, offset: 16685},
 				run: (*parser).callonListParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 489, col: 22, offset: 16588},
+					pos: position{line: 489, col: 22, offset: 16685},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 489, col: 22, offset: 16588},
+							pos: position{line: 489, col: 22, offset: 16685},
 							expr: &ruleRefExpr{
-								pos:  position{line: 489, col: 23, offset: 16589},
+								pos:  position{line: 489, col: 23, offset: 16686},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 490, col: 5, offset: 16597},
+							pos: position{line: 490, col: 5, offset: 16694},
 							expr: &ruleRefExpr{
-								pos:  position{line: 490, col: 6, offset: 16598},
+								pos:  position{line: 490, col: 6, offset: 16695},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 491, col: 5, offset: 16613},
+							pos: position{line: 491, col: 5, offset: 16710},
 							expr: &ruleRefExpr{
-								pos:  position{line: 491, col: 6, offset: 16614},
+								pos:  position{line: 491, col: 6, offset: 16711},
 								name: "SingleLineComment",
 							},
 						},
 						&notExpr{
-							pos: position{line: 492, col: 5, offset: 16636},
+							pos: position{line: 492, col: 5, offset: 16733},
 							expr: &ruleRefExpr{
-								pos:  position{line: 492, col: 6, offset: 16637},
+								pos:  position{line: 492, col: 6, offset: 16734},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 493, col: 5, offset: 16663},
+							pos: position{line: 493, col: 5, offset: 16760},
 							expr: &ruleRefExpr{
-								pos:  position{line: 493, col: 6, offset: 16664},
+								pos:  position{line: 493, col: 6, offset: 16761},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 494, col: 5, offset: 16692},
+							pos: position{line: 494, col: 5, offset: 16789},
 							expr: &ruleRefExpr{
-								pos:  position{line: 494, col: 6, offset: 16693},
+								pos:  position{line: 494, col: 6, offset: 16790},
 								name: "ListItemContinuation",
 							},
 						},
 						&notExpr{
-							pos: position{line: 495, col: 5, offset: 16718},
+							pos: position{line: 495, col: 5, offset: 16815},
 							expr: &ruleRefExpr{
-								pos:  position{line: 495, col: 6, offset: 16719},
+								pos:  position{line: 495, col: 6, offset: 16816},
 								name: "ElementAttribute",
 							},
 						},
 						&notExpr{
-							pos: position{line: 496, col: 5, offset: 16740},
+							pos: position{line: 496, col: 5, offset: 16837},
 							expr: &ruleRefExpr{
-								pos:  position{line: 496, col: 6, offset: 16741},
+								pos:  position{line: 496, col: 6, offset: 16838},
 								name: "BlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 497, col: 5, offset: 16760},
+							pos: position{line: 497, col: 5, offset: 16857},
 							expr: &seqExpr{
-								pos: position{line: 497, col: 7, offset: 16762},
+								pos: position{line: 497, col: 7, offset: 16859},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 497, col: 7, offset: 16762},
+										pos:  position{line: 497, col: 7, offset: 16859},
 										name: "SimpleLabeledListItemTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 497, col: 33, offset: 16788},
+										pos:  position{line: 497, col: 33, offset: 16885},
 										name: "LabeledListItemSeparator",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 5, offset: 16819},
+							pos:   position{line: 498, col: 5, offset: 16916},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 499, col: 9, offset: 16834},
+								pos: position{line: 499, col: 9, offset: 16931},
 								run: (*parser).callonListParagraphLine24,
 								expr: &seqExpr{
-									pos: position{line: 499, col: 9, offset: 16834},
+									pos: position{line: 499, col: 9, offset: 16931},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 499, col: 9, offset: 16834},
+											pos:   position{line: 499, col: 9, offset: 16931},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 499, col: 18, offset: 16843},
+												pos: position{line: 499, col: 18, offset: 16940},
 												expr: &ruleRefExpr{
-													pos:  position{line: 499, col: 19, offset: 16844},
+													pos:  position{line: 499, col: 19, offset: 16941},
 													name: "InlineElement",
 												},
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 499, col: 35, offset: 16860},
+											pos:   position{line: 499, col: 35, offset: 16957},
 											label: "linebreak",
 											expr: &zeroOrOneExpr{
-												pos: position{line: 499, col: 45, offset: 16870},
+												pos: position{line: 499, col: 45, offset: 16967},
 												expr: &ruleRefExpr{
-													pos:  position{line: 499, col: 46, offset: 16871},
+													pos:  position{line: 499, col: 46, offset: 16968},
 													name: "LineBreak",
 												},
 											},
@@ -4201,7 +4218,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 501, col: 12, offset: 17023},
+							pos:  position{line: 501, col: 12, offset: 17120},
 							name: "EOL",
 						},
 					},
@@ -4210,24 +4227,24 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 505, col: 1, offset: 17070},
+			pos:  position{line: 505, col: 1, offset: 17167},
 			expr: &seqExpr{
-				pos: position{line: 505, col: 25, offset: 17094},
+				pos: position{line: 505, col: 25, offset: 17191},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 505, col: 25, offset: 17094},
+						pos:        position{line: 505, col: 25, offset: 17191},
 						val:        "+",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 505, col: 29, offset: 17098},
+						pos: position{line: 505, col: 29, offset: 17195},
 						expr: &ruleRefExpr{
-							pos:  position{line: 505, col: 29, offset: 17098},
+							pos:  position{line: 505, col: 29, offset: 17195},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 505, col: 33, offset: 17102},
+						pos:  position{line: 505, col: 33, offset: 17199},
 						name: "EOL",
 					},
 				},
@@ -4235,33 +4252,33 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemElement",
-			pos:  position{line: 507, col: 1, offset: 17108},
+			pos:  position{line: 507, col: 1, offset: 17205},
 			expr: &actionExpr{
-				pos: position{line: 507, col: 29, offset: 17136},
+				pos: position{line: 507, col: 29, offset: 17233},
 				run: (*parser).callonContinuedListItemElement1,
 				expr: &seqExpr{
-					pos: position{line: 507, col: 29, offset: 17136},
+					pos: position{line: 507, col: 29, offset: 17233},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 507, col: 29, offset: 17136},
+							pos:   position{line: 507, col: 29, offset: 17233},
 							label: "blanklines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 507, col: 41, offset: 17148},
+								pos: position{line: 507, col: 41, offset: 17245},
 								expr: &ruleRefExpr{
-									pos:  position{line: 507, col: 41, offset: 17148},
+									pos:  position{line: 507, col: 41, offset: 17245},
 									name: "BlankLine",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 507, col: 53, offset: 17160},
+							pos:  position{line: 507, col: 53, offset: 17257},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 507, col: 74, offset: 17181},
+							pos:   position{line: 507, col: 74, offset: 17278},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 507, col: 82, offset: 17189},
+								pos:  position{line: 507, col: 82, offset: 17286},
 								name: "ContinuedListItemBlock",
 							},
 						},
@@ -4271,72 +4288,72 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemBlock",
-			pos:  position{line: 511, col: 1, offset: 17327},
+			pos:  position{line: 511, col: 1, offset: 17424},
 			expr: &actionExpr{
-				pos: position{line: 511, col: 27, offset: 17353},
+				pos: position{line: 511, col: 27, offset: 17450},
 				run: (*parser).callonContinuedListItemBlock1,
 				expr: &seqExpr{
-					pos: position{line: 511, col: 27, offset: 17353},
+					pos: position{line: 511, col: 27, offset: 17450},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 511, col: 27, offset: 17353},
+							pos: position{line: 511, col: 27, offset: 17450},
 							expr: &ruleRefExpr{
-								pos:  position{line: 511, col: 28, offset: 17354},
+								pos:  position{line: 511, col: 28, offset: 17451},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 512, col: 5, offset: 17363},
+							pos:   position{line: 512, col: 5, offset: 17460},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 512, col: 12, offset: 17370},
+								pos: position{line: 512, col: 12, offset: 17467},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 512, col: 12, offset: 17370},
+										pos:  position{line: 512, col: 12, offset: 17467},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 513, col: 11, offset: 17395},
+										pos:  position{line: 513, col: 11, offset: 17492},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 514, col: 11, offset: 17419},
+										pos:  position{line: 514, col: 11, offset: 17516},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 515, col: 11, offset: 17473},
+										pos:  position{line: 515, col: 11, offset: 17570},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 516, col: 11, offset: 17495},
+										pos:  position{line: 516, col: 11, offset: 17592},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 517, col: 11, offset: 17514},
+										pos:  position{line: 517, col: 11, offset: 17611},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 518, col: 11, offset: 17565},
+										pos:  position{line: 518, col: 11, offset: 17662},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 519, col: 11, offset: 17589},
+										pos:  position{line: 519, col: 11, offset: 17686},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 520, col: 11, offset: 17629},
+										pos:  position{line: 520, col: 11, offset: 17726},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 521, col: 11, offset: 17663},
+										pos:  position{line: 521, col: 11, offset: 17760},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 522, col: 11, offset: 17700},
+										pos:  position{line: 522, col: 11, offset: 17797},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 523, col: 11, offset: 17725},
+										pos:  position{line: 523, col: 11, offset: 17822},
 										name: "ContinuedParagraph",
 									},
 								},
@@ -4348,37 +4365,37 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 530, col: 1, offset: 17886},
+			pos:  position{line: 530, col: 1, offset: 17983},
 			expr: &actionExpr{
-				pos: position{line: 530, col: 20, offset: 17905},
+				pos: position{line: 530, col: 20, offset: 18002},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 530, col: 20, offset: 17905},
+					pos: position{line: 530, col: 20, offset: 18002},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 530, col: 20, offset: 17905},
+							pos:   position{line: 530, col: 20, offset: 18002},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 530, col: 31, offset: 17916},
+								pos: position{line: 530, col: 31, offset: 18013},
 								expr: &ruleRefExpr{
-									pos:  position{line: 530, col: 32, offset: 17917},
+									pos:  position{line: 530, col: 32, offset: 18014},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 530, col: 52, offset: 17937},
+							pos:   position{line: 530, col: 52, offset: 18034},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 530, col: 60, offset: 17945},
+								pos:  position{line: 530, col: 60, offset: 18042},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 530, col: 83, offset: 17968},
+							pos:   position{line: 530, col: 83, offset: 18065},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 530, col: 92, offset: 17977},
+								pos:  position{line: 530, col: 92, offset: 18074},
 								name: "OrderedListItemContent",
 							},
 						},
@@ -4388,42 +4405,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 534, col: 1, offset: 18117},
+			pos:  position{line: 534, col: 1, offset: 18214},
 			expr: &actionExpr{
-				pos: position{line: 535, col: 5, offset: 18147},
+				pos: position{line: 535, col: 5, offset: 18244},
 				run: (*parser).callonOrderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 535, col: 5, offset: 18147},
+					pos: position{line: 535, col: 5, offset: 18244},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 535, col: 5, offset: 18147},
+							pos: position{line: 535, col: 5, offset: 18244},
 							expr: &ruleRefExpr{
-								pos:  position{line: 535, col: 5, offset: 18147},
+								pos:  position{line: 535, col: 5, offset: 18244},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 535, col: 9, offset: 18151},
+							pos:   position{line: 535, col: 9, offset: 18248},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 537, col: 9, offset: 18214},
+								pos: position{line: 537, col: 9, offset: 18311},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 537, col: 9, offset: 18214},
+										pos: position{line: 537, col: 9, offset: 18311},
 										run: (*parser).callonOrderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 537, col: 9, offset: 18214},
+											pos: position{line: 537, col: 9, offset: 18311},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 537, col: 9, offset: 18214},
+													pos:   position{line: 537, col: 9, offset: 18311},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 537, col: 16, offset: 18221},
+														pos: position{line: 537, col: 16, offset: 18318},
 														run: (*parser).callonOrderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 537, col: 16, offset: 18221},
+															pos: position{line: 537, col: 16, offset: 18318},
 															expr: &litMatcher{
-																pos:        position{line: 537, col: 17, offset: 18222},
+																pos:        position{line: 537, col: 17, offset: 18319},
 																val:        ".",
 																ignoreCase: false,
 															},
@@ -4431,22 +4448,22 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 541, col: 9, offset: 18322},
+													pos: position{line: 541, col: 9, offset: 18419},
 													run: (*parser).callonOrderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 560, col: 11, offset: 19039},
+										pos: position{line: 560, col: 11, offset: 19136},
 										run: (*parser).callonOrderedListItemPrefix14,
 										expr: &seqExpr{
-											pos: position{line: 560, col: 11, offset: 19039},
+											pos: position{line: 560, col: 11, offset: 19136},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 560, col: 11, offset: 19039},
+													pos: position{line: 560, col: 11, offset: 19136},
 													expr: &charClassMatcher{
-														pos:        position{line: 560, col: 12, offset: 19040},
+														pos:        position{line: 560, col: 12, offset: 19137},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -4454,7 +4471,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 560, col: 20, offset: 19048},
+													pos:        position{line: 560, col: 20, offset: 19145},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4462,20 +4479,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 562, col: 13, offset: 19159},
+										pos: position{line: 562, col: 13, offset: 19256},
 										run: (*parser).callonOrderedListItemPrefix19,
 										expr: &seqExpr{
-											pos: position{line: 562, col: 13, offset: 19159},
+											pos: position{line: 562, col: 13, offset: 19256},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 562, col: 14, offset: 19160},
+													pos:        position{line: 562, col: 14, offset: 19257},
 													val:        "[a-z]",
 													ranges:     []rune{'a', 'z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 562, col: 21, offset: 19167},
+													pos:        position{line: 562, col: 21, offset: 19264},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4483,20 +4500,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 564, col: 13, offset: 19281},
+										pos: position{line: 564, col: 13, offset: 19378},
 										run: (*parser).callonOrderedListItemPrefix23,
 										expr: &seqExpr{
-											pos: position{line: 564, col: 13, offset: 19281},
+											pos: position{line: 564, col: 13, offset: 19378},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 564, col: 14, offset: 19282},
+													pos:        position{line: 564, col: 14, offset: 19379},
 													val:        "[A-Z]",
 													ranges:     []rune{'A', 'Z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 564, col: 21, offset: 19289},
+													pos:        position{line: 564, col: 21, offset: 19386},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4504,15 +4521,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 566, col: 13, offset: 19403},
+										pos: position{line: 566, col: 13, offset: 19500},
 										run: (*parser).callonOrderedListItemPrefix27,
 										expr: &seqExpr{
-											pos: position{line: 566, col: 13, offset: 19403},
+											pos: position{line: 566, col: 13, offset: 19500},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 566, col: 13, offset: 19403},
+													pos: position{line: 566, col: 13, offset: 19500},
 													expr: &charClassMatcher{
-														pos:        position{line: 566, col: 14, offset: 19404},
+														pos:        position{line: 566, col: 14, offset: 19501},
 														val:        "[a-z]",
 														ranges:     []rune{'a', 'z'},
 														ignoreCase: false,
@@ -4520,7 +4537,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 566, col: 22, offset: 19412},
+													pos:        position{line: 566, col: 22, offset: 19509},
 													val:        ")",
 													ignoreCase: false,
 												},
@@ -4528,15 +4545,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 568, col: 13, offset: 19526},
+										pos: position{line: 568, col: 13, offset: 19623},
 										run: (*parser).callonOrderedListItemPrefix32,
 										expr: &seqExpr{
-											pos: position{line: 568, col: 13, offset: 19526},
+											pos: position{line: 568, col: 13, offset: 19623},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 568, col: 13, offset: 19526},
+													pos: position{line: 568, col: 13, offset: 19623},
 													expr: &charClassMatcher{
-														pos:        position{line: 568, col: 14, offset: 19527},
+														pos:        position{line: 568, col: 14, offset: 19624},
 														val:        "[A-Z]",
 														ranges:     []rune{'A', 'Z'},
 														ignoreCase: false,
@@ -4544,7 +4561,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 568, col: 22, offset: 19535},
+													pos:        position{line: 568, col: 22, offset: 19632},
 													val:        ")",
 													ignoreCase: false,
 												},
@@ -4555,9 +4572,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 570, col: 12, offset: 19648},
+							pos: position{line: 570, col: 12, offset: 19745},
 							expr: &ruleRefExpr{
-								pos:  position{line: 570, col: 12, offset: 19648},
+								pos:  position{line: 570, col: 12, offset: 19745},
 								name: "WS",
 							},
 						},
@@ -4567,17 +4584,17 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 574, col: 1, offset: 19680},
+			pos:  position{line: 574, col: 1, offset: 19777},
 			expr: &actionExpr{
-				pos: position{line: 574, col: 27, offset: 19706},
+				pos: position{line: 574, col: 27, offset: 19803},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 574, col: 27, offset: 19706},
+					pos:   position{line: 574, col: 27, offset: 19803},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 574, col: 37, offset: 19716},
+						pos: position{line: 574, col: 37, offset: 19813},
 						expr: &ruleRefExpr{
-							pos:  position{line: 574, col: 37, offset: 19716},
+							pos:  position{line: 574, col: 37, offset: 19813},
 							name: "ListParagraph",
 						},
 					},
@@ -4586,48 +4603,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 581, col: 1, offset: 19916},
+			pos:  position{line: 581, col: 1, offset: 20013},
 			expr: &actionExpr{
-				pos: position{line: 581, col: 22, offset: 19937},
+				pos: position{line: 581, col: 22, offset: 20034},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 581, col: 22, offset: 19937},
+					pos: position{line: 581, col: 22, offset: 20034},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 581, col: 22, offset: 19937},
+							pos:   position{line: 581, col: 22, offset: 20034},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 581, col: 33, offset: 19948},
+								pos: position{line: 581, col: 33, offset: 20045},
 								expr: &ruleRefExpr{
-									pos:  position{line: 581, col: 34, offset: 19949},
+									pos:  position{line: 581, col: 34, offset: 20046},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 581, col: 54, offset: 19969},
+							pos:   position{line: 581, col: 54, offset: 20066},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 62, offset: 19977},
+								pos:  position{line: 581, col: 62, offset: 20074},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 581, col: 87, offset: 20002},
+							pos:   position{line: 581, col: 87, offset: 20099},
 							label: "checkstyle",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 581, col: 98, offset: 20013},
+								pos: position{line: 581, col: 98, offset: 20110},
 								expr: &ruleRefExpr{
-									pos:  position{line: 581, col: 99, offset: 20014},
+									pos:  position{line: 581, col: 99, offset: 20111},
 									name: "UnorderedListItemCheckStyle",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 581, col: 129, offset: 20044},
+							pos:   position{line: 581, col: 129, offset: 20141},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 138, offset: 20053},
+								pos:  position{line: 581, col: 138, offset: 20150},
 								name: "UnorderedListItemContent",
 							},
 						},
@@ -4637,42 +4654,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 585, col: 1, offset: 20211},
+			pos:  position{line: 585, col: 1, offset: 20308},
 			expr: &actionExpr{
-				pos: position{line: 586, col: 5, offset: 20243},
+				pos: position{line: 586, col: 5, offset: 20340},
 				run: (*parser).callonUnorderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 586, col: 5, offset: 20243},
+					pos: position{line: 586, col: 5, offset: 20340},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 586, col: 5, offset: 20243},
+							pos: position{line: 586, col: 5, offset: 20340},
 							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 5, offset: 20243},
+								pos:  position{line: 586, col: 5, offset: 20340},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 586, col: 9, offset: 20247},
+							pos:   position{line: 586, col: 9, offset: 20344},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 586, col: 17, offset: 20255},
+								pos: position{line: 586, col: 17, offset: 20352},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 588, col: 9, offset: 20312},
+										pos: position{line: 588, col: 9, offset: 20409},
 										run: (*parser).callonUnorderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 588, col: 9, offset: 20312},
+											pos: position{line: 588, col: 9, offset: 20409},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 588, col: 9, offset: 20312},
+													pos:   position{line: 588, col: 9, offset: 20409},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 588, col: 16, offset: 20319},
+														pos: position{line: 588, col: 16, offset: 20416},
 														run: (*parser).callonUnorderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 588, col: 16, offset: 20319},
+															pos: position{line: 588, col: 16, offset: 20416},
 															expr: &litMatcher{
-																pos:        position{line: 588, col: 17, offset: 20320},
+																pos:        position{line: 588, col: 17, offset: 20417},
 																val:        "*",
 																ignoreCase: false,
 															},
@@ -4680,20 +4697,20 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 592, col: 9, offset: 20420},
+													pos: position{line: 592, col: 9, offset: 20517},
 													run: (*parser).callonUnorderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 609, col: 14, offset: 21127},
+										pos:   position{line: 609, col: 14, offset: 21224},
 										label: "depth",
 										expr: &actionExpr{
-											pos: position{line: 609, col: 21, offset: 21134},
+											pos: position{line: 609, col: 21, offset: 21231},
 											run: (*parser).callonUnorderedListItemPrefix15,
 											expr: &litMatcher{
-												pos:        position{line: 609, col: 22, offset: 21135},
+												pos:        position{line: 609, col: 22, offset: 21232},
 												val:        "-",
 												ignoreCase: false,
 											},
@@ -4703,9 +4720,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 611, col: 13, offset: 21221},
+							pos: position{line: 611, col: 13, offset: 21318},
 							expr: &ruleRefExpr{
-								pos:  position{line: 611, col: 13, offset: 21221},
+								pos:  position{line: 611, col: 13, offset: 21318},
 								name: "WS",
 							},
 						},
@@ -4715,50 +4732,50 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemCheckStyle",
-			pos:  position{line: 615, col: 1, offset: 21254},
+			pos:  position{line: 615, col: 1, offset: 21351},
 			expr: &actionExpr{
-				pos: position{line: 615, col: 32, offset: 21285},
+				pos: position{line: 615, col: 32, offset: 21382},
 				run: (*parser).callonUnorderedListItemCheckStyle1,
 				expr: &seqExpr{
-					pos: position{line: 615, col: 32, offset: 21285},
+					pos: position{line: 615, col: 32, offset: 21382},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 615, col: 32, offset: 21285},
+							pos: position{line: 615, col: 32, offset: 21382},
 							expr: &litMatcher{
-								pos:        position{line: 615, col: 33, offset: 21286},
+								pos:        position{line: 615, col: 33, offset: 21383},
 								val:        "[",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 615, col: 37, offset: 21290},
+							pos:   position{line: 615, col: 37, offset: 21387},
 							label: "style",
 							expr: &choiceExpr{
-								pos: position{line: 616, col: 7, offset: 21304},
+								pos: position{line: 616, col: 7, offset: 21401},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 616, col: 7, offset: 21304},
+										pos: position{line: 616, col: 7, offset: 21401},
 										run: (*parser).callonUnorderedListItemCheckStyle7,
 										expr: &litMatcher{
-											pos:        position{line: 616, col: 7, offset: 21304},
+											pos:        position{line: 616, col: 7, offset: 21401},
 											val:        "[ ]",
 											ignoreCase: false,
 										},
 									},
 									&actionExpr{
-										pos: position{line: 617, col: 7, offset: 21349},
+										pos: position{line: 617, col: 7, offset: 21446},
 										run: (*parser).callonUnorderedListItemCheckStyle9,
 										expr: &litMatcher{
-											pos:        position{line: 617, col: 7, offset: 21349},
+											pos:        position{line: 617, col: 7, offset: 21446},
 											val:        "[*]",
 											ignoreCase: false,
 										},
 									},
 									&actionExpr{
-										pos: position{line: 618, col: 7, offset: 21392},
+										pos: position{line: 618, col: 7, offset: 21489},
 										run: (*parser).callonUnorderedListItemCheckStyle11,
 										expr: &litMatcher{
-											pos:        position{line: 618, col: 7, offset: 21392},
+											pos:        position{line: 618, col: 7, offset: 21489},
 											val:        "[x]",
 											ignoreCase: false,
 										},
@@ -4767,9 +4784,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 619, col: 7, offset: 21434},
+							pos: position{line: 619, col: 7, offset: 21531},
 							expr: &ruleRefExpr{
-								pos:  position{line: 619, col: 7, offset: 21434},
+								pos:  position{line: 619, col: 7, offset: 21531},
 								name: "WS",
 							},
 						},
@@ -4779,17 +4796,17 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 623, col: 1, offset: 21473},
+			pos:  position{line: 623, col: 1, offset: 21570},
 			expr: &actionExpr{
-				pos: position{line: 623, col: 29, offset: 21501},
+				pos: position{line: 623, col: 29, offset: 21598},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 623, col: 29, offset: 21501},
+					pos:   position{line: 623, col: 29, offset: 21598},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 623, col: 39, offset: 21511},
+						pos: position{line: 623, col: 39, offset: 21608},
 						expr: &ruleRefExpr{
-							pos:  position{line: 623, col: 39, offset: 21511},
+							pos:  position{line: 623, col: 39, offset: 21608},
 							name: "ListParagraph",
 						},
 					},
@@ -4798,47 +4815,47 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 630, col: 1, offset: 21827},
+			pos:  position{line: 630, col: 1, offset: 21924},
 			expr: &actionExpr{
-				pos: position{line: 630, col: 20, offset: 21846},
+				pos: position{line: 630, col: 20, offset: 21943},
 				run: (*parser).callonLabeledListItem1,
 				expr: &seqExpr{
-					pos: position{line: 630, col: 20, offset: 21846},
+					pos: position{line: 630, col: 20, offset: 21943},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 630, col: 20, offset: 21846},
+							pos:   position{line: 630, col: 20, offset: 21943},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 630, col: 31, offset: 21857},
+								pos: position{line: 630, col: 31, offset: 21954},
 								expr: &ruleRefExpr{
-									pos:  position{line: 630, col: 32, offset: 21858},
+									pos:  position{line: 630, col: 32, offset: 21955},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 630, col: 52, offset: 21878},
+							pos:   position{line: 630, col: 52, offset: 21975},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 630, col: 58, offset: 21884},
+								pos:  position{line: 630, col: 58, offset: 21981},
 								name: "SimpleLabeledListItemTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 630, col: 85, offset: 21911},
+							pos:   position{line: 630, col: 85, offset: 22008},
 							label: "separator",
 							expr: &ruleRefExpr{
-								pos:  position{line: 630, col: 96, offset: 21922},
+								pos:  position{line: 630, col: 96, offset: 22019},
 								name: "LabeledListItemSeparator",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 630, col: 122, offset: 21948},
+							pos:   position{line: 630, col: 122, offset: 22045},
 							label: "description",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 630, col: 134, offset: 21960},
+								pos: position{line: 630, col: 134, offset: 22057},
 								expr: &ruleRefExpr{
-									pos:  position{line: 630, col: 135, offset: 21961},
+									pos:  position{line: 630, col: 135, offset: 22058},
 									name: "LabeledListItemDescription",
 								},
 							},
@@ -4849,48 +4866,48 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleLabeledListItemTerm",
-			pos:  position{line: 634, col: 1, offset: 22107},
+			pos:  position{line: 634, col: 1, offset: 22204},
 			expr: &actionExpr{
-				pos: position{line: 634, col: 30, offset: 22136},
+				pos: position{line: 634, col: 30, offset: 22233},
 				run: (*parser).callonSimpleLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 634, col: 30, offset: 22136},
+					pos:   position{line: 634, col: 30, offset: 22233},
 					label: "content",
 					expr: &actionExpr{
-						pos: position{line: 634, col: 39, offset: 22145},
+						pos: position{line: 634, col: 39, offset: 22242},
 						run: (*parser).callonSimpleLabeledListItemTerm3,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 634, col: 39, offset: 22145},
+							pos: position{line: 634, col: 39, offset: 22242},
 							expr: &choiceExpr{
-								pos: position{line: 634, col: 40, offset: 22146},
+								pos: position{line: 634, col: 40, offset: 22243},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 634, col: 40, offset: 22146},
+										pos:  position{line: 634, col: 40, offset: 22243},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 634, col: 52, offset: 22158},
+										pos:  position{line: 634, col: 52, offset: 22255},
 										name: "Spaces",
 									},
 									&seqExpr{
-										pos: position{line: 634, col: 62, offset: 22168},
+										pos: position{line: 634, col: 62, offset: 22265},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 634, col: 62, offset: 22168},
+												pos: position{line: 634, col: 62, offset: 22265},
 												expr: &ruleRefExpr{
-													pos:  position{line: 634, col: 63, offset: 22169},
+													pos:  position{line: 634, col: 63, offset: 22266},
 													name: "Newline",
 												},
 											},
 											&notExpr{
-												pos: position{line: 634, col: 71, offset: 22177},
+												pos: position{line: 634, col: 71, offset: 22274},
 												expr: &ruleRefExpr{
-													pos:  position{line: 634, col: 72, offset: 22178},
+													pos:  position{line: 634, col: 72, offset: 22275},
 													name: "LabeledListItemSeparator",
 												},
 											},
 											&anyMatcher{
-												line: 634, col: 97, offset: 22203,
+												line: 634, col: 97, offset: 22300,
 											},
 										},
 									},
@@ -4903,34 +4920,34 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 640, col: 1, offset: 22332},
+			pos:  position{line: 640, col: 1, offset: 22429},
 			expr: &actionExpr{
-				pos: position{line: 640, col: 24, offset: 22355},
+				pos: position{line: 640, col: 24, offset: 22452},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 640, col: 24, offset: 22355},
+					pos:   position{line: 640, col: 24, offset: 22452},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 640, col: 33, offset: 22364},
+						pos: position{line: 640, col: 33, offset: 22461},
 						expr: &seqExpr{
-							pos: position{line: 640, col: 34, offset: 22365},
+							pos: position{line: 640, col: 34, offset: 22462},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 640, col: 34, offset: 22365},
+									pos: position{line: 640, col: 34, offset: 22462},
 									expr: &ruleRefExpr{
-										pos:  position{line: 640, col: 35, offset: 22366},
+										pos:  position{line: 640, col: 35, offset: 22463},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 640, col: 43, offset: 22374},
+									pos: position{line: 640, col: 43, offset: 22471},
 									expr: &ruleRefExpr{
-										pos:  position{line: 640, col: 44, offset: 22375},
+										pos:  position{line: 640, col: 44, offset: 22472},
 										name: "LabeledListItemSeparator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 640, col: 69, offset: 22400},
+									pos:  position{line: 640, col: 69, offset: 22497},
 									name: "LabeledListItemTermElement",
 								},
 							},
@@ -4941,74 +4958,74 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTermElement",
-			pos:  position{line: 644, col: 1, offset: 22535},
+			pos:  position{line: 644, col: 1, offset: 22632},
 			expr: &actionExpr{
-				pos: position{line: 644, col: 31, offset: 22565},
+				pos: position{line: 644, col: 31, offset: 22662},
 				run: (*parser).callonLabeledListItemTermElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 644, col: 31, offset: 22565},
+					pos:   position{line: 644, col: 31, offset: 22662},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 644, col: 40, offset: 22574},
+						pos: position{line: 644, col: 40, offset: 22671},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 644, col: 40, offset: 22574},
+								pos:  position{line: 644, col: 40, offset: 22671},
 								name: "SimpleWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 645, col: 11, offset: 22595},
+								pos:  position{line: 645, col: 11, offset: 22692},
 								name: "Spaces",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 646, col: 11, offset: 22613},
+								pos:  position{line: 646, col: 11, offset: 22710},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 647, col: 11, offset: 22638},
+								pos:  position{line: 647, col: 11, offset: 22735},
 								name: "ConcealedIndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 648, col: 11, offset: 22667},
+								pos:  position{line: 648, col: 11, offset: 22764},
 								name: "IndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 649, col: 11, offset: 22687},
+								pos:  position{line: 649, col: 11, offset: 22784},
 								name: "Passthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 650, col: 11, offset: 22709},
+								pos:  position{line: 650, col: 11, offset: 22806},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 651, col: 11, offset: 22732},
+								pos:  position{line: 651, col: 11, offset: 22829},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 652, col: 11, offset: 22747},
+								pos:  position{line: 652, col: 11, offset: 22844},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 653, col: 11, offset: 22772},
+								pos:  position{line: 653, col: 11, offset: 22869},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 654, col: 11, offset: 22793},
+								pos:  position{line: 654, col: 11, offset: 22890},
 								name: "DocumentAttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 655, col: 11, offset: 22833},
+								pos:  position{line: 655, col: 11, offset: 22930},
 								name: "LineBreak",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 656, col: 11, offset: 22853},
+								pos:  position{line: 656, col: 11, offset: 22950},
 								name: "Parenthesis",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 657, col: 11, offset: 22875},
+								pos:  position{line: 657, col: 11, offset: 22972},
 								name: "AnyChars",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 658, col: 11, offset: 22894},
+								pos:  position{line: 658, col: 11, offset: 22991},
 								name: "AnyChar",
 							},
 						},
@@ -5018,23 +5035,23 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 662, col: 1, offset: 22933},
+			pos:  position{line: 662, col: 1, offset: 23030},
 			expr: &actionExpr{
-				pos: position{line: 663, col: 5, offset: 22966},
+				pos: position{line: 663, col: 5, offset: 23063},
 				run: (*parser).callonLabeledListItemSeparator1,
 				expr: &seqExpr{
-					pos: position{line: 663, col: 5, offset: 22966},
+					pos: position{line: 663, col: 5, offset: 23063},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 663, col: 5, offset: 22966},
+							pos:   position{line: 663, col: 5, offset: 23063},
 							label: "separator",
 							expr: &actionExpr{
-								pos: position{line: 663, col: 16, offset: 22977},
+								pos: position{line: 663, col: 16, offset: 23074},
 								run: (*parser).callonLabeledListItemSeparator4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 663, col: 16, offset: 22977},
+									pos: position{line: 663, col: 16, offset: 23074},
 									expr: &litMatcher{
-										pos:        position{line: 663, col: 17, offset: 22978},
+										pos:        position{line: 663, col: 17, offset: 23075},
 										val:        ":",
 										ignoreCase: false,
 									},
@@ -5042,30 +5059,30 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 666, col: 5, offset: 23036},
+							pos: position{line: 666, col: 5, offset: 23133},
 							run: (*parser).callonLabeledListItemSeparator7,
 						},
 						&choiceExpr{
-							pos: position{line: 670, col: 6, offset: 23212},
+							pos: position{line: 670, col: 6, offset: 23309},
 							alternatives: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 670, col: 6, offset: 23212},
+									pos: position{line: 670, col: 6, offset: 23309},
 									expr: &choiceExpr{
-										pos: position{line: 670, col: 7, offset: 23213},
+										pos: position{line: 670, col: 7, offset: 23310},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 670, col: 7, offset: 23213},
+												pos:  position{line: 670, col: 7, offset: 23310},
 												name: "WS",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 670, col: 12, offset: 23218},
+												pos:  position{line: 670, col: 12, offset: 23315},
 												name: "Newline",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 670, col: 24, offset: 23230},
+									pos:  position{line: 670, col: 24, offset: 23327},
 									name: "EOL",
 								},
 							},
@@ -5076,17 +5093,17 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 674, col: 1, offset: 23270},
+			pos:  position{line: 674, col: 1, offset: 23367},
 			expr: &actionExpr{
-				pos: position{line: 674, col: 31, offset: 23300},
+				pos: position{line: 674, col: 31, offset: 23397},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 674, col: 31, offset: 23300},
+					pos:   position{line: 674, col: 31, offset: 23397},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 674, col: 40, offset: 23309},
+						pos: position{line: 674, col: 40, offset: 23406},
 						expr: &ruleRefExpr{
-							pos:  position{line: 674, col: 41, offset: 23310},
+							pos:  position{line: 674, col: 41, offset: 23407},
 							name: "ListParagraph",
 						},
 					},
@@ -5095,51 +5112,51 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionKind",
-			pos:  position{line: 681, col: 1, offset: 23501},
+			pos:  position{line: 681, col: 1, offset: 23598},
 			expr: &choiceExpr{
-				pos: position{line: 681, col: 19, offset: 23519},
+				pos: position{line: 681, col: 19, offset: 23616},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 681, col: 19, offset: 23519},
+						pos: position{line: 681, col: 19, offset: 23616},
 						run: (*parser).callonAdmonitionKind2,
 						expr: &litMatcher{
-							pos:        position{line: 681, col: 19, offset: 23519},
+							pos:        position{line: 681, col: 19, offset: 23616},
 							val:        "TIP",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 683, col: 9, offset: 23565},
+						pos: position{line: 683, col: 9, offset: 23662},
 						run: (*parser).callonAdmonitionKind4,
 						expr: &litMatcher{
-							pos:        position{line: 683, col: 9, offset: 23565},
+							pos:        position{line: 683, col: 9, offset: 23662},
 							val:        "NOTE",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 685, col: 9, offset: 23613},
+						pos: position{line: 685, col: 9, offset: 23710},
 						run: (*parser).callonAdmonitionKind6,
 						expr: &litMatcher{
-							pos:        position{line: 685, col: 9, offset: 23613},
+							pos:        position{line: 685, col: 9, offset: 23710},
 							val:        "IMPORTANT",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 687, col: 9, offset: 23671},
+						pos: position{line: 687, col: 9, offset: 23768},
 						run: (*parser).callonAdmonitionKind8,
 						expr: &litMatcher{
-							pos:        position{line: 687, col: 9, offset: 23671},
+							pos:        position{line: 687, col: 9, offset: 23768},
 							val:        "WARNING",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 689, col: 9, offset: 23725},
+						pos: position{line: 689, col: 9, offset: 23822},
 						run: (*parser).callonAdmonitionKind10,
 						expr: &litMatcher{
-							pos:        position{line: 689, col: 9, offset: 23725},
+							pos:        position{line: 689, col: 9, offset: 23822},
 							val:        "CAUTION",
 							ignoreCase: false,
 						},
@@ -5149,47 +5166,47 @@ var g = &grammar{
 		},
 		{
 			name: "Paragraph",
-			pos:  position{line: 698, col: 1, offset: 24032},
+			pos:  position{line: 698, col: 1, offset: 24129},
 			expr: &choiceExpr{
-				pos: position{line: 700, col: 5, offset: 24079},
+				pos: position{line: 700, col: 5, offset: 24176},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 700, col: 5, offset: 24079},
+						pos: position{line: 700, col: 5, offset: 24176},
 						run: (*parser).callonParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 700, col: 5, offset: 24079},
+							pos: position{line: 700, col: 5, offset: 24176},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 700, col: 5, offset: 24079},
+									pos:   position{line: 700, col: 5, offset: 24176},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 700, col: 16, offset: 24090},
+										pos: position{line: 700, col: 16, offset: 24187},
 										expr: &ruleRefExpr{
-											pos:  position{line: 700, col: 17, offset: 24091},
+											pos:  position{line: 700, col: 17, offset: 24188},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 700, col: 37, offset: 24111},
+									pos:   position{line: 700, col: 37, offset: 24208},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 700, col: 40, offset: 24114},
+										pos:  position{line: 700, col: 40, offset: 24211},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 700, col: 56, offset: 24130},
+									pos:        position{line: 700, col: 56, offset: 24227},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 700, col: 61, offset: 24135},
+									pos:   position{line: 700, col: 61, offset: 24232},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 700, col: 67, offset: 24141},
+										pos: position{line: 700, col: 67, offset: 24238},
 										expr: &ruleRefExpr{
-											pos:  position{line: 700, col: 68, offset: 24142},
+											pos:  position{line: 700, col: 68, offset: 24239},
 											name: "InlineElements",
 										},
 									},
@@ -5198,29 +5215,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 704, col: 5, offset: 24334},
+						pos: position{line: 704, col: 5, offset: 24431},
 						run: (*parser).callonParagraph13,
 						expr: &seqExpr{
-							pos: position{line: 704, col: 5, offset: 24334},
+							pos: position{line: 704, col: 5, offset: 24431},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 704, col: 5, offset: 24334},
+									pos:   position{line: 704, col: 5, offset: 24431},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 704, col: 16, offset: 24345},
+										pos: position{line: 704, col: 16, offset: 24442},
 										expr: &ruleRefExpr{
-											pos:  position{line: 704, col: 17, offset: 24346},
+											pos:  position{line: 704, col: 17, offset: 24443},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 704, col: 37, offset: 24366},
+									pos:   position{line: 704, col: 37, offset: 24463},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 704, col: 43, offset: 24372},
+										pos: position{line: 704, col: 43, offset: 24469},
 										expr: &ruleRefExpr{
-											pos:  position{line: 704, col: 44, offset: 24373},
+											pos:  position{line: 704, col: 44, offset: 24470},
 											name: "InlineElements",
 										},
 									},
@@ -5233,43 +5250,43 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleParagraph",
-			pos:  position{line: 709, col: 1, offset: 24538},
+			pos:  position{line: 709, col: 1, offset: 24635},
 			expr: &actionExpr{
-				pos: position{line: 709, col: 20, offset: 24557},
+				pos: position{line: 709, col: 20, offset: 24654},
 				run: (*parser).callonSimpleParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 709, col: 20, offset: 24557},
+					pos: position{line: 709, col: 20, offset: 24654},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 709, col: 20, offset: 24557},
+							pos:   position{line: 709, col: 20, offset: 24654},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 709, col: 31, offset: 24568},
+								pos: position{line: 709, col: 31, offset: 24665},
 								expr: &ruleRefExpr{
-									pos:  position{line: 709, col: 32, offset: 24569},
+									pos:  position{line: 709, col: 32, offset: 24666},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 710, col: 5, offset: 24594},
+							pos: position{line: 710, col: 5, offset: 24691},
 							run: (*parser).callonSimpleParagraph6,
 						},
 						&labeledExpr{
-							pos:   position{line: 718, col: 5, offset: 24885},
+							pos:   position{line: 718, col: 5, offset: 24982},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 718, col: 16, offset: 24896},
+								pos:  position{line: 718, col: 16, offset: 24993},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 719, col: 5, offset: 24919},
+							pos:   position{line: 719, col: 5, offset: 25016},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 719, col: 16, offset: 24930},
+								pos: position{line: 719, col: 16, offset: 25027},
 								expr: &ruleRefExpr{
-									pos:  position{line: 719, col: 17, offset: 24931},
+									pos:  position{line: 719, col: 17, offset: 25028},
 									name: "OtherParagraphLine",
 								},
 							},
@@ -5280,45 +5297,45 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedParagraph",
-			pos:  position{line: 724, col: 1, offset: 25139},
+			pos:  position{line: 724, col: 1, offset: 25236},
 			expr: &choiceExpr{
-				pos: position{line: 726, col: 5, offset: 25195},
+				pos: position{line: 726, col: 5, offset: 25292},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 726, col: 5, offset: 25195},
+						pos: position{line: 726, col: 5, offset: 25292},
 						run: (*parser).callonContinuedParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 726, col: 5, offset: 25195},
+							pos: position{line: 726, col: 5, offset: 25292},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 726, col: 5, offset: 25195},
+									pos:   position{line: 726, col: 5, offset: 25292},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 726, col: 16, offset: 25206},
+										pos: position{line: 726, col: 16, offset: 25303},
 										expr: &ruleRefExpr{
-											pos:  position{line: 726, col: 17, offset: 25207},
+											pos:  position{line: 726, col: 17, offset: 25304},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 726, col: 37, offset: 25227},
+									pos:   position{line: 726, col: 37, offset: 25324},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 726, col: 40, offset: 25230},
+										pos:  position{line: 726, col: 40, offset: 25327},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 726, col: 56, offset: 25246},
+									pos:        position{line: 726, col: 56, offset: 25343},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 726, col: 61, offset: 25251},
+									pos:   position{line: 726, col: 61, offset: 25348},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 726, col: 68, offset: 25258},
+										pos:  position{line: 726, col: 68, offset: 25355},
 										name: "ContinuedParagraphLines",
 									},
 								},
@@ -5326,27 +5343,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 730, col: 5, offset: 25458},
+						pos: position{line: 730, col: 5, offset: 25555},
 						run: (*parser).callonContinuedParagraph12,
 						expr: &seqExpr{
-							pos: position{line: 730, col: 5, offset: 25458},
+							pos: position{line: 730, col: 5, offset: 25555},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 730, col: 5, offset: 25458},
+									pos:   position{line: 730, col: 5, offset: 25555},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 730, col: 16, offset: 25469},
+										pos: position{line: 730, col: 16, offset: 25566},
 										expr: &ruleRefExpr{
-											pos:  position{line: 730, col: 17, offset: 25470},
+											pos:  position{line: 730, col: 17, offset: 25567},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 730, col: 37, offset: 25490},
+									pos:   position{line: 730, col: 37, offset: 25587},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 730, col: 44, offset: 25497},
+										pos:  position{line: 730, col: 44, offset: 25594},
 										name: "ContinuedParagraphLines",
 									},
 								},
@@ -5358,38 +5375,38 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedParagraphLines",
-			pos:  position{line: 734, col: 1, offset: 25598},
+			pos:  position{line: 734, col: 1, offset: 25695},
 			expr: &actionExpr{
-				pos: position{line: 734, col: 28, offset: 25625},
+				pos: position{line: 734, col: 28, offset: 25722},
 				run: (*parser).callonContinuedParagraphLines1,
 				expr: &seqExpr{
-					pos: position{line: 734, col: 28, offset: 25625},
+					pos: position{line: 734, col: 28, offset: 25722},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 734, col: 28, offset: 25625},
+							pos:   position{line: 734, col: 28, offset: 25722},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 734, col: 39, offset: 25636},
+								pos:  position{line: 734, col: 39, offset: 25733},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 734, col: 59, offset: 25656},
+							pos:   position{line: 734, col: 59, offset: 25753},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 734, col: 70, offset: 25667},
+								pos: position{line: 734, col: 70, offset: 25764},
 								expr: &seqExpr{
-									pos: position{line: 734, col: 71, offset: 25668},
+									pos: position{line: 734, col: 71, offset: 25765},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 734, col: 71, offset: 25668},
+											pos: position{line: 734, col: 71, offset: 25765},
 											expr: &ruleRefExpr{
-												pos:  position{line: 734, col: 72, offset: 25669},
+												pos:  position{line: 734, col: 72, offset: 25766},
 												name: "ListItemContinuation",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 734, col: 93, offset: 25690},
+											pos:  position{line: 734, col: 93, offset: 25787},
 											name: "OtherParagraphLine",
 										},
 									},
@@ -5402,43 +5419,43 @@ var g = &grammar{
 		},
 		{
 			name: "FirstParagraphLine",
-			pos:  position{line: 738, col: 1, offset: 25796},
+			pos:  position{line: 738, col: 1, offset: 25893},
 			expr: &actionExpr{
-				pos: position{line: 738, col: 23, offset: 25818},
+				pos: position{line: 738, col: 23, offset: 25915},
 				run: (*parser).callonFirstParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 738, col: 23, offset: 25818},
+					pos: position{line: 738, col: 23, offset: 25915},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 738, col: 23, offset: 25818},
+							pos: position{line: 738, col: 23, offset: 25915},
 							expr: &seqExpr{
-								pos: position{line: 738, col: 25, offset: 25820},
+								pos: position{line: 738, col: 25, offset: 25917},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 738, col: 25, offset: 25820},
+										pos:  position{line: 738, col: 25, offset: 25917},
 										name: "SimpleLabeledListItemTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 738, col: 51, offset: 25846},
+										pos:  position{line: 738, col: 51, offset: 25943},
 										name: "LabeledListItemSeparator",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 739, col: 5, offset: 25876},
+							pos:   position{line: 739, col: 5, offset: 25973},
 							label: "elements",
 							expr: &seqExpr{
-								pos: position{line: 739, col: 15, offset: 25886},
+								pos: position{line: 739, col: 15, offset: 25983},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 739, col: 15, offset: 25886},
+										pos:  position{line: 739, col: 15, offset: 25983},
 										name: "SimpleWord",
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 739, col: 26, offset: 25897},
+										pos: position{line: 739, col: 26, offset: 25994},
 										expr: &ruleRefExpr{
-											pos:  position{line: 739, col: 26, offset: 25897},
+											pos:  position{line: 739, col: 26, offset: 25994},
 											name: "InlineElement",
 										},
 									},
@@ -5446,18 +5463,18 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 739, col: 42, offset: 25913},
+							pos:   position{line: 739, col: 42, offset: 26010},
 							label: "linebreak",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 739, col: 52, offset: 25923},
+								pos: position{line: 739, col: 52, offset: 26020},
 								expr: &ruleRefExpr{
-									pos:  position{line: 739, col: 53, offset: 25924},
+									pos:  position{line: 739, col: 53, offset: 26021},
 									name: "LineBreak",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 739, col: 65, offset: 25936},
+							pos:  position{line: 739, col: 65, offset: 26033},
 							name: "EOL",
 						},
 					},
@@ -5466,15 +5483,15 @@ var g = &grammar{
 		},
 		{
 			name: "OtherParagraphLine",
-			pos:  position{line: 743, col: 1, offset: 26026},
+			pos:  position{line: 743, col: 1, offset: 26123},
 			expr: &actionExpr{
-				pos: position{line: 743, col: 23, offset: 26048},
+				pos: position{line: 743, col: 23, offset: 26145},
 				run: (*parser).callonOtherParagraphLine1,
 				expr: &labeledExpr{
-					pos:   position{line: 743, col: 23, offset: 26048},
+					pos:   position{line: 743, col: 23, offset: 26145},
 					label: "elements",
 					expr: &ruleRefExpr{
-						pos:  position{line: 743, col: 33, offset: 26058},
+						pos:  position{line: 743, col: 33, offset: 26155},
 						name: "InlineElements",
 					},
 				},
@@ -5482,51 +5499,51 @@ var g = &grammar{
 		},
 		{
 			name: "VerseParagraph",
-			pos:  position{line: 747, col: 1, offset: 26104},
+			pos:  position{line: 747, col: 1, offset: 26201},
 			expr: &choiceExpr{
-				pos: position{line: 749, col: 5, offset: 26156},
+				pos: position{line: 749, col: 5, offset: 26253},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 749, col: 5, offset: 26156},
+						pos: position{line: 749, col: 5, offset: 26253},
 						run: (*parser).callonVerseParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 749, col: 5, offset: 26156},
+							pos: position{line: 749, col: 5, offset: 26253},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 749, col: 5, offset: 26156},
+									pos:   position{line: 749, col: 5, offset: 26253},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 749, col: 16, offset: 26167},
+										pos: position{line: 749, col: 16, offset: 26264},
 										expr: &ruleRefExpr{
-											pos:  position{line: 749, col: 17, offset: 26168},
+											pos:  position{line: 749, col: 17, offset: 26265},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 750, col: 5, offset: 26192},
+									pos: position{line: 750, col: 5, offset: 26289},
 									run: (*parser).callonVerseParagraph7,
 								},
 								&labeledExpr{
-									pos:   position{line: 757, col: 5, offset: 26404},
+									pos:   position{line: 757, col: 5, offset: 26501},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 757, col: 8, offset: 26407},
+										pos:  position{line: 757, col: 8, offset: 26504},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 757, col: 24, offset: 26423},
+									pos:        position{line: 757, col: 24, offset: 26520},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 757, col: 29, offset: 26428},
+									pos:   position{line: 757, col: 29, offset: 26525},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 757, col: 35, offset: 26434},
+										pos: position{line: 757, col: 35, offset: 26531},
 										expr: &ruleRefExpr{
-											pos:  position{line: 757, col: 36, offset: 26435},
+											pos:  position{line: 757, col: 36, offset: 26532},
 											name: "InlineElements",
 										},
 									},
@@ -5535,33 +5552,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 761, col: 5, offset: 26627},
+						pos: position{line: 761, col: 5, offset: 26724},
 						run: (*parser).callonVerseParagraph14,
 						expr: &seqExpr{
-							pos: position{line: 761, col: 5, offset: 26627},
+							pos: position{line: 761, col: 5, offset: 26724},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 761, col: 5, offset: 26627},
+									pos:   position{line: 761, col: 5, offset: 26724},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 761, col: 16, offset: 26638},
+										pos: position{line: 761, col: 16, offset: 26735},
 										expr: &ruleRefExpr{
-											pos:  position{line: 761, col: 17, offset: 26639},
+											pos:  position{line: 761, col: 17, offset: 26736},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 762, col: 5, offset: 26663},
+									pos: position{line: 762, col: 5, offset: 26760},
 									run: (*parser).callonVerseParagraph19,
 								},
 								&labeledExpr{
-									pos:   position{line: 769, col: 5, offset: 26875},
+									pos:   position{line: 769, col: 5, offset: 26972},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 769, col: 11, offset: 26881},
+										pos: position{line: 769, col: 11, offset: 26978},
 										expr: &ruleRefExpr{
-											pos:  position{line: 769, col: 12, offset: 26882},
+											pos:  position{line: 769, col: 12, offset: 26979},
 											name: "InlineElements",
 										},
 									},
@@ -5574,82 +5591,82 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElements",
-			pos:  position{line: 773, col: 1, offset: 26983},
+			pos:  position{line: 773, col: 1, offset: 27080},
 			expr: &actionExpr{
-				pos: position{line: 773, col: 19, offset: 27001},
+				pos: position{line: 773, col: 19, offset: 27098},
 				run: (*parser).callonInlineElements1,
 				expr: &seqExpr{
-					pos: position{line: 773, col: 19, offset: 27001},
+					pos: position{line: 773, col: 19, offset: 27098},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 773, col: 19, offset: 27001},
+							pos: position{line: 773, col: 19, offset: 27098},
 							expr: &ruleRefExpr{
-								pos:  position{line: 773, col: 20, offset: 27002},
+								pos:  position{line: 773, col: 20, offset: 27099},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 773, col: 24, offset: 27006},
+							pos: position{line: 773, col: 24, offset: 27103},
 							expr: &ruleRefExpr{
-								pos:  position{line: 773, col: 25, offset: 27007},
+								pos:  position{line: 773, col: 25, offset: 27104},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 774, col: 5, offset: 27021},
+							pos:   position{line: 774, col: 5, offset: 27118},
 							label: "elements",
 							expr: &choiceExpr{
-								pos: position{line: 774, col: 15, offset: 27031},
+								pos: position{line: 774, col: 15, offset: 27128},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 774, col: 15, offset: 27031},
+										pos: position{line: 774, col: 15, offset: 27128},
 										run: (*parser).callonInlineElements9,
 										expr: &labeledExpr{
-											pos:   position{line: 774, col: 15, offset: 27031},
+											pos:   position{line: 774, col: 15, offset: 27128},
 											label: "comment",
 											expr: &ruleRefExpr{
-												pos:  position{line: 774, col: 24, offset: 27040},
+												pos:  position{line: 774, col: 24, offset: 27137},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 776, col: 9, offset: 27132},
+										pos: position{line: 776, col: 9, offset: 27229},
 										run: (*parser).callonInlineElements12,
 										expr: &seqExpr{
-											pos: position{line: 776, col: 9, offset: 27132},
+											pos: position{line: 776, col: 9, offset: 27229},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 776, col: 9, offset: 27132},
+													pos: position{line: 776, col: 9, offset: 27229},
 													expr: &ruleRefExpr{
-														pos:  position{line: 776, col: 10, offset: 27133},
+														pos:  position{line: 776, col: 10, offset: 27230},
 														name: "BlockDelimiter",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 776, col: 25, offset: 27148},
+													pos:   position{line: 776, col: 25, offset: 27245},
 													label: "elements",
 													expr: &oneOrMoreExpr{
-														pos: position{line: 776, col: 34, offset: 27157},
+														pos: position{line: 776, col: 34, offset: 27254},
 														expr: &ruleRefExpr{
-															pos:  position{line: 776, col: 35, offset: 27158},
+															pos:  position{line: 776, col: 35, offset: 27255},
 															name: "InlineElement",
 														},
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 776, col: 51, offset: 27174},
+													pos:   position{line: 776, col: 51, offset: 27271},
 													label: "linebreak",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 776, col: 61, offset: 27184},
+														pos: position{line: 776, col: 61, offset: 27281},
 														expr: &ruleRefExpr{
-															pos:  position{line: 776, col: 62, offset: 27185},
+															pos:  position{line: 776, col: 62, offset: 27282},
 															name: "LineBreak",
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 776, col: 74, offset: 27197},
+													pos:  position{line: 776, col: 74, offset: 27294},
 													name: "EOL",
 												},
 											},
@@ -5664,95 +5681,99 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElement",
-			pos:  position{line: 782, col: 1, offset: 27333},
+			pos:  position{line: 782, col: 1, offset: 27430},
 			expr: &actionExpr{
-				pos: position{line: 782, col: 18, offset: 27350},
+				pos: position{line: 782, col: 18, offset: 27447},
 				run: (*parser).callonInlineElement1,
 				expr: &seqExpr{
-					pos: position{line: 782, col: 18, offset: 27350},
+					pos: position{line: 782, col: 18, offset: 27447},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 782, col: 18, offset: 27350},
+							pos: position{line: 782, col: 18, offset: 27447},
 							expr: &ruleRefExpr{
-								pos:  position{line: 782, col: 19, offset: 27351},
+								pos:  position{line: 782, col: 19, offset: 27448},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 782, col: 23, offset: 27355},
+							pos: position{line: 782, col: 23, offset: 27452},
 							expr: &ruleRefExpr{
-								pos:  position{line: 782, col: 24, offset: 27356},
+								pos:  position{line: 782, col: 24, offset: 27453},
 								name: "LineBreak",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 782, col: 34, offset: 27463},
+							run: (*parser).callonInlineElement7,
+						},
 						&labeledExpr{
-							pos:   position{line: 783, col: 5, offset: 27371},
+							pos:   position{line: 783, col: 5, offset: 27500},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 783, col: 14, offset: 27380},
+								pos: position{line: 783, col: 14, offset: 27509},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 783, col: 14, offset: 27380},
+										pos:  position{line: 783, col: 14, offset: 27509},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 784, col: 11, offset: 27401},
+										pos:  position{line: 784, col: 11, offset: 27530},
 										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 785, col: 11, offset: 27423},
+										pos:  position{line: 785, col: 11, offset: 27552},
 										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 786, col: 11, offset: 27441},
+										pos:  position{line: 786, col: 11, offset: 27570},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 787, col: 11, offset: 27464},
+										pos:  position{line: 787, col: 11, offset: 27593},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 788, col: 11, offset: 27480},
+										pos:  position{line: 788, col: 11, offset: 27609},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 789, col: 11, offset: 27503},
+										pos:  position{line: 789, col: 11, offset: 27632},
 										name: "InlineFootnote",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 790, col: 11, offset: 27529},
+										pos:  position{line: 790, col: 11, offset: 27658},
 										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 791, col: 11, offset: 27555},
+										pos:  position{line: 791, col: 11, offset: 27684},
 										name: "InlineUserMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 792, col: 11, offset: 27582},
+										pos:  position{line: 792, col: 11, offset: 27711},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 793, col: 11, offset: 27623},
+										pos:  position{line: 793, col: 11, offset: 27752},
 										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 794, col: 11, offset: 27650},
+										pos:  position{line: 794, col: 11, offset: 27779},
 										name: "ConcealedIndexTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 795, col: 11, offset: 27679},
+										pos:  position{line: 795, col: 11, offset: 27808},
 										name: "IndexTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 796, col: 11, offset: 27699},
+										pos:  position{line: 796, col: 11, offset: 27828},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 797, col: 11, offset: 27721},
+										pos:  position{line: 797, col: 11, offset: 27850},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 798, col: 11, offset: 27740},
+										pos:  position{line: 798, col: 11, offset: 27869},
 										name: "AnyChar",
 									},
 								},
@@ -5764,51 +5785,51 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementsWithoutSubtitution",
-			pos:  position{line: 806, col: 1, offset: 28000},
+			pos:  position{line: 806, col: 1, offset: 28129},
 			expr: &actionExpr{
-				pos: position{line: 806, col: 37, offset: 28036},
+				pos: position{line: 806, col: 37, offset: 28165},
 				run: (*parser).callonInlineElementsWithoutSubtitution1,
 				expr: &seqExpr{
-					pos: position{line: 806, col: 37, offset: 28036},
+					pos: position{line: 806, col: 37, offset: 28165},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 806, col: 37, offset: 28036},
+							pos: position{line: 806, col: 37, offset: 28165},
 							expr: &ruleRefExpr{
-								pos:  position{line: 806, col: 38, offset: 28037},
+								pos:  position{line: 806, col: 38, offset: 28166},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 806, col: 48, offset: 28047},
+							pos: position{line: 806, col: 48, offset: 28176},
 							expr: &ruleRefExpr{
-								pos:  position{line: 806, col: 49, offset: 28048},
+								pos:  position{line: 806, col: 49, offset: 28177},
 								name: "BlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 806, col: 64, offset: 28063},
+							pos:   position{line: 806, col: 64, offset: 28192},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 806, col: 73, offset: 28072},
+								pos: position{line: 806, col: 73, offset: 28201},
 								expr: &ruleRefExpr{
-									pos:  position{line: 806, col: 74, offset: 28073},
+									pos:  position{line: 806, col: 74, offset: 28202},
 									name: "InlineElementWithoutSubtitution",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 806, col: 108, offset: 28107},
+							pos:   position{line: 806, col: 108, offset: 28236},
 							label: "linebreak",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 806, col: 118, offset: 28117},
+								pos: position{line: 806, col: 118, offset: 28246},
 								expr: &ruleRefExpr{
-									pos:  position{line: 806, col: 119, offset: 28118},
+									pos:  position{line: 806, col: 119, offset: 28247},
 									name: "LineBreak",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 806, col: 131, offset: 28130},
+							pos:  position{line: 806, col: 131, offset: 28259},
 							name: "EOL",
 						},
 					},
@@ -5817,75 +5838,75 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementWithoutSubtitution",
-			pos:  position{line: 810, col: 1, offset: 28221},
+			pos:  position{line: 810, col: 1, offset: 28350},
 			expr: &actionExpr{
-				pos: position{line: 810, col: 36, offset: 28256},
+				pos: position{line: 810, col: 36, offset: 28385},
 				run: (*parser).callonInlineElementWithoutSubtitution1,
 				expr: &seqExpr{
-					pos: position{line: 810, col: 36, offset: 28256},
+					pos: position{line: 810, col: 36, offset: 28385},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 810, col: 36, offset: 28256},
+							pos: position{line: 810, col: 36, offset: 28385},
 							expr: &ruleRefExpr{
-								pos:  position{line: 810, col: 37, offset: 28257},
+								pos:  position{line: 810, col: 37, offset: 28386},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 810, col: 41, offset: 28261},
+							pos: position{line: 810, col: 41, offset: 28390},
 							expr: &ruleRefExpr{
-								pos:  position{line: 810, col: 42, offset: 28262},
+								pos:  position{line: 810, col: 42, offset: 28391},
 								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 811, col: 5, offset: 28277},
+							pos:   position{line: 811, col: 5, offset: 28406},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 811, col: 14, offset: 28286},
+								pos: position{line: 811, col: 14, offset: 28415},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 811, col: 14, offset: 28286},
+										pos:  position{line: 811, col: 14, offset: 28415},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 812, col: 11, offset: 28307},
+										pos:  position{line: 812, col: 11, offset: 28436},
 										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 813, col: 11, offset: 28329},
+										pos:  position{line: 813, col: 11, offset: 28458},
 										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 814, col: 11, offset: 28347},
+										pos:  position{line: 814, col: 11, offset: 28476},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 815, col: 11, offset: 28370},
+										pos:  position{line: 815, col: 11, offset: 28499},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 816, col: 11, offset: 28386},
+										pos:  position{line: 816, col: 11, offset: 28515},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 817, col: 11, offset: 28409},
+										pos:  position{line: 817, col: 11, offset: 28538},
 										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 818, col: 11, offset: 28435},
+										pos:  position{line: 818, col: 11, offset: 28564},
 										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 819, col: 11, offset: 28461},
+										pos:  position{line: 819, col: 11, offset: 28590},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 820, col: 11, offset: 28483},
+										pos:  position{line: 820, col: 11, offset: 28612},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 821, col: 11, offset: 28502},
+										pos:  position{line: 821, col: 11, offset: 28631},
 										name: "AnyChar",
 									},
 								},
@@ -5897,47 +5918,47 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimParagraph",
-			pos:  position{line: 825, col: 1, offset: 28541},
+			pos:  position{line: 825, col: 1, offset: 28670},
 			expr: &actionExpr{
-				pos: position{line: 825, col: 22, offset: 28562},
+				pos: position{line: 825, col: 22, offset: 28691},
 				run: (*parser).callonVerbatimParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 825, col: 22, offset: 28562},
+					pos: position{line: 825, col: 22, offset: 28691},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 825, col: 22, offset: 28562},
+							pos:   position{line: 825, col: 22, offset: 28691},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 825, col: 33, offset: 28573},
+								pos: position{line: 825, col: 33, offset: 28702},
 								expr: &ruleRefExpr{
-									pos:  position{line: 825, col: 34, offset: 28574},
+									pos:  position{line: 825, col: 34, offset: 28703},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 825, col: 54, offset: 28594},
+							pos:   position{line: 825, col: 54, offset: 28723},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 825, col: 60, offset: 28600},
+								pos: position{line: 825, col: 60, offset: 28729},
 								expr: &actionExpr{
-									pos: position{line: 825, col: 61, offset: 28601},
+									pos: position{line: 825, col: 61, offset: 28730},
 									run: (*parser).callonVerbatimParagraph8,
 									expr: &seqExpr{
-										pos: position{line: 825, col: 61, offset: 28601},
+										pos: position{line: 825, col: 61, offset: 28730},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 825, col: 61, offset: 28601},
+												pos: position{line: 825, col: 61, offset: 28730},
 												expr: &ruleRefExpr{
-													pos:  position{line: 825, col: 62, offset: 28602},
+													pos:  position{line: 825, col: 62, offset: 28731},
 													name: "EOF",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 825, col: 66, offset: 28606},
+												pos:   position{line: 825, col: 66, offset: 28735},
 												label: "line",
 												expr: &ruleRefExpr{
-													pos:  position{line: 825, col: 72, offset: 28612},
+													pos:  position{line: 825, col: 72, offset: 28741},
 													name: "VerbatimParagraphLine",
 												},
 											},
@@ -5952,51 +5973,51 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimParagraphLine",
-			pos:  position{line: 831, col: 1, offset: 28732},
+			pos:  position{line: 831, col: 1, offset: 28861},
 			expr: &actionExpr{
-				pos: position{line: 831, col: 26, offset: 28757},
+				pos: position{line: 831, col: 26, offset: 28886},
 				run: (*parser).callonVerbatimParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 831, col: 26, offset: 28757},
+					pos: position{line: 831, col: 26, offset: 28886},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 831, col: 26, offset: 28757},
+							pos: position{line: 831, col: 26, offset: 28886},
 							expr: &ruleRefExpr{
-								pos:  position{line: 831, col: 27, offset: 28758},
+								pos:  position{line: 831, col: 27, offset: 28887},
 								name: "BlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 831, col: 42, offset: 28773},
+							pos: position{line: 831, col: 42, offset: 28902},
 							expr: &ruleRefExpr{
-								pos:  position{line: 831, col: 43, offset: 28774},
+								pos:  position{line: 831, col: 43, offset: 28903},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 831, col: 53, offset: 28784},
+							pos:   position{line: 831, col: 53, offset: 28913},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 831, col: 62, offset: 28793},
+								pos: position{line: 831, col: 62, offset: 28922},
 								expr: &ruleRefExpr{
-									pos:  position{line: 831, col: 63, offset: 28794},
+									pos:  position{line: 831, col: 63, offset: 28923},
 									name: "VerbatimParagraphLineElement",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 831, col: 94, offset: 28825},
+							pos:   position{line: 831, col: 94, offset: 28954},
 							label: "linebreak",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 831, col: 104, offset: 28835},
+								pos: position{line: 831, col: 104, offset: 28964},
 								expr: &ruleRefExpr{
-									pos:  position{line: 831, col: 105, offset: 28836},
+									pos:  position{line: 831, col: 105, offset: 28965},
 									name: "LineBreak",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 831, col: 117, offset: 28848},
+							pos:  position{line: 831, col: 117, offset: 28977},
 							name: "EOL",
 						},
 					},
@@ -6005,31 +6026,31 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimParagraphLineElement",
-			pos:  position{line: 835, col: 1, offset: 28939},
+			pos:  position{line: 835, col: 1, offset: 29068},
 			expr: &actionExpr{
-				pos: position{line: 835, col: 33, offset: 28971},
+				pos: position{line: 835, col: 33, offset: 29100},
 				run: (*parser).callonVerbatimParagraphLineElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 835, col: 33, offset: 28971},
+					pos: position{line: 835, col: 33, offset: 29100},
 					expr: &seqExpr{
-						pos: position{line: 835, col: 34, offset: 28972},
+						pos: position{line: 835, col: 34, offset: 29101},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 835, col: 34, offset: 28972},
+								pos: position{line: 835, col: 34, offset: 29101},
 								expr: &ruleRefExpr{
-									pos:  position{line: 835, col: 35, offset: 28973},
+									pos:  position{line: 835, col: 35, offset: 29102},
 									name: "EOL",
 								},
 							},
 							&notExpr{
-								pos: position{line: 835, col: 39, offset: 28977},
+								pos: position{line: 835, col: 39, offset: 29106},
 								expr: &ruleRefExpr{
-									pos:  position{line: 835, col: 40, offset: 28978},
+									pos:  position{line: 835, col: 40, offset: 29107},
 									name: "LineBreak",
 								},
 							},
 							&anyMatcher{
-								line: 835, col: 50, offset: 28988,
+								line: 835, col: 50, offset: 29117,
 							},
 						},
 					},
@@ -6038,33 +6059,33 @@ var g = &grammar{
 		},
 		{
 			name: "LineBreak",
-			pos:  position{line: 842, col: 1, offset: 29212},
+			pos:  position{line: 842, col: 1, offset: 29341},
 			expr: &actionExpr{
-				pos: position{line: 842, col: 14, offset: 29225},
+				pos: position{line: 842, col: 14, offset: 29354},
 				run: (*parser).callonLineBreak1,
 				expr: &seqExpr{
-					pos: position{line: 842, col: 14, offset: 29225},
+					pos: position{line: 842, col: 14, offset: 29354},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 842, col: 14, offset: 29225},
+							pos:  position{line: 842, col: 14, offset: 29354},
 							name: "WS",
 						},
 						&litMatcher{
-							pos:        position{line: 842, col: 17, offset: 29228},
+							pos:        position{line: 842, col: 17, offset: 29357},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 842, col: 21, offset: 29232},
+							pos: position{line: 842, col: 21, offset: 29361},
 							expr: &ruleRefExpr{
-								pos:  position{line: 842, col: 21, offset: 29232},
+								pos:  position{line: 842, col: 21, offset: 29361},
 								name: "WS",
 							},
 						},
 						&andExpr{
-							pos: position{line: 842, col: 25, offset: 29236},
+							pos: position{line: 842, col: 25, offset: 29365},
 							expr: &ruleRefExpr{
-								pos:  position{line: 842, col: 26, offset: 29237},
+								pos:  position{line: 842, col: 26, offset: 29366},
 								name: "EOL",
 							},
 						},
@@ -6074,20 +6095,20 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedText",
-			pos:  position{line: 849, col: 1, offset: 29521},
+			pos:  position{line: 849, col: 1, offset: 29650},
 			expr: &choiceExpr{
-				pos: position{line: 849, col: 15, offset: 29535},
+				pos: position{line: 849, col: 15, offset: 29664},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 849, col: 15, offset: 29535},
+						pos:  position{line: 849, col: 15, offset: 29664},
 						name: "UnconstrainedQuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 849, col: 41, offset: 29561},
+						pos:  position{line: 849, col: 41, offset: 29690},
 						name: "ConstrainedQuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 849, col: 65, offset: 29585},
+						pos:  position{line: 849, col: 65, offset: 29714},
 						name: "EscapedQuotedText",
 					},
 				},
@@ -6095,22 +6116,22 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedTextMarker",
-			pos:  position{line: 851, col: 1, offset: 29604},
+			pos:  position{line: 851, col: 1, offset: 29733},
 			expr: &choiceExpr{
-				pos: position{line: 851, col: 32, offset: 29635},
+				pos: position{line: 851, col: 32, offset: 29764},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 851, col: 32, offset: 29635},
+						pos: position{line: 851, col: 32, offset: 29764},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 851, col: 32, offset: 29635},
+								pos:        position{line: 851, col: 32, offset: 29764},
 								val:        "*",
 								ignoreCase: false,
 							},
 							&notExpr{
-								pos: position{line: 851, col: 36, offset: 29639},
+								pos: position{line: 851, col: 36, offset: 29768},
 								expr: &litMatcher{
-									pos:        position{line: 851, col: 37, offset: 29640},
+									pos:        position{line: 851, col: 37, offset: 29769},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6118,17 +6139,17 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 851, col: 43, offset: 29646},
+						pos: position{line: 851, col: 43, offset: 29775},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 851, col: 43, offset: 29646},
+								pos:        position{line: 851, col: 43, offset: 29775},
 								val:        "_",
 								ignoreCase: false,
 							},
 							&notExpr{
-								pos: position{line: 851, col: 47, offset: 29650},
+								pos: position{line: 851, col: 47, offset: 29779},
 								expr: &litMatcher{
-									pos:        position{line: 851, col: 48, offset: 29651},
+									pos:        position{line: 851, col: 48, offset: 29780},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -6136,17 +6157,17 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 851, col: 54, offset: 29657},
+						pos: position{line: 851, col: 54, offset: 29786},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 851, col: 54, offset: 29657},
+								pos:        position{line: 851, col: 54, offset: 29786},
 								val:        "`",
 								ignoreCase: false,
 							},
 							&notExpr{
-								pos: position{line: 851, col: 58, offset: 29661},
+								pos: position{line: 851, col: 58, offset: 29790},
 								expr: &litMatcher{
-									pos:        position{line: 851, col: 59, offset: 29662},
+									pos:        position{line: 851, col: 59, offset: 29791},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -6158,32 +6179,32 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedTextPrefix",
-			pos:  position{line: 853, col: 1, offset: 29668},
+			pos:  position{line: 853, col: 1, offset: 29797},
 			expr: &choiceExpr{
-				pos: position{line: 853, col: 34, offset: 29701},
+				pos: position{line: 853, col: 34, offset: 29830},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 853, col: 34, offset: 29701},
+						pos:        position{line: 853, col: 34, offset: 29830},
 						val:        "**",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 853, col: 41, offset: 29708},
+						pos:        position{line: 853, col: 41, offset: 29837},
 						val:        "__",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 853, col: 48, offset: 29715},
+						pos:        position{line: 853, col: 48, offset: 29844},
 						val:        "``",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 853, col: 55, offset: 29722},
+						pos:        position{line: 853, col: 55, offset: 29851},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 853, col: 61, offset: 29728},
+						pos:        position{line: 853, col: 61, offset: 29857},
 						val:        "~",
 						ignoreCase: false,
 					},
@@ -6192,48 +6213,48 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedText",
-			pos:  position{line: 855, col: 1, offset: 29733},
+			pos:  position{line: 855, col: 1, offset: 29862},
 			expr: &actionExpr{
-				pos: position{line: 855, col: 26, offset: 29758},
+				pos: position{line: 855, col: 26, offset: 29887},
 				run: (*parser).callonConstrainedQuotedText1,
 				expr: &seqExpr{
-					pos: position{line: 855, col: 26, offset: 29758},
+					pos: position{line: 855, col: 26, offset: 29887},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 855, col: 26, offset: 29758},
+							pos: position{line: 855, col: 26, offset: 29887},
 							expr: &ruleRefExpr{
-								pos:  position{line: 855, col: 27, offset: 29759},
+								pos:  position{line: 855, col: 27, offset: 29888},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 855, col: 30, offset: 29762},
+							pos:   position{line: 855, col: 30, offset: 29891},
 							label: "text",
 							expr: &choiceExpr{
-								pos: position{line: 855, col: 36, offset: 29768},
+								pos: position{line: 855, col: 36, offset: 29897},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 855, col: 36, offset: 29768},
+										pos:  position{line: 855, col: 36, offset: 29897},
 										name: "SingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 856, col: 15, offset: 29803},
+										pos:  position{line: 856, col: 15, offset: 29932},
 										name: "SingleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 857, col: 15, offset: 29840},
+										pos:  position{line: 857, col: 15, offset: 29969},
 										name: "SingleQuoteMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 858, col: 15, offset: 29880},
+										pos:  position{line: 858, col: 15, offset: 30009},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 859, col: 15, offset: 29909},
+										pos:  position{line: 859, col: 15, offset: 30038},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 860, col: 15, offset: 29940},
+										pos:  position{line: 860, col: 15, offset: 30069},
 										name: "SubscriptOrSuperscriptPrefix",
 									},
 								},
@@ -6245,20 +6266,20 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedText",
-			pos:  position{line: 864, col: 1, offset: 30094},
+			pos:  position{line: 864, col: 1, offset: 30223},
 			expr: &choiceExpr{
-				pos: position{line: 864, col: 28, offset: 30121},
+				pos: position{line: 864, col: 28, offset: 30250},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 864, col: 28, offset: 30121},
+						pos:  position{line: 864, col: 28, offset: 30250},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 865, col: 15, offset: 30155},
+						pos:  position{line: 865, col: 15, offset: 30284},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 866, col: 15, offset: 30191},
+						pos:  position{line: 866, col: 15, offset: 30320},
 						name: "DoubleQuoteMonospaceText",
 					},
 				},
@@ -6266,28 +6287,28 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedQuotedText",
-			pos:  position{line: 868, col: 1, offset: 30217},
+			pos:  position{line: 868, col: 1, offset: 30346},
 			expr: &choiceExpr{
-				pos: position{line: 868, col: 22, offset: 30238},
+				pos: position{line: 868, col: 22, offset: 30367},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 868, col: 22, offset: 30238},
+						pos:  position{line: 868, col: 22, offset: 30367},
 						name: "EscapedBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 869, col: 15, offset: 30269},
+						pos:  position{line: 869, col: 15, offset: 30398},
 						name: "EscapedItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 870, col: 15, offset: 30302},
+						pos:  position{line: 870, col: 15, offset: 30431},
 						name: "EscapedMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 871, col: 15, offset: 30338},
+						pos:  position{line: 871, col: 15, offset: 30467},
 						name: "EscapedSubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 872, col: 15, offset: 30374},
+						pos:  position{line: 872, col: 15, offset: 30503},
 						name: "EscapedSuperscriptText",
 					},
 				},
@@ -6295,20 +6316,20 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptOrSuperscriptPrefix",
-			pos:  position{line: 874, col: 1, offset: 30398},
+			pos:  position{line: 874, col: 1, offset: 30527},
 			expr: &choiceExpr{
-				pos: position{line: 874, col: 33, offset: 30430},
+				pos: position{line: 874, col: 33, offset: 30559},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 874, col: 33, offset: 30430},
+						pos:        position{line: 874, col: 33, offset: 30559},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 874, col: 39, offset: 30436},
+						pos: position{line: 874, col: 39, offset: 30565},
 						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
 						expr: &litMatcher{
-							pos:        position{line: 874, col: 39, offset: 30436},
+							pos:        position{line: 874, col: 39, offset: 30565},
 							val:        "~",
 							ignoreCase: false,
 						},
@@ -6318,14 +6339,14 @@ var g = &grammar{
 		},
 		{
 			name: "OneOrMoreBackslashes",
-			pos:  position{line: 878, col: 1, offset: 30569},
+			pos:  position{line: 878, col: 1, offset: 30698},
 			expr: &actionExpr{
-				pos: position{line: 878, col: 25, offset: 30593},
+				pos: position{line: 878, col: 25, offset: 30722},
 				run: (*parser).callonOneOrMoreBackslashes1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 878, col: 25, offset: 30593},
+					pos: position{line: 878, col: 25, offset: 30722},
 					expr: &litMatcher{
-						pos:        position{line: 878, col: 25, offset: 30593},
+						pos:        position{line: 878, col: 25, offset: 30722},
 						val:        "\\",
 						ignoreCase: false,
 					},
@@ -6334,22 +6355,22 @@ var g = &grammar{
 		},
 		{
 			name: "TwoOrMoreBackslashes",
-			pos:  position{line: 882, col: 1, offset: 30634},
+			pos:  position{line: 882, col: 1, offset: 30763},
 			expr: &actionExpr{
-				pos: position{line: 882, col: 25, offset: 30658},
+				pos: position{line: 882, col: 25, offset: 30787},
 				run: (*parser).callonTwoOrMoreBackslashes1,
 				expr: &seqExpr{
-					pos: position{line: 882, col: 25, offset: 30658},
+					pos: position{line: 882, col: 25, offset: 30787},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 882, col: 25, offset: 30658},
+							pos:        position{line: 882, col: 25, offset: 30787},
 							val:        "\\\\",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 882, col: 30, offset: 30663},
+							pos: position{line: 882, col: 30, offset: 30792},
 							expr: &litMatcher{
-								pos:        position{line: 882, col: 30, offset: 30663},
+								pos:        position{line: 882, col: 30, offset: 30792},
 								val:        "\\",
 								ignoreCase: false,
 							},
@@ -6360,16 +6381,16 @@ var g = &grammar{
 		},
 		{
 			name: "BoldText",
-			pos:  position{line: 890, col: 1, offset: 30760},
+			pos:  position{line: 890, col: 1, offset: 30889},
 			expr: &choiceExpr{
-				pos: position{line: 890, col: 13, offset: 30772},
+				pos: position{line: 890, col: 13, offset: 30901},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 890, col: 13, offset: 30772},
+						pos:  position{line: 890, col: 13, offset: 30901},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 890, col: 35, offset: 30794},
+						pos:  position{line: 890, col: 35, offset: 30923},
 						name: "SingleQuoteBoldText",
 					},
 				},
@@ -6377,36 +6398,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldText",
-			pos:  position{line: 892, col: 1, offset: 30861},
+			pos:  position{line: 892, col: 1, offset: 30990},
 			expr: &actionExpr{
-				pos: position{line: 892, col: 24, offset: 30884},
+				pos: position{line: 892, col: 24, offset: 31013},
 				run: (*parser).callonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 892, col: 24, offset: 30884},
+					pos: position{line: 892, col: 24, offset: 31013},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 892, col: 24, offset: 30884},
+							pos: position{line: 892, col: 24, offset: 31013},
 							expr: &litMatcher{
-								pos:        position{line: 892, col: 25, offset: 30885},
+								pos:        position{line: 892, col: 25, offset: 31014},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 892, col: 30, offset: 30890},
+							pos:        position{line: 892, col: 30, offset: 31019},
 							val:        "**",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 892, col: 35, offset: 30895},
+							pos:   position{line: 892, col: 35, offset: 31024},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 892, col: 45, offset: 30905},
+								pos:  position{line: 892, col: 45, offset: 31034},
 								name: "DoubleQuoteBoldTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 892, col: 74, offset: 30934},
+							pos:        position{line: 892, col: 74, offset: 31063},
 							val:        "**",
 							ignoreCase: false,
 						},
@@ -6416,36 +6437,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElements",
-			pos:  position{line: 896, col: 1, offset: 31015},
+			pos:  position{line: 896, col: 1, offset: 31144},
 			expr: &seqExpr{
-				pos: position{line: 896, col: 32, offset: 31046},
+				pos: position{line: 896, col: 32, offset: 31175},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 896, col: 32, offset: 31046},
+						pos:  position{line: 896, col: 32, offset: 31175},
 						name: "DoubleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 896, col: 59, offset: 31073},
+						pos: position{line: 896, col: 59, offset: 31202},
 						expr: &seqExpr{
-							pos: position{line: 896, col: 60, offset: 31074},
+							pos: position{line: 896, col: 60, offset: 31203},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 896, col: 60, offset: 31074},
+									pos: position{line: 896, col: 60, offset: 31203},
 									expr: &litMatcher{
-										pos:        position{line: 896, col: 62, offset: 31076},
+										pos:        position{line: 896, col: 62, offset: 31205},
 										val:        "**",
 										ignoreCase: false,
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 896, col: 69, offset: 31083},
+									pos: position{line: 896, col: 69, offset: 31212},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 896, col: 69, offset: 31083},
+											pos:  position{line: 896, col: 69, offset: 31212},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 896, col: 74, offset: 31088},
+											pos:  position{line: 896, col: 74, offset: 31217},
 											name: "DoubleQuoteBoldTextElement",
 										},
 									},
@@ -6458,80 +6479,84 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElement",
-			pos:  position{line: 898, col: 1, offset: 31153},
+			pos:  position{line: 898, col: 1, offset: 31282},
 			expr: &actionExpr{
-				pos: position{line: 898, col: 31, offset: 31183},
+				pos: position{line: 898, col: 31, offset: 31312},
 				run: (*parser).callonDoubleQuoteBoldTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 898, col: 31, offset: 31183},
+					pos: position{line: 898, col: 31, offset: 31312},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 898, col: 31, offset: 31183},
+							pos: position{line: 898, col: 31, offset: 31312},
 							expr: &ruleRefExpr{
-								pos:  position{line: 898, col: 32, offset: 31184},
+								pos:  position{line: 898, col: 32, offset: 31313},
 								name: "Newline",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 898, col: 40, offset: 31321},
+							run: (*parser).callonDoubleQuoteBoldTextElement5,
+						},
 						&labeledExpr{
-							pos:   position{line: 898, col: 40, offset: 31192},
+							pos:   position{line: 898, col: 73, offset: 31354},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 898, col: 49, offset: 31201},
+								pos: position{line: 898, col: 82, offset: 31363},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 898, col: 49, offset: 31201},
+										pos:  position{line: 898, col: 82, offset: 31363},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 899, col: 11, offset: 31223},
+										pos:  position{line: 899, col: 11, offset: 31385},
 										name: "SingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 900, col: 11, offset: 31254},
+										pos:  position{line: 900, col: 11, offset: 31416},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 901, col: 11, offset: 31276},
+										pos:  position{line: 901, col: 11, offset: 31438},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 902, col: 11, offset: 31300},
+										pos:  position{line: 902, col: 11, offset: 31462},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 903, col: 11, offset: 31324},
+										pos:  position{line: 903, col: 11, offset: 31486},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 904, col: 11, offset: 31350},
+										pos:  position{line: 904, col: 11, offset: 31512},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 905, col: 11, offset: 31373},
+										pos:  position{line: 905, col: 11, offset: 31535},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 906, col: 11, offset: 31395},
+										pos:  position{line: 906, col: 11, offset: 31557},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 907, col: 11, offset: 31418},
+										pos:  position{line: 907, col: 11, offset: 31580},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 908, col: 11, offset: 31458},
+										pos:  position{line: 908, col: 11, offset: 31620},
 										name: "NonDoubleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 909, col: 11, offset: 31491},
+										pos:  position{line: 909, col: 11, offset: 31653},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 910, col: 11, offset: 31513},
+										pos:  position{line: 910, col: 11, offset: 31675},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 911, col: 11, offset: 31532},
+										pos:  position{line: 911, col: 11, offset: 31694},
 										name: "AnyChar",
 									},
 								},
@@ -6543,68 +6568,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonDoubleQuoteBoldText",
-			pos:  position{line: 915, col: 1, offset: 31674},
+			pos:  position{line: 915, col: 1, offset: 31836},
 			expr: &actionExpr{
-				pos: position{line: 915, col: 27, offset: 31700},
+				pos: position{line: 915, col: 27, offset: 31862},
 				run: (*parser).callonNonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 915, col: 27, offset: 31700},
+					pos: position{line: 915, col: 27, offset: 31862},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 915, col: 28, offset: 31701,
+							line: 915, col: 28, offset: 31863,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 915, col: 31, offset: 31704},
+							pos: position{line: 915, col: 31, offset: 31866},
 							expr: &seqExpr{
-								pos: position{line: 915, col: 32, offset: 31705},
+								pos: position{line: 915, col: 32, offset: 31867},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 915, col: 32, offset: 31705},
+										pos: position{line: 915, col: 32, offset: 31867},
 										expr: &litMatcher{
-											pos:        position{line: 915, col: 33, offset: 31706},
+											pos:        position{line: 915, col: 33, offset: 31868},
 											val:        "**",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 915, col: 38, offset: 31711},
+										pos: position{line: 915, col: 38, offset: 31873},
 										expr: &ruleRefExpr{
-											pos:  position{line: 915, col: 39, offset: 31712},
+											pos:  position{line: 915, col: 39, offset: 31874},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 915, col: 42, offset: 31715},
+										pos: position{line: 915, col: 42, offset: 31877},
 										expr: &litMatcher{
-											pos:        position{line: 915, col: 43, offset: 31716},
+											pos:        position{line: 915, col: 43, offset: 31878},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 915, col: 47, offset: 31720},
+										pos: position{line: 915, col: 47, offset: 31882},
 										expr: &litMatcher{
-											pos:        position{line: 915, col: 48, offset: 31721},
+											pos:        position{line: 915, col: 48, offset: 31883},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 915, col: 52, offset: 31725},
+										pos: position{line: 915, col: 52, offset: 31887},
 										expr: &ruleRefExpr{
-											pos:  position{line: 915, col: 53, offset: 31726},
+											pos:  position{line: 915, col: 53, offset: 31888},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 915, col: 61, offset: 31734},
+										pos: position{line: 915, col: 61, offset: 31896},
 										expr: &ruleRefExpr{
-											pos:  position{line: 915, col: 62, offset: 31735},
+											pos:  position{line: 915, col: 62, offset: 31897},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 915, col: 74, offset: 31747,
+										line: 915, col: 74, offset: 31909,
 									},
 								},
 							},
@@ -6615,47 +6640,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldText",
-			pos:  position{line: 919, col: 1, offset: 31807},
+			pos:  position{line: 919, col: 1, offset: 31969},
 			expr: &choiceExpr{
-				pos: position{line: 919, col: 24, offset: 31830},
+				pos: position{line: 919, col: 24, offset: 31992},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 919, col: 24, offset: 31830},
+						pos: position{line: 919, col: 24, offset: 31992},
 						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 919, col: 24, offset: 31830},
+							pos: position{line: 919, col: 24, offset: 31992},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 919, col: 24, offset: 31830},
+									pos: position{line: 919, col: 24, offset: 31992},
 									expr: &litMatcher{
-										pos:        position{line: 919, col: 25, offset: 31831},
+										pos:        position{line: 919, col: 25, offset: 31993},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 919, col: 29, offset: 31835},
+									pos:        position{line: 919, col: 29, offset: 31997},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 919, col: 33, offset: 31839},
+									pos: position{line: 919, col: 33, offset: 32001},
 									expr: &litMatcher{
-										pos:        position{line: 919, col: 34, offset: 31840},
+										pos:        position{line: 919, col: 34, offset: 32002},
 										val:        "*",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 919, col: 38, offset: 31844},
+									pos:   position{line: 919, col: 38, offset: 32006},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 919, col: 48, offset: 31854},
+										pos:  position{line: 919, col: 48, offset: 32016},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 919, col: 77, offset: 31883},
+									pos:        position{line: 919, col: 77, offset: 32045},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6663,44 +6688,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 921, col: 5, offset: 32064},
+						pos: position{line: 921, col: 5, offset: 32226},
 						run: (*parser).callonSingleQuoteBoldText12,
 						expr: &seqExpr{
-							pos: position{line: 921, col: 5, offset: 32064},
+							pos: position{line: 921, col: 5, offset: 32226},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 921, col: 5, offset: 32064},
+									pos: position{line: 921, col: 5, offset: 32226},
 									expr: &litMatcher{
-										pos:        position{line: 921, col: 6, offset: 32065},
+										pos:        position{line: 921, col: 6, offset: 32227},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 921, col: 11, offset: 32070},
+									pos:        position{line: 921, col: 11, offset: 32232},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 921, col: 15, offset: 32074},
+									pos:   position{line: 921, col: 15, offset: 32236},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 921, col: 25, offset: 32084},
+										pos: position{line: 921, col: 25, offset: 32246},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 921, col: 25, offset: 32084},
+												pos:        position{line: 921, col: 25, offset: 32246},
 												val:        "*",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 921, col: 29, offset: 32088},
+												pos:  position{line: 921, col: 29, offset: 32250},
 												name: "SingleQuoteBoldTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 921, col: 58, offset: 32117},
+									pos:        position{line: 921, col: 58, offset: 32279},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6712,40 +6737,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElements",
-			pos:  position{line: 925, col: 1, offset: 32316},
+			pos:  position{line: 925, col: 1, offset: 32478},
 			expr: &seqExpr{
-				pos: position{line: 925, col: 32, offset: 32347},
+				pos: position{line: 925, col: 32, offset: 32509},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 925, col: 32, offset: 32347},
+						pos: position{line: 925, col: 32, offset: 32509},
 						expr: &ruleRefExpr{
-							pos:  position{line: 925, col: 33, offset: 32348},
+							pos:  position{line: 925, col: 33, offset: 32510},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 925, col: 36, offset: 32351},
+						pos:  position{line: 925, col: 36, offset: 32513},
 						name: "SingleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 925, col: 63, offset: 32378},
+						pos: position{line: 925, col: 63, offset: 32540},
 						expr: &seqExpr{
-							pos: position{line: 925, col: 64, offset: 32379},
+							pos: position{line: 925, col: 64, offset: 32541},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 925, col: 64, offset: 32379},
+									pos: position{line: 925, col: 64, offset: 32541},
 									expr: &seqExpr{
-										pos: position{line: 925, col: 66, offset: 32381},
+										pos: position{line: 925, col: 66, offset: 32543},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 925, col: 66, offset: 32381},
+												pos:        position{line: 925, col: 66, offset: 32543},
 												val:        "*",
 												ignoreCase: false,
 											},
 											&notExpr{
-												pos: position{line: 925, col: 70, offset: 32385},
+												pos: position{line: 925, col: 70, offset: 32547},
 												expr: &ruleRefExpr{
-													pos:  position{line: 925, col: 71, offset: 32386},
+													pos:  position{line: 925, col: 71, offset: 32548},
 													name: "Alphanum",
 												},
 											},
@@ -6753,14 +6778,14 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 925, col: 81, offset: 32396},
+									pos: position{line: 925, col: 81, offset: 32558},
 									expr: &ruleRefExpr{
-										pos:  position{line: 925, col: 81, offset: 32396},
+										pos:  position{line: 925, col: 81, offset: 32558},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 925, col: 85, offset: 32400},
+									pos:  position{line: 925, col: 85, offset: 32562},
 									name: "SingleQuoteBoldTextElement",
 								},
 							},
@@ -6771,80 +6796,84 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElement",
-			pos:  position{line: 927, col: 1, offset: 32431},
+			pos:  position{line: 927, col: 1, offset: 32593},
 			expr: &actionExpr{
-				pos: position{line: 927, col: 31, offset: 32461},
+				pos: position{line: 927, col: 31, offset: 32623},
 				run: (*parser).callonSingleQuoteBoldTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 927, col: 31, offset: 32461},
+					pos: position{line: 927, col: 31, offset: 32623},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 927, col: 31, offset: 32461},
+							pos: position{line: 927, col: 31, offset: 32623},
 							expr: &ruleRefExpr{
-								pos:  position{line: 927, col: 32, offset: 32462},
+								pos:  position{line: 927, col: 32, offset: 32624},
 								name: "Newline",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 927, col: 40, offset: 32632},
+							run: (*parser).callonSingleQuoteBoldTextElement5,
+						},
 						&labeledExpr{
-							pos:   position{line: 927, col: 40, offset: 32470},
+							pos:   position{line: 927, col: 73, offset: 32665},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 927, col: 49, offset: 32479},
+								pos: position{line: 927, col: 82, offset: 32674},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 927, col: 49, offset: 32479},
+										pos:  position{line: 927, col: 82, offset: 32674},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 928, col: 11, offset: 32501},
+										pos:  position{line: 928, col: 11, offset: 32696},
 										name: "DoubleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 929, col: 11, offset: 32531},
+										pos:  position{line: 929, col: 11, offset: 32726},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 930, col: 11, offset: 32553},
+										pos:  position{line: 930, col: 11, offset: 32748},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 931, col: 11, offset: 32577},
+										pos:  position{line: 931, col: 11, offset: 32772},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 932, col: 11, offset: 32601},
+										pos:  position{line: 932, col: 11, offset: 32796},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 933, col: 11, offset: 32627},
+										pos:  position{line: 933, col: 11, offset: 32822},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 934, col: 11, offset: 32650},
+										pos:  position{line: 934, col: 11, offset: 32845},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 935, col: 11, offset: 32672},
+										pos:  position{line: 935, col: 11, offset: 32867},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 936, col: 11, offset: 32695},
+										pos:  position{line: 936, col: 11, offset: 32890},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 937, col: 11, offset: 32735},
+										pos:  position{line: 937, col: 11, offset: 32930},
 										name: "NonSingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 938, col: 11, offset: 32768},
+										pos:  position{line: 938, col: 11, offset: 32963},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 939, col: 11, offset: 32790},
+										pos:  position{line: 939, col: 11, offset: 32985},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 940, col: 11, offset: 32809},
+										pos:  position{line: 940, col: 11, offset: 33004},
 										name: "AnyChar",
 									},
 								},
@@ -6856,68 +6885,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonSingleQuoteBoldText",
-			pos:  position{line: 944, col: 1, offset: 32951},
+			pos:  position{line: 944, col: 1, offset: 33146},
 			expr: &actionExpr{
-				pos: position{line: 944, col: 27, offset: 32977},
+				pos: position{line: 944, col: 27, offset: 33172},
 				run: (*parser).callonNonSingleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 944, col: 27, offset: 32977},
+					pos: position{line: 944, col: 27, offset: 33172},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 944, col: 28, offset: 32978,
+							line: 944, col: 28, offset: 33173,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 944, col: 31, offset: 32981},
+							pos: position{line: 944, col: 31, offset: 33176},
 							expr: &seqExpr{
-								pos: position{line: 944, col: 32, offset: 32982},
+								pos: position{line: 944, col: 32, offset: 33177},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 944, col: 32, offset: 32982},
+										pos: position{line: 944, col: 32, offset: 33177},
 										expr: &litMatcher{
-											pos:        position{line: 944, col: 33, offset: 32983},
+											pos:        position{line: 944, col: 33, offset: 33178},
 											val:        "*",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 944, col: 37, offset: 32987},
+										pos: position{line: 944, col: 37, offset: 33182},
 										expr: &ruleRefExpr{
-											pos:  position{line: 944, col: 38, offset: 32988},
+											pos:  position{line: 944, col: 38, offset: 33183},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 944, col: 41, offset: 32991},
+										pos: position{line: 944, col: 41, offset: 33186},
 										expr: &litMatcher{
-											pos:        position{line: 944, col: 42, offset: 32992},
+											pos:        position{line: 944, col: 42, offset: 33187},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 944, col: 46, offset: 32996},
+										pos: position{line: 944, col: 46, offset: 33191},
 										expr: &litMatcher{
-											pos:        position{line: 944, col: 47, offset: 32997},
+											pos:        position{line: 944, col: 47, offset: 33192},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 944, col: 51, offset: 33001},
+										pos: position{line: 944, col: 51, offset: 33196},
 										expr: &ruleRefExpr{
-											pos:  position{line: 944, col: 52, offset: 33002},
+											pos:  position{line: 944, col: 52, offset: 33197},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 944, col: 60, offset: 33010},
+										pos: position{line: 944, col: 60, offset: 33205},
 										expr: &ruleRefExpr{
-											pos:  position{line: 944, col: 61, offset: 33011},
+											pos:  position{line: 944, col: 61, offset: 33206},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 944, col: 73, offset: 33023,
+										line: 944, col: 73, offset: 33218,
 									},
 								},
 							},
@@ -6928,39 +6957,39 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedBoldText",
-			pos:  position{line: 948, col: 1, offset: 33083},
+			pos:  position{line: 948, col: 1, offset: 33278},
 			expr: &choiceExpr{
-				pos: position{line: 949, col: 5, offset: 33107},
+				pos: position{line: 949, col: 5, offset: 33302},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 949, col: 5, offset: 33107},
+						pos: position{line: 949, col: 5, offset: 33302},
 						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 949, col: 5, offset: 33107},
+							pos: position{line: 949, col: 5, offset: 33302},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 949, col: 5, offset: 33107},
+									pos:   position{line: 949, col: 5, offset: 33302},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 949, col: 18, offset: 33120},
+										pos:  position{line: 949, col: 18, offset: 33315},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 949, col: 40, offset: 33142},
+									pos:        position{line: 949, col: 40, offset: 33337},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 949, col: 45, offset: 33147},
+									pos:   position{line: 949, col: 45, offset: 33342},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 949, col: 55, offset: 33157},
+										pos:  position{line: 949, col: 55, offset: 33352},
 										name: "DoubleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 949, col: 84, offset: 33186},
+									pos:        position{line: 949, col: 84, offset: 33381},
 									val:        "**",
 									ignoreCase: false,
 								},
@@ -6968,34 +6997,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 951, col: 9, offset: 33343},
+						pos: position{line: 951, col: 9, offset: 33538},
 						run: (*parser).callonEscapedBoldText10,
 						expr: &seqExpr{
-							pos: position{line: 951, col: 9, offset: 33343},
+							pos: position{line: 951, col: 9, offset: 33538},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 951, col: 9, offset: 33343},
+									pos:   position{line: 951, col: 9, offset: 33538},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 951, col: 22, offset: 33356},
+										pos:  position{line: 951, col: 22, offset: 33551},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 951, col: 44, offset: 33378},
+									pos:        position{line: 951, col: 44, offset: 33573},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 951, col: 49, offset: 33383},
+									pos:   position{line: 951, col: 49, offset: 33578},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 951, col: 59, offset: 33393},
+										pos:  position{line: 951, col: 59, offset: 33588},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 951, col: 88, offset: 33422},
+									pos:        position{line: 951, col: 88, offset: 33617},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -7003,34 +7032,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 954, col: 9, offset: 33622},
+						pos: position{line: 954, col: 9, offset: 33817},
 						run: (*parser).callonEscapedBoldText18,
 						expr: &seqExpr{
-							pos: position{line: 954, col: 9, offset: 33622},
+							pos: position{line: 954, col: 9, offset: 33817},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 954, col: 9, offset: 33622},
+									pos:   position{line: 954, col: 9, offset: 33817},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 954, col: 22, offset: 33635},
+										pos:  position{line: 954, col: 22, offset: 33830},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 44, offset: 33657},
+									pos:        position{line: 954, col: 44, offset: 33852},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 954, col: 48, offset: 33661},
+									pos:   position{line: 954, col: 48, offset: 33856},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 954, col: 58, offset: 33671},
+										pos:  position{line: 954, col: 58, offset: 33866},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 87, offset: 33700},
+									pos:        position{line: 954, col: 87, offset: 33895},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -7042,16 +7071,16 @@ var g = &grammar{
 		},
 		{
 			name: "ItalicText",
-			pos:  position{line: 962, col: 1, offset: 33908},
+			pos:  position{line: 962, col: 1, offset: 34103},
 			expr: &choiceExpr{
-				pos: position{line: 962, col: 15, offset: 33922},
+				pos: position{line: 962, col: 15, offset: 34117},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 962, col: 15, offset: 33922},
+						pos:  position{line: 962, col: 15, offset: 34117},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 962, col: 39, offset: 33946},
+						pos:  position{line: 962, col: 39, offset: 34141},
 						name: "SingleQuoteItalicText",
 					},
 				},
@@ -7059,36 +7088,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicText",
-			pos:  position{line: 964, col: 1, offset: 33969},
+			pos:  position{line: 964, col: 1, offset: 34164},
 			expr: &actionExpr{
-				pos: position{line: 964, col: 26, offset: 33994},
+				pos: position{line: 964, col: 26, offset: 34189},
 				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 964, col: 26, offset: 33994},
+					pos: position{line: 964, col: 26, offset: 34189},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 964, col: 26, offset: 33994},
+							pos: position{line: 964, col: 26, offset: 34189},
 							expr: &litMatcher{
-								pos:        position{line: 964, col: 27, offset: 33995},
+								pos:        position{line: 964, col: 27, offset: 34190},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 964, col: 32, offset: 34000},
+							pos:        position{line: 964, col: 32, offset: 34195},
 							val:        "__",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 964, col: 37, offset: 34005},
+							pos:   position{line: 964, col: 37, offset: 34200},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 964, col: 47, offset: 34015},
+								pos:  position{line: 964, col: 47, offset: 34210},
 								name: "DoubleQuoteItalicTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 964, col: 78, offset: 34046},
+							pos:        position{line: 964, col: 78, offset: 34241},
 							val:        "__",
 							ignoreCase: false,
 						},
@@ -7098,36 +7127,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElements",
-			pos:  position{line: 968, col: 1, offset: 34173},
+			pos:  position{line: 968, col: 1, offset: 34368},
 			expr: &seqExpr{
-				pos: position{line: 968, col: 34, offset: 34206},
+				pos: position{line: 968, col: 34, offset: 34401},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 968, col: 34, offset: 34206},
+						pos:  position{line: 968, col: 34, offset: 34401},
 						name: "DoubleQuoteItalicTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 968, col: 63, offset: 34235},
+						pos: position{line: 968, col: 63, offset: 34430},
 						expr: &seqExpr{
-							pos: position{line: 968, col: 64, offset: 34236},
+							pos: position{line: 968, col: 64, offset: 34431},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 968, col: 64, offset: 34236},
+									pos: position{line: 968, col: 64, offset: 34431},
 									expr: &litMatcher{
-										pos:        position{line: 968, col: 66, offset: 34238},
+										pos:        position{line: 968, col: 66, offset: 34433},
 										val:        "__",
 										ignoreCase: false,
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 968, col: 73, offset: 34245},
+									pos: position{line: 968, col: 73, offset: 34440},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 968, col: 73, offset: 34245},
+											pos:  position{line: 968, col: 73, offset: 34440},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 968, col: 78, offset: 34250},
+											pos:  position{line: 968, col: 78, offset: 34445},
 											name: "DoubleQuoteItalicTextElement",
 										},
 									},
@@ -7140,76 +7169,80 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElement",
-			pos:  position{line: 970, col: 1, offset: 34317},
+			pos:  position{line: 970, col: 1, offset: 34512},
 			expr: &actionExpr{
-				pos: position{line: 970, col: 33, offset: 34349},
+				pos: position{line: 970, col: 33, offset: 34544},
 				run: (*parser).callonDoubleQuoteItalicTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 970, col: 33, offset: 34349},
+					pos: position{line: 970, col: 33, offset: 34544},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 970, col: 33, offset: 34349},
+							pos: position{line: 970, col: 33, offset: 34544},
 							expr: &ruleRefExpr{
-								pos:  position{line: 970, col: 34, offset: 34350},
+								pos:  position{line: 970, col: 34, offset: 34545},
 								name: "Newline",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 970, col: 42, offset: 34553},
+							run: (*parser).callonDoubleQuoteItalicTextElement5,
+						},
 						&labeledExpr{
-							pos:   position{line: 970, col: 42, offset: 34358},
+							pos:   position{line: 970, col: 75, offset: 34586},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 970, col: 51, offset: 34367},
+								pos: position{line: 970, col: 84, offset: 34595},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 970, col: 51, offset: 34367},
+										pos:  position{line: 970, col: 84, offset: 34595},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 971, col: 11, offset: 34389},
+										pos:  position{line: 971, col: 11, offset: 34617},
 										name: "SingleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 972, col: 11, offset: 34422},
+										pos:  position{line: 972, col: 11, offset: 34650},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 973, col: 11, offset: 34442},
+										pos:  position{line: 973, col: 11, offset: 34670},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 974, col: 11, offset: 34466},
+										pos:  position{line: 974, col: 11, offset: 34694},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 975, col: 11, offset: 34490},
+										pos:  position{line: 975, col: 11, offset: 34718},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 976, col: 11, offset: 34516},
+										pos:  position{line: 976, col: 11, offset: 34744},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 977, col: 11, offset: 34539},
+										pos:  position{line: 977, col: 11, offset: 34767},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 978, col: 11, offset: 34561},
+										pos:  position{line: 978, col: 11, offset: 34789},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 979, col: 11, offset: 34584},
+										pos:  position{line: 979, col: 11, offset: 34812},
 										name: "NonDoubleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 980, col: 11, offset: 34619},
+										pos:  position{line: 980, col: 11, offset: 34847},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 981, col: 11, offset: 34641},
+										pos:  position{line: 981, col: 11, offset: 34869},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 982, col: 11, offset: 34660},
+										pos:  position{line: 982, col: 11, offset: 34888},
 										name: "AnyChar",
 									},
 								},
@@ -7221,61 +7254,61 @@ var g = &grammar{
 		},
 		{
 			name: "NonDoubleQuoteItalicText",
-			pos:  position{line: 986, col: 1, offset: 34802},
+			pos:  position{line: 986, col: 1, offset: 35030},
 			expr: &actionExpr{
-				pos: position{line: 986, col: 29, offset: 34830},
+				pos: position{line: 986, col: 29, offset: 35058},
 				run: (*parser).callonNonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 986, col: 29, offset: 34830},
+					pos: position{line: 986, col: 29, offset: 35058},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 986, col: 30, offset: 34831,
+							line: 986, col: 30, offset: 35059,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 986, col: 33, offset: 34834},
+							pos: position{line: 986, col: 33, offset: 35062},
 							expr: &seqExpr{
-								pos: position{line: 986, col: 34, offset: 34835},
+								pos: position{line: 986, col: 34, offset: 35063},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 986, col: 34, offset: 34835},
+										pos: position{line: 986, col: 34, offset: 35063},
 										expr: &litMatcher{
-											pos:        position{line: 986, col: 35, offset: 34836},
+											pos:        position{line: 986, col: 35, offset: 35064},
 											val:        "__",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 986, col: 40, offset: 34841},
+										pos: position{line: 986, col: 40, offset: 35069},
 										expr: &litMatcher{
-											pos:        position{line: 986, col: 41, offset: 34842},
+											pos:        position{line: 986, col: 41, offset: 35070},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 986, col: 45, offset: 34846},
+										pos: position{line: 986, col: 45, offset: 35074},
 										expr: &litMatcher{
-											pos:        position{line: 986, col: 46, offset: 34847},
+											pos:        position{line: 986, col: 46, offset: 35075},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 986, col: 50, offset: 34851},
+										pos: position{line: 986, col: 50, offset: 35079},
 										expr: &ruleRefExpr{
-											pos:  position{line: 986, col: 51, offset: 34852},
+											pos:  position{line: 986, col: 51, offset: 35080},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 986, col: 59, offset: 34860},
+										pos: position{line: 986, col: 59, offset: 35088},
 										expr: &ruleRefExpr{
-											pos:  position{line: 986, col: 60, offset: 34861},
+											pos:  position{line: 986, col: 60, offset: 35089},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 986, col: 72, offset: 34873,
+										line: 986, col: 72, offset: 35101,
 									},
 								},
 							},
@@ -7286,47 +7319,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicText",
-			pos:  position{line: 990, col: 1, offset: 34933},
+			pos:  position{line: 990, col: 1, offset: 35161},
 			expr: &choiceExpr{
-				pos: position{line: 990, col: 26, offset: 34958},
+				pos: position{line: 990, col: 26, offset: 35186},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 990, col: 26, offset: 34958},
+						pos: position{line: 990, col: 26, offset: 35186},
 						run: (*parser).callonSingleQuoteItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 990, col: 26, offset: 34958},
+							pos: position{line: 990, col: 26, offset: 35186},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 990, col: 26, offset: 34958},
+									pos: position{line: 990, col: 26, offset: 35186},
 									expr: &litMatcher{
-										pos:        position{line: 990, col: 27, offset: 34959},
+										pos:        position{line: 990, col: 27, offset: 35187},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 990, col: 31, offset: 34963},
+									pos:        position{line: 990, col: 31, offset: 35191},
 									val:        "_",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 990, col: 35, offset: 34967},
+									pos: position{line: 990, col: 35, offset: 35195},
 									expr: &litMatcher{
-										pos:        position{line: 990, col: 36, offset: 34968},
+										pos:        position{line: 990, col: 36, offset: 35196},
 										val:        "_",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 990, col: 40, offset: 34972},
+									pos:   position{line: 990, col: 40, offset: 35200},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 990, col: 50, offset: 34982},
+										pos:  position{line: 990, col: 50, offset: 35210},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 990, col: 81, offset: 35013},
+									pos:        position{line: 990, col: 81, offset: 35241},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -7334,44 +7367,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 992, col: 5, offset: 35196},
+						pos: position{line: 992, col: 5, offset: 35424},
 						run: (*parser).callonSingleQuoteItalicText12,
 						expr: &seqExpr{
-							pos: position{line: 992, col: 5, offset: 35196},
+							pos: position{line: 992, col: 5, offset: 35424},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 992, col: 5, offset: 35196},
+									pos: position{line: 992, col: 5, offset: 35424},
 									expr: &litMatcher{
-										pos:        position{line: 992, col: 6, offset: 35197},
+										pos:        position{line: 992, col: 6, offset: 35425},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 992, col: 11, offset: 35202},
+									pos:        position{line: 992, col: 11, offset: 35430},
 									val:        "_",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 992, col: 15, offset: 35206},
+									pos:   position{line: 992, col: 15, offset: 35434},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 992, col: 25, offset: 35216},
+										pos: position{line: 992, col: 25, offset: 35444},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 992, col: 25, offset: 35216},
+												pos:        position{line: 992, col: 25, offset: 35444},
 												val:        "_",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 992, col: 29, offset: 35220},
+												pos:  position{line: 992, col: 29, offset: 35448},
 												name: "SingleQuoteItalicTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 992, col: 60, offset: 35251},
+									pos:        position{line: 992, col: 60, offset: 35479},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -7383,40 +7416,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElements",
-			pos:  position{line: 996, col: 1, offset: 35454},
+			pos:  position{line: 996, col: 1, offset: 35682},
 			expr: &seqExpr{
-				pos: position{line: 996, col: 34, offset: 35487},
+				pos: position{line: 996, col: 34, offset: 35715},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 996, col: 34, offset: 35487},
+						pos: position{line: 996, col: 34, offset: 35715},
 						expr: &ruleRefExpr{
-							pos:  position{line: 996, col: 35, offset: 35488},
+							pos:  position{line: 996, col: 35, offset: 35716},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 996, col: 38, offset: 35491},
+						pos:  position{line: 996, col: 38, offset: 35719},
 						name: "SingleQuoteItalicTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 996, col: 67, offset: 35520},
+						pos: position{line: 996, col: 67, offset: 35748},
 						expr: &seqExpr{
-							pos: position{line: 996, col: 68, offset: 35521},
+							pos: position{line: 996, col: 68, offset: 35749},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 996, col: 68, offset: 35521},
+									pos: position{line: 996, col: 68, offset: 35749},
 									expr: &seqExpr{
-										pos: position{line: 996, col: 70, offset: 35523},
+										pos: position{line: 996, col: 70, offset: 35751},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 996, col: 70, offset: 35523},
+												pos:        position{line: 996, col: 70, offset: 35751},
 												val:        "_",
 												ignoreCase: false,
 											},
 											&notExpr{
-												pos: position{line: 996, col: 74, offset: 35527},
+												pos: position{line: 996, col: 74, offset: 35755},
 												expr: &ruleRefExpr{
-													pos:  position{line: 996, col: 75, offset: 35528},
+													pos:  position{line: 996, col: 75, offset: 35756},
 													name: "Alphanum",
 												},
 											},
@@ -7424,14 +7457,14 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 996, col: 85, offset: 35538},
+									pos: position{line: 996, col: 85, offset: 35766},
 									expr: &ruleRefExpr{
-										pos:  position{line: 996, col: 85, offset: 35538},
+										pos:  position{line: 996, col: 85, offset: 35766},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 996, col: 89, offset: 35542},
+									pos:  position{line: 996, col: 89, offset: 35770},
 									name: "SingleQuoteItalicTextElement",
 								},
 							},
@@ -7442,76 +7475,80 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElement",
-			pos:  position{line: 998, col: 1, offset: 35574},
+			pos:  position{line: 998, col: 1, offset: 35802},
 			expr: &actionExpr{
-				pos: position{line: 998, col: 33, offset: 35606},
+				pos: position{line: 998, col: 33, offset: 35834},
 				run: (*parser).callonSingleQuoteItalicTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 998, col: 33, offset: 35606},
+					pos: position{line: 998, col: 33, offset: 35834},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 998, col: 33, offset: 35606},
+							pos: position{line: 998, col: 33, offset: 35834},
 							expr: &ruleRefExpr{
-								pos:  position{line: 998, col: 34, offset: 35607},
+								pos:  position{line: 998, col: 34, offset: 35835},
 								name: "Newline",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 998, col: 42, offset: 35843},
+							run: (*parser).callonSingleQuoteItalicTextElement5,
+						},
 						&labeledExpr{
-							pos:   position{line: 998, col: 42, offset: 35615},
+							pos:   position{line: 998, col: 75, offset: 35876},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 998, col: 51, offset: 35624},
+								pos: position{line: 998, col: 84, offset: 35885},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 998, col: 51, offset: 35624},
+										pos:  position{line: 998, col: 84, offset: 35885},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 999, col: 11, offset: 35646},
+										pos:  position{line: 999, col: 11, offset: 35907},
 										name: "DoubleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1000, col: 11, offset: 35678},
+										pos:  position{line: 1000, col: 11, offset: 35939},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1001, col: 11, offset: 35698},
+										pos:  position{line: 1001, col: 11, offset: 35959},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1002, col: 11, offset: 35722},
+										pos:  position{line: 1002, col: 11, offset: 35983},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1003, col: 11, offset: 35746},
+										pos:  position{line: 1003, col: 11, offset: 36007},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1004, col: 11, offset: 35772},
+										pos:  position{line: 1004, col: 11, offset: 36033},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1005, col: 11, offset: 35795},
+										pos:  position{line: 1005, col: 11, offset: 36056},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1006, col: 11, offset: 35817},
+										pos:  position{line: 1006, col: 11, offset: 36078},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1007, col: 11, offset: 35840},
+										pos:  position{line: 1007, col: 11, offset: 36101},
 										name: "NonSingleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1008, col: 11, offset: 35875},
+										pos:  position{line: 1008, col: 11, offset: 36136},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1009, col: 11, offset: 35897},
+										pos:  position{line: 1009, col: 11, offset: 36158},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1010, col: 11, offset: 35916},
+										pos:  position{line: 1010, col: 11, offset: 36177},
 										name: "AnyChar",
 									},
 								},
@@ -7523,68 +7560,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonSingleQuoteItalicText",
-			pos:  position{line: 1014, col: 1, offset: 36058},
+			pos:  position{line: 1014, col: 1, offset: 36319},
 			expr: &actionExpr{
-				pos: position{line: 1014, col: 29, offset: 36086},
+				pos: position{line: 1014, col: 29, offset: 36347},
 				run: (*parser).callonNonSingleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 1014, col: 29, offset: 36086},
+					pos: position{line: 1014, col: 29, offset: 36347},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 1014, col: 30, offset: 36087,
+							line: 1014, col: 30, offset: 36348,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1014, col: 33, offset: 36090},
+							pos: position{line: 1014, col: 33, offset: 36351},
 							expr: &seqExpr{
-								pos: position{line: 1014, col: 34, offset: 36091},
+								pos: position{line: 1014, col: 34, offset: 36352},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1014, col: 34, offset: 36091},
+										pos: position{line: 1014, col: 34, offset: 36352},
 										expr: &litMatcher{
-											pos:        position{line: 1014, col: 35, offset: 36092},
+											pos:        position{line: 1014, col: 35, offset: 36353},
 											val:        "_",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1014, col: 39, offset: 36096},
+										pos: position{line: 1014, col: 39, offset: 36357},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1014, col: 40, offset: 36097},
+											pos:  position{line: 1014, col: 40, offset: 36358},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1014, col: 43, offset: 36100},
+										pos: position{line: 1014, col: 43, offset: 36361},
 										expr: &litMatcher{
-											pos:        position{line: 1014, col: 44, offset: 36101},
+											pos:        position{line: 1014, col: 44, offset: 36362},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1014, col: 48, offset: 36105},
+										pos: position{line: 1014, col: 48, offset: 36366},
 										expr: &litMatcher{
-											pos:        position{line: 1014, col: 49, offset: 36106},
+											pos:        position{line: 1014, col: 49, offset: 36367},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1014, col: 53, offset: 36110},
+										pos: position{line: 1014, col: 53, offset: 36371},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1014, col: 54, offset: 36111},
+											pos:  position{line: 1014, col: 54, offset: 36372},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1014, col: 62, offset: 36119},
+										pos: position{line: 1014, col: 62, offset: 36380},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1014, col: 63, offset: 36120},
+											pos:  position{line: 1014, col: 63, offset: 36381},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 1014, col: 75, offset: 36132,
+										line: 1014, col: 75, offset: 36393,
 									},
 								},
 							},
@@ -7595,39 +7632,39 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedItalicText",
-			pos:  position{line: 1018, col: 1, offset: 36192},
+			pos:  position{line: 1018, col: 1, offset: 36453},
 			expr: &choiceExpr{
-				pos: position{line: 1019, col: 5, offset: 36218},
+				pos: position{line: 1019, col: 5, offset: 36479},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1019, col: 5, offset: 36218},
+						pos: position{line: 1019, col: 5, offset: 36479},
 						run: (*parser).callonEscapedItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1019, col: 5, offset: 36218},
+							pos: position{line: 1019, col: 5, offset: 36479},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1019, col: 5, offset: 36218},
+									pos:   position{line: 1019, col: 5, offset: 36479},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1019, col: 18, offset: 36231},
+										pos:  position{line: 1019, col: 18, offset: 36492},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1019, col: 40, offset: 36253},
+									pos:        position{line: 1019, col: 40, offset: 36514},
 									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1019, col: 45, offset: 36258},
+									pos:   position{line: 1019, col: 45, offset: 36519},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1019, col: 55, offset: 36268},
+										pos:  position{line: 1019, col: 55, offset: 36529},
 										name: "DoubleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1019, col: 86, offset: 36299},
+									pos:        position{line: 1019, col: 86, offset: 36560},
 									val:        "__",
 									ignoreCase: false,
 								},
@@ -7635,34 +7672,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1021, col: 9, offset: 36456},
+						pos: position{line: 1021, col: 9, offset: 36717},
 						run: (*parser).callonEscapedItalicText10,
 						expr: &seqExpr{
-							pos: position{line: 1021, col: 9, offset: 36456},
+							pos: position{line: 1021, col: 9, offset: 36717},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1021, col: 9, offset: 36456},
+									pos:   position{line: 1021, col: 9, offset: 36717},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1021, col: 22, offset: 36469},
+										pos:  position{line: 1021, col: 22, offset: 36730},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1021, col: 44, offset: 36491},
+									pos:        position{line: 1021, col: 44, offset: 36752},
 									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1021, col: 49, offset: 36496},
+									pos:   position{line: 1021, col: 49, offset: 36757},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1021, col: 59, offset: 36506},
+										pos:  position{line: 1021, col: 59, offset: 36767},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1021, col: 90, offset: 36537},
+									pos:        position{line: 1021, col: 90, offset: 36798},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -7670,34 +7707,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1024, col: 9, offset: 36737},
+						pos: position{line: 1024, col: 9, offset: 36998},
 						run: (*parser).callonEscapedItalicText18,
 						expr: &seqExpr{
-							pos: position{line: 1024, col: 9, offset: 36737},
+							pos: position{line: 1024, col: 9, offset: 36998},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1024, col: 9, offset: 36737},
+									pos:   position{line: 1024, col: 9, offset: 36998},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1024, col: 22, offset: 36750},
+										pos:  position{line: 1024, col: 22, offset: 37011},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1024, col: 44, offset: 36772},
+									pos:        position{line: 1024, col: 44, offset: 37033},
 									val:        "_",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1024, col: 48, offset: 36776},
+									pos:   position{line: 1024, col: 48, offset: 37037},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1024, col: 58, offset: 36786},
+										pos:  position{line: 1024, col: 58, offset: 37047},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1024, col: 89, offset: 36817},
+									pos:        position{line: 1024, col: 89, offset: 37078},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -7709,16 +7746,16 @@ var g = &grammar{
 		},
 		{
 			name: "MonospaceText",
-			pos:  position{line: 1031, col: 1, offset: 37027},
+			pos:  position{line: 1031, col: 1, offset: 37288},
 			expr: &choiceExpr{
-				pos: position{line: 1031, col: 18, offset: 37044},
+				pos: position{line: 1031, col: 18, offset: 37305},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1031, col: 18, offset: 37044},
+						pos:  position{line: 1031, col: 18, offset: 37305},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1031, col: 45, offset: 37071},
+						pos:  position{line: 1031, col: 45, offset: 37332},
 						name: "SingleQuoteMonospaceText",
 					},
 				},
@@ -7726,36 +7763,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceText",
-			pos:  position{line: 1033, col: 1, offset: 37097},
+			pos:  position{line: 1033, col: 1, offset: 37358},
 			expr: &actionExpr{
-				pos: position{line: 1033, col: 29, offset: 37125},
+				pos: position{line: 1033, col: 29, offset: 37386},
 				run: (*parser).callonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1033, col: 29, offset: 37125},
+					pos: position{line: 1033, col: 29, offset: 37386},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1033, col: 29, offset: 37125},
+							pos: position{line: 1033, col: 29, offset: 37386},
 							expr: &litMatcher{
-								pos:        position{line: 1033, col: 30, offset: 37126},
+								pos:        position{line: 1033, col: 30, offset: 37387},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1033, col: 35, offset: 37131},
+							pos:        position{line: 1033, col: 35, offset: 37392},
 							val:        "``",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1033, col: 40, offset: 37136},
+							pos:   position{line: 1033, col: 40, offset: 37397},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1033, col: 50, offset: 37146},
+								pos:  position{line: 1033, col: 50, offset: 37407},
 								name: "DoubleQuoteMonospaceTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1033, col: 84, offset: 37180},
+							pos:        position{line: 1033, col: 84, offset: 37441},
 							val:        "``",
 							ignoreCase: false,
 						},
@@ -7765,36 +7802,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElements",
-			pos:  position{line: 1037, col: 1, offset: 37310},
+			pos:  position{line: 1037, col: 1, offset: 37571},
 			expr: &seqExpr{
-				pos: position{line: 1037, col: 37, offset: 37346},
+				pos: position{line: 1037, col: 37, offset: 37607},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1037, col: 37, offset: 37346},
+						pos:  position{line: 1037, col: 37, offset: 37607},
 						name: "DoubleQuoteMonospaceTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1037, col: 69, offset: 37378},
+						pos: position{line: 1037, col: 69, offset: 37639},
 						expr: &seqExpr{
-							pos: position{line: 1037, col: 70, offset: 37379},
+							pos: position{line: 1037, col: 70, offset: 37640},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1037, col: 70, offset: 37379},
+									pos: position{line: 1037, col: 70, offset: 37640},
 									expr: &litMatcher{
-										pos:        position{line: 1037, col: 72, offset: 37381},
+										pos:        position{line: 1037, col: 72, offset: 37642},
 										val:        "``",
 										ignoreCase: false,
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1037, col: 79, offset: 37388},
+									pos: position{line: 1037, col: 79, offset: 37649},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1037, col: 79, offset: 37388},
+											pos:  position{line: 1037, col: 79, offset: 37649},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1037, col: 84, offset: 37393},
+											pos:  position{line: 1037, col: 84, offset: 37654},
 											name: "DoubleQuoteMonospaceTextElement",
 										},
 									},
@@ -7807,76 +7844,80 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElement",
-			pos:  position{line: 1039, col: 1, offset: 37462},
+			pos:  position{line: 1039, col: 1, offset: 37723},
 			expr: &actionExpr{
-				pos: position{line: 1039, col: 36, offset: 37497},
+				pos: position{line: 1039, col: 36, offset: 37758},
 				run: (*parser).callonDoubleQuoteMonospaceTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 1039, col: 36, offset: 37497},
+					pos: position{line: 1039, col: 36, offset: 37758},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1039, col: 36, offset: 37497},
+							pos: position{line: 1039, col: 36, offset: 37758},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1039, col: 37, offset: 37498},
+								pos:  position{line: 1039, col: 37, offset: 37759},
 								name: "Newline",
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 1039, col: 45, offset: 37767},
+							run: (*parser).callonDoubleQuoteMonospaceTextElement5,
+						},
 						&labeledExpr{
-							pos:   position{line: 1039, col: 45, offset: 37506},
+							pos:   position{line: 1039, col: 78, offset: 37800},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1039, col: 54, offset: 37515},
+								pos: position{line: 1039, col: 87, offset: 37809},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1039, col: 54, offset: 37515},
+										pos:  position{line: 1039, col: 87, offset: 37809},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1040, col: 11, offset: 37537},
+										pos:  position{line: 1040, col: 11, offset: 37831},
 										name: "SingleQuoteMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1041, col: 11, offset: 37573},
+										pos:  position{line: 1041, col: 11, offset: 37867},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1042, col: 11, offset: 37592},
+										pos:  position{line: 1042, col: 11, offset: 37886},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1043, col: 11, offset: 37614},
+										pos:  position{line: 1043, col: 11, offset: 37908},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1044, col: 11, offset: 37638},
+										pos:  position{line: 1044, col: 11, offset: 37932},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1045, col: 11, offset: 37664},
+										pos:  position{line: 1045, col: 11, offset: 37958},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1046, col: 11, offset: 37687},
+										pos:  position{line: 1046, col: 11, offset: 37981},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1047, col: 11, offset: 37709},
+										pos:  position{line: 1047, col: 11, offset: 38003},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1048, col: 11, offset: 37732},
+										pos:  position{line: 1048, col: 11, offset: 38026},
 										name: "NonDoubleQuoteMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1049, col: 11, offset: 37770},
+										pos:  position{line: 1049, col: 11, offset: 38064},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1050, col: 11, offset: 37792},
+										pos:  position{line: 1050, col: 11, offset: 38086},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1051, col: 11, offset: 37811},
+										pos:  position{line: 1051, col: 11, offset: 38105},
 										name: "AnyChar",
 									},
 								},
@@ -7888,68 +7929,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonDoubleQuoteMonospaceText",
-			pos:  position{line: 1055, col: 1, offset: 37953},
+			pos:  position{line: 1055, col: 1, offset: 38247},
 			expr: &actionExpr{
-				pos: position{line: 1055, col: 32, offset: 37984},
+				pos: position{line: 1055, col: 32, offset: 38278},
 				run: (*parser).callonNonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1055, col: 32, offset: 37984},
+					pos: position{line: 1055, col: 32, offset: 38278},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 1055, col: 33, offset: 37985,
+							line: 1055, col: 33, offset: 38279,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1055, col: 36, offset: 37988},
+							pos: position{line: 1055, col: 36, offset: 38282},
 							expr: &seqExpr{
-								pos: position{line: 1055, col: 37, offset: 37989},
+								pos: position{line: 1055, col: 37, offset: 38283},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1055, col: 37, offset: 37989},
+										pos: position{line: 1055, col: 37, offset: 38283},
 										expr: &litMatcher{
-											pos:        position{line: 1055, col: 38, offset: 37990},
+											pos:        position{line: 1055, col: 38, offset: 38284},
 											val:        "``",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1055, col: 43, offset: 37995},
+										pos: position{line: 1055, col: 43, offset: 38289},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1055, col: 44, offset: 37996},
+											pos:  position{line: 1055, col: 44, offset: 38290},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1055, col: 47, offset: 37999},
+										pos: position{line: 1055, col: 47, offset: 38293},
 										expr: &litMatcher{
-											pos:        position{line: 1055, col: 48, offset: 38000},
+											pos:        position{line: 1055, col: 48, offset: 38294},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1055, col: 52, offset: 38004},
+										pos: position{line: 1055, col: 52, offset: 38298},
 										expr: &litMatcher{
-											pos:        position{line: 1055, col: 53, offset: 38005},
+											pos:        position{line: 1055, col: 53, offset: 38299},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1055, col: 57, offset: 38009},
+										pos: position{line: 1055, col: 57, offset: 38303},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1055, col: 58, offset: 38010},
+											pos:  position{line: 1055, col: 58, offset: 38304},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1055, col: 66, offset: 38018},
+										pos: position{line: 1055, col: 66, offset: 38312},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1055, col: 67, offset: 38019},
+											pos:  position{line: 1055, col: 67, offset: 38313},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 1055, col: 79, offset: 38031,
+										line: 1055, col: 79, offset: 38325,
 									},
 								},
 							},
@@ -7960,47 +8001,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceText",
-			pos:  position{line: 1059, col: 1, offset: 38091},
+			pos:  position{line: 1059, col: 1, offset: 38385},
 			expr: &choiceExpr{
-				pos: position{line: 1059, col: 29, offset: 38119},
+				pos: position{line: 1059, col: 29, offset: 38413},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1059, col: 29, offset: 38119},
+						pos: position{line: 1059, col: 29, offset: 38413},
 						run: (*parser).callonSingleQuoteMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1059, col: 29, offset: 38119},
+							pos: position{line: 1059, col: 29, offset: 38413},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1059, col: 29, offset: 38119},
+									pos: position{line: 1059, col: 29, offset: 38413},
 									expr: &litMatcher{
-										pos:        position{line: 1059, col: 30, offset: 38120},
+										pos:        position{line: 1059, col: 30, offset: 38414},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 1059, col: 34, offset: 38124},
+									pos: position{line: 1059, col: 34, offset: 38418},
 									expr: &litMatcher{
-										pos:        position{line: 1059, col: 35, offset: 38125},
+										pos:        position{line: 1059, col: 35, offset: 38419},
 										val:        "``",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 40, offset: 38130},
+									pos:        position{line: 1059, col: 40, offset: 38424},
 									val:        "`",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1059, col: 44, offset: 38134},
+									pos:   position{line: 1059, col: 44, offset: 38428},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 54, offset: 38144},
+										pos:  position{line: 1059, col: 54, offset: 38438},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 88, offset: 38178},
+									pos:        position{line: 1059, col: 88, offset: 38472},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -8008,44 +8049,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1061, col: 5, offset: 38364},
+						pos: position{line: 1061, col: 5, offset: 38658},
 						run: (*parser).callonSingleQuoteMonospaceText12,
 						expr: &seqExpr{
-							pos: position{line: 1061, col: 5, offset: 38364},
+							pos: position{line: 1061, col: 5, offset: 38658},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1061, col: 5, offset: 38364},
+									pos: position{line: 1061, col: 5, offset: 38658},
 									expr: &litMatcher{
-										pos:        position{line: 1061, col: 6, offset: 38365},
+										pos:        position{line: 1061, col: 6, offset: 38659},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1061, col: 11, offset: 38370},
+									pos:        position{line: 1061, col: 11, offset: 38664},
 									val:        "`",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1061, col: 15, offset: 38374},
+									pos:   position{line: 1061, col: 15, offset: 38668},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1061, col: 25, offset: 38384},
+										pos: position{line: 1061, col: 25, offset: 38678},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1061, col: 25, offset: 38384},
+												pos:        position{line: 1061, col: 25, offset: 38678},
 												val:        "`",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1061, col: 29, offset: 38388},
+												pos:  position{line: 1061, col: 29, offset: 38682},
 												name: "SingleQuoteMonospaceTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1061, col: 63, offset: 38422},
+									pos:        position{line: 1061, col: 63, offset: 38716},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -8057,40 +8098,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElements",
-			pos:  position{line: 1065, col: 1, offset: 38631},
+			pos:  position{line: 1065, col: 1, offset: 38925},
 			expr: &seqExpr{
-				pos: position{line: 1065, col: 37, offset: 38667},
+				pos: position{line: 1065, col: 37, offset: 38961},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1065, col: 37, offset: 38667},
+						pos: position{line: 1065, col: 37, offset: 38961},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1065, col: 38, offset: 38668},
+							pos:  position{line: 1065, col: 38, offset: 38962},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1065, col: 41, offset: 38671},
+						pos:  position{line: 1065, col: 41, offset: 38965},
 						name: "SingleQuoteMonospaceTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1065, col: 73, offset: 38703},
+						pos: position{line: 1065, col: 73, offset: 38997},
 						expr: &seqExpr{
-							pos: position{line: 1065, col: 74, offset: 38704},
+							pos: position{line: 1065, col: 74, offset: 38998},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1065, col: 74, offset: 38704},
+									pos: position{line: 1065, col: 74, offset: 38998},
 									expr: &seqExpr{
-										pos: position{line: 1065, col: 76, offset: 38706},
+										pos: position{line: 1065, col: 76, offset: 39000},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1065, col: 76, offset: 38706},
+												pos:        position{line: 1065, col: 76, offset: 39000},
 												val:        "`",
 												ignoreCase: false,
 											},
 											&notExpr{
-												pos: position{line: 1065, col: 80, offset: 38710},
+												pos: position{line: 1065, col: 80, offset: 39004},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1065, col: 81, offset: 38711},
+													pos:  position{line: 1065, col: 81, offset: 39005},
 													name: "Alphanum",
 												},
 											},
@@ -8098,14 +8139,14 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1065, col: 91, offset: 38721},
+									pos: position{line: 1065, col: 91, offset: 39015},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1065, col: 91, offset: 38721},
+										pos:  position{line: 1065, col: 91, offset: 39015},
 										name: "WS",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1065, col: 95, offset: 38725},
+									pos:  position{line: 1065, col: 95, offset: 39019},
 									name: "SingleQuoteMonospaceTextElement",
 								},
 							},
@@ -8116,71 +8157,80 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElement",
-			pos:  position{line: 1067, col: 1, offset: 38760},
+			pos:  position{line: 1067, col: 1, offset: 39054},
 			expr: &actionExpr{
-				pos: position{line: 1067, col: 37, offset: 38796},
+				pos: position{line: 1067, col: 36, offset: 39089},
 				run: (*parser).callonSingleQuoteMonospaceTextElement1,
-				expr: &labeledExpr{
-					pos:   position{line: 1067, col: 37, offset: 38796},
-					label: "element",
-					expr: &choiceExpr{
-						pos: position{line: 1067, col: 46, offset: 38805},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 1067, col: 46, offset: 38805},
-								name: "SimpleWord",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1068, col: 11, offset: 38827},
-								name: "Newline",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1069, col: 11, offset: 38865},
-								name: "DoubleQuoteMonospaceText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1070, col: 11, offset: 38901},
-								name: "BoldText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1071, col: 11, offset: 38920},
-								name: "ItalicText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1072, col: 11, offset: 38941},
-								name: "SubscriptText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1073, col: 11, offset: 38965},
-								name: "SuperscriptText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1074, col: 11, offset: 38991},
-								name: "InlineImage",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1075, col: 11, offset: 39014},
-								name: "QuotedLink",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1076, col: 11, offset: 39036},
-								name: "Passthrough",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1077, col: 11, offset: 39059},
-								name: "NonSingleQuoteMonospaceText",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1078, col: 11, offset: 39097},
-								name: "Parenthesis",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1079, col: 11, offset: 39119},
-								name: "AnyChars",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1080, col: 11, offset: 39138},
-								name: "AnyChar",
+				expr: &seqExpr{
+					pos: position{line: 1067, col: 36, offset: 39089},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 1067, col: 36, offset: 39089},
+							run: (*parser).callonSingleQuoteMonospaceTextElement3,
+						},
+						&labeledExpr{
+							pos:   position{line: 1067, col: 69, offset: 39122},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1067, col: 78, offset: 39131},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1067, col: 78, offset: 39131},
+										name: "SimpleWord",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1068, col: 11, offset: 39153},
+										name: "Newline",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1069, col: 11, offset: 39191},
+										name: "DoubleQuoteMonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1070, col: 11, offset: 39227},
+										name: "BoldText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1071, col: 11, offset: 39246},
+										name: "ItalicText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1072, col: 11, offset: 39267},
+										name: "SubscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1073, col: 11, offset: 39291},
+										name: "SuperscriptText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1074, col: 11, offset: 39317},
+										name: "InlineImage",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1075, col: 11, offset: 39340},
+										name: "QuotedLink",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1076, col: 11, offset: 39362},
+										name: "Passthrough",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1077, col: 11, offset: 39385},
+										name: "NonSingleQuoteMonospaceText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1078, col: 11, offset: 39423},
+										name: "Parenthesis",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1079, col: 11, offset: 39445},
+										name: "AnyChars",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1080, col: 11, offset: 39464},
+										name: "AnyChar",
+									},
+								},
 							},
 						},
 					},
@@ -8189,68 +8239,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonSingleQuoteMonospaceText",
-			pos:  position{line: 1084, col: 1, offset: 39280},
+			pos:  position{line: 1084, col: 1, offset: 39606},
 			expr: &actionExpr{
-				pos: position{line: 1084, col: 32, offset: 39311},
+				pos: position{line: 1084, col: 32, offset: 39637},
 				run: (*parser).callonNonSingleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1084, col: 32, offset: 39311},
+					pos: position{line: 1084, col: 32, offset: 39637},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 1084, col: 33, offset: 39312,
+							line: 1084, col: 33, offset: 39638,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1084, col: 36, offset: 39315},
+							pos: position{line: 1084, col: 36, offset: 39641},
 							expr: &seqExpr{
-								pos: position{line: 1084, col: 37, offset: 39316},
+								pos: position{line: 1084, col: 37, offset: 39642},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1084, col: 37, offset: 39316},
+										pos: position{line: 1084, col: 37, offset: 39642},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1084, col: 38, offset: 39317},
+											pos:  position{line: 1084, col: 38, offset: 39643},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1084, col: 41, offset: 39320},
+										pos: position{line: 1084, col: 41, offset: 39646},
 										expr: &litMatcher{
-											pos:        position{line: 1084, col: 42, offset: 39321},
+											pos:        position{line: 1084, col: 42, offset: 39647},
 											val:        "`",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1084, col: 46, offset: 39325},
+										pos: position{line: 1084, col: 46, offset: 39651},
 										expr: &litMatcher{
-											pos:        position{line: 1084, col: 47, offset: 39326},
+											pos:        position{line: 1084, col: 47, offset: 39652},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1084, col: 51, offset: 39330},
+										pos: position{line: 1084, col: 51, offset: 39656},
 										expr: &litMatcher{
-											pos:        position{line: 1084, col: 52, offset: 39331},
+											pos:        position{line: 1084, col: 52, offset: 39657},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1084, col: 56, offset: 39335},
+										pos: position{line: 1084, col: 56, offset: 39661},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1084, col: 57, offset: 39336},
+											pos:  position{line: 1084, col: 57, offset: 39662},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1084, col: 65, offset: 39344},
+										pos: position{line: 1084, col: 65, offset: 39670},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1084, col: 66, offset: 39345},
+											pos:  position{line: 1084, col: 66, offset: 39671},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 1084, col: 78, offset: 39357,
+										line: 1084, col: 78, offset: 39683,
 									},
 								},
 							},
@@ -8261,39 +8311,39 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMonospaceText",
-			pos:  position{line: 1088, col: 1, offset: 39438},
+			pos:  position{line: 1088, col: 1, offset: 39764},
 			expr: &choiceExpr{
-				pos: position{line: 1089, col: 5, offset: 39467},
+				pos: position{line: 1089, col: 5, offset: 39793},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1089, col: 5, offset: 39467},
+						pos: position{line: 1089, col: 5, offset: 39793},
 						run: (*parser).callonEscapedMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1089, col: 5, offset: 39467},
+							pos: position{line: 1089, col: 5, offset: 39793},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1089, col: 5, offset: 39467},
+									pos:   position{line: 1089, col: 5, offset: 39793},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1089, col: 18, offset: 39480},
+										pos:  position{line: 1089, col: 18, offset: 39806},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1089, col: 40, offset: 39502},
+									pos:        position{line: 1089, col: 40, offset: 39828},
 									val:        "``",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1089, col: 45, offset: 39507},
+									pos:   position{line: 1089, col: 45, offset: 39833},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1089, col: 55, offset: 39517},
+										pos:  position{line: 1089, col: 55, offset: 39843},
 										name: "DoubleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1089, col: 89, offset: 39551},
+									pos:        position{line: 1089, col: 89, offset: 39877},
 									val:        "``",
 									ignoreCase: false,
 								},
@@ -8301,34 +8351,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1091, col: 9, offset: 39708},
+						pos: position{line: 1091, col: 9, offset: 40034},
 						run: (*parser).callonEscapedMonospaceText10,
 						expr: &seqExpr{
-							pos: position{line: 1091, col: 9, offset: 39708},
+							pos: position{line: 1091, col: 9, offset: 40034},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1091, col: 9, offset: 39708},
+									pos:   position{line: 1091, col: 9, offset: 40034},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1091, col: 22, offset: 39721},
+										pos:  position{line: 1091, col: 22, offset: 40047},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1091, col: 44, offset: 39743},
+									pos:        position{line: 1091, col: 44, offset: 40069},
 									val:        "``",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1091, col: 49, offset: 39748},
+									pos:   position{line: 1091, col: 49, offset: 40074},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1091, col: 59, offset: 39758},
+										pos:  position{line: 1091, col: 59, offset: 40084},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1091, col: 93, offset: 39792},
+									pos:        position{line: 1091, col: 93, offset: 40118},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -8336,34 +8386,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1094, col: 9, offset: 39992},
+						pos: position{line: 1094, col: 9, offset: 40318},
 						run: (*parser).callonEscapedMonospaceText18,
 						expr: &seqExpr{
-							pos: position{line: 1094, col: 9, offset: 39992},
+							pos: position{line: 1094, col: 9, offset: 40318},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1094, col: 9, offset: 39992},
+									pos:   position{line: 1094, col: 9, offset: 40318},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1094, col: 22, offset: 40005},
+										pos:  position{line: 1094, col: 22, offset: 40331},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1094, col: 44, offset: 40027},
+									pos:        position{line: 1094, col: 44, offset: 40353},
 									val:        "`",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1094, col: 48, offset: 40031},
+									pos:   position{line: 1094, col: 48, offset: 40357},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1094, col: 58, offset: 40041},
+										pos:  position{line: 1094, col: 58, offset: 40367},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1094, col: 92, offset: 40075},
+									pos:        position{line: 1094, col: 92, offset: 40401},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -8375,36 +8425,36 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptText",
-			pos:  position{line: 1098, col: 1, offset: 40225},
+			pos:  position{line: 1098, col: 1, offset: 40551},
 			expr: &actionExpr{
-				pos: position{line: 1098, col: 18, offset: 40242},
+				pos: position{line: 1098, col: 18, offset: 40568},
 				run: (*parser).callonSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1098, col: 18, offset: 40242},
+					pos: position{line: 1098, col: 18, offset: 40568},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1098, col: 18, offset: 40242},
+							pos: position{line: 1098, col: 18, offset: 40568},
 							expr: &litMatcher{
-								pos:        position{line: 1098, col: 19, offset: 40243},
+								pos:        position{line: 1098, col: 19, offset: 40569},
 								val:        "\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1098, col: 23, offset: 40247},
+							pos:        position{line: 1098, col: 23, offset: 40573},
 							val:        "~",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1098, col: 27, offset: 40251},
+							pos:   position{line: 1098, col: 27, offset: 40577},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1098, col: 36, offset: 40260},
+								pos:  position{line: 1098, col: 36, offset: 40586},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1098, col: 58, offset: 40282},
+							pos:        position{line: 1098, col: 58, offset: 40608},
 							val:        "~",
 							ignoreCase: false,
 						},
@@ -8414,16 +8464,16 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptTextElement",
-			pos:  position{line: 1102, col: 1, offset: 40371},
+			pos:  position{line: 1102, col: 1, offset: 40697},
 			expr: &choiceExpr{
-				pos: position{line: 1102, col: 25, offset: 40395},
+				pos: position{line: 1102, col: 25, offset: 40721},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1102, col: 25, offset: 40395},
+						pos:  position{line: 1102, col: 25, offset: 40721},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1102, col: 38, offset: 40408},
+						pos:  position{line: 1102, col: 38, offset: 40734},
 						name: "NonSubscriptText",
 					},
 				},
@@ -8431,39 +8481,39 @@ var g = &grammar{
 		},
 		{
 			name: "NonSubscriptText",
-			pos:  position{line: 1104, col: 1, offset: 40427},
+			pos:  position{line: 1104, col: 1, offset: 40753},
 			expr: &actionExpr{
-				pos: position{line: 1104, col: 21, offset: 40447},
+				pos: position{line: 1104, col: 21, offset: 40773},
 				run: (*parser).callonNonSubscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1104, col: 21, offset: 40447},
+					pos: position{line: 1104, col: 21, offset: 40773},
 					expr: &seqExpr{
-						pos: position{line: 1104, col: 22, offset: 40448},
+						pos: position{line: 1104, col: 22, offset: 40774},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1104, col: 22, offset: 40448},
+								pos: position{line: 1104, col: 22, offset: 40774},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1104, col: 23, offset: 40449},
+									pos:  position{line: 1104, col: 23, offset: 40775},
 									name: "Newline",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1104, col: 31, offset: 40457},
+								pos: position{line: 1104, col: 31, offset: 40783},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1104, col: 32, offset: 40458},
+									pos:  position{line: 1104, col: 32, offset: 40784},
 									name: "WS",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1104, col: 35, offset: 40461},
+								pos: position{line: 1104, col: 35, offset: 40787},
 								expr: &litMatcher{
-									pos:        position{line: 1104, col: 36, offset: 40462},
+									pos:        position{line: 1104, col: 36, offset: 40788},
 									val:        "~",
 									ignoreCase: false,
 								},
 							},
 							&anyMatcher{
-								line: 1104, col: 40, offset: 40466,
+								line: 1104, col: 40, offset: 40792,
 							},
 						},
 					},
@@ -8472,36 +8522,36 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSubscriptText",
-			pos:  position{line: 1108, col: 1, offset: 40499},
+			pos:  position{line: 1108, col: 1, offset: 40825},
 			expr: &actionExpr{
-				pos: position{line: 1108, col: 25, offset: 40523},
+				pos: position{line: 1108, col: 25, offset: 40849},
 				run: (*parser).callonEscapedSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1108, col: 25, offset: 40523},
+					pos: position{line: 1108, col: 25, offset: 40849},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1108, col: 25, offset: 40523},
+							pos:   position{line: 1108, col: 25, offset: 40849},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1108, col: 38, offset: 40536},
+								pos:  position{line: 1108, col: 38, offset: 40862},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1108, col: 60, offset: 40558},
+							pos:        position{line: 1108, col: 60, offset: 40884},
 							val:        "~",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1108, col: 64, offset: 40562},
+							pos:   position{line: 1108, col: 64, offset: 40888},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1108, col: 73, offset: 40571},
+								pos:  position{line: 1108, col: 73, offset: 40897},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1108, col: 95, offset: 40593},
+							pos:        position{line: 1108, col: 95, offset: 40919},
 							val:        "~",
 							ignoreCase: false,
 						},
@@ -8511,36 +8561,36 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptText",
-			pos:  position{line: 1112, col: 1, offset: 40722},
+			pos:  position{line: 1112, col: 1, offset: 41048},
 			expr: &actionExpr{
-				pos: position{line: 1112, col: 20, offset: 40741},
+				pos: position{line: 1112, col: 20, offset: 41067},
 				run: (*parser).callonSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1112, col: 20, offset: 40741},
+					pos: position{line: 1112, col: 20, offset: 41067},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1112, col: 20, offset: 40741},
+							pos: position{line: 1112, col: 20, offset: 41067},
 							expr: &litMatcher{
-								pos:        position{line: 1112, col: 21, offset: 40742},
+								pos:        position{line: 1112, col: 21, offset: 41068},
 								val:        "\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1112, col: 25, offset: 40746},
+							pos:        position{line: 1112, col: 25, offset: 41072},
 							val:        "^",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1112, col: 29, offset: 40750},
+							pos:   position{line: 1112, col: 29, offset: 41076},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1112, col: 38, offset: 40759},
+								pos:  position{line: 1112, col: 38, offset: 41085},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1112, col: 62, offset: 40783},
+							pos:        position{line: 1112, col: 62, offset: 41109},
 							val:        "^",
 							ignoreCase: false,
 						},
@@ -8550,16 +8600,16 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptTextElement",
-			pos:  position{line: 1116, col: 1, offset: 40874},
+			pos:  position{line: 1116, col: 1, offset: 41200},
 			expr: &choiceExpr{
-				pos: position{line: 1116, col: 27, offset: 40900},
+				pos: position{line: 1116, col: 27, offset: 41226},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1116, col: 27, offset: 40900},
+						pos:  position{line: 1116, col: 27, offset: 41226},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1116, col: 40, offset: 40913},
+						pos:  position{line: 1116, col: 40, offset: 41239},
 						name: "NonSuperscriptText",
 					},
 				},
@@ -8567,39 +8617,39 @@ var g = &grammar{
 		},
 		{
 			name: "NonSuperscriptText",
-			pos:  position{line: 1118, col: 1, offset: 40934},
+			pos:  position{line: 1118, col: 1, offset: 41260},
 			expr: &actionExpr{
-				pos: position{line: 1118, col: 23, offset: 40956},
+				pos: position{line: 1118, col: 23, offset: 41282},
 				run: (*parser).callonNonSuperscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1118, col: 23, offset: 40956},
+					pos: position{line: 1118, col: 23, offset: 41282},
 					expr: &seqExpr{
-						pos: position{line: 1118, col: 24, offset: 40957},
+						pos: position{line: 1118, col: 24, offset: 41283},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1118, col: 24, offset: 40957},
+								pos: position{line: 1118, col: 24, offset: 41283},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1118, col: 25, offset: 40958},
+									pos:  position{line: 1118, col: 25, offset: 41284},
 									name: "Newline",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1118, col: 33, offset: 40966},
+								pos: position{line: 1118, col: 33, offset: 41292},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1118, col: 34, offset: 40967},
+									pos:  position{line: 1118, col: 34, offset: 41293},
 									name: "WS",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1118, col: 37, offset: 40970},
+								pos: position{line: 1118, col: 37, offset: 41296},
 								expr: &litMatcher{
-									pos:        position{line: 1118, col: 38, offset: 40971},
+									pos:        position{line: 1118, col: 38, offset: 41297},
 									val:        "^",
 									ignoreCase: false,
 								},
 							},
 							&anyMatcher{
-								line: 1118, col: 42, offset: 40975,
+								line: 1118, col: 42, offset: 41301,
 							},
 						},
 					},
@@ -8608,36 +8658,36 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSuperscriptText",
-			pos:  position{line: 1122, col: 1, offset: 41008},
+			pos:  position{line: 1122, col: 1, offset: 41334},
 			expr: &actionExpr{
-				pos: position{line: 1122, col: 27, offset: 41034},
+				pos: position{line: 1122, col: 27, offset: 41360},
 				run: (*parser).callonEscapedSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1122, col: 27, offset: 41034},
+					pos: position{line: 1122, col: 27, offset: 41360},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1122, col: 27, offset: 41034},
+							pos:   position{line: 1122, col: 27, offset: 41360},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1122, col: 40, offset: 41047},
+								pos:  position{line: 1122, col: 40, offset: 41373},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1122, col: 62, offset: 41069},
+							pos:        position{line: 1122, col: 62, offset: 41395},
 							val:        "^",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1122, col: 66, offset: 41073},
+							pos:   position{line: 1122, col: 66, offset: 41399},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1122, col: 75, offset: 41082},
+								pos:  position{line: 1122, col: 75, offset: 41408},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1122, col: 99, offset: 41106},
+							pos:        position{line: 1122, col: 99, offset: 41432},
 							val:        "^",
 							ignoreCase: false,
 						},
@@ -8647,20 +8697,20 @@ var g = &grammar{
 		},
 		{
 			name: "Passthrough",
-			pos:  position{line: 1129, col: 1, offset: 41342},
+			pos:  position{line: 1129, col: 1, offset: 41668},
 			expr: &choiceExpr{
-				pos: position{line: 1129, col: 16, offset: 41357},
+				pos: position{line: 1129, col: 16, offset: 41683},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1129, col: 16, offset: 41357},
+						pos:  position{line: 1129, col: 16, offset: 41683},
 						name: "TriplePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1129, col: 40, offset: 41381},
+						pos:  position{line: 1129, col: 40, offset: 41707},
 						name: "SinglePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1129, col: 64, offset: 41405},
+						pos:  position{line: 1129, col: 64, offset: 41731},
 						name: "PassthroughMacro",
 					},
 				},
@@ -8668,42 +8718,42 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughPrefix",
-			pos:  position{line: 1131, col: 1, offset: 41423},
+			pos:  position{line: 1131, col: 1, offset: 41749},
 			expr: &litMatcher{
-				pos:        position{line: 1131, col: 32, offset: 41454},
+				pos:        position{line: 1131, col: 32, offset: 41780},
 				val:        "+",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "SinglePlusPassthrough",
-			pos:  position{line: 1133, col: 1, offset: 41459},
+			pos:  position{line: 1133, col: 1, offset: 41785},
 			expr: &actionExpr{
-				pos: position{line: 1133, col: 26, offset: 41484},
+				pos: position{line: 1133, col: 26, offset: 41810},
 				run: (*parser).callonSinglePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1133, col: 26, offset: 41484},
+					pos: position{line: 1133, col: 26, offset: 41810},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1133, col: 26, offset: 41484},
+							pos:  position{line: 1133, col: 26, offset: 41810},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1133, col: 54, offset: 41512},
+							pos:   position{line: 1133, col: 54, offset: 41838},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1133, col: 63, offset: 41521},
+								pos:  position{line: 1133, col: 63, offset: 41847},
 								name: "SinglePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1133, col: 93, offset: 41551},
+							pos:  position{line: 1133, col: 93, offset: 41877},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1133, col: 121, offset: 41579},
+							pos: position{line: 1133, col: 121, offset: 41905},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1133, col: 122, offset: 41580},
+								pos:  position{line: 1133, col: 122, offset: 41906},
 								name: "Alphanum",
 							},
 						},
@@ -8713,85 +8763,85 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughContent",
-			pos:  position{line: 1137, col: 1, offset: 41679},
+			pos:  position{line: 1137, col: 1, offset: 42005},
 			expr: &choiceExpr{
-				pos: position{line: 1137, col: 33, offset: 41711},
+				pos: position{line: 1137, col: 33, offset: 42037},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1137, col: 34, offset: 41712},
+						pos: position{line: 1137, col: 34, offset: 42038},
 						run: (*parser).callonSinglePlusPassthroughContent2,
 						expr: &seqExpr{
-							pos: position{line: 1137, col: 34, offset: 41712},
+							pos: position{line: 1137, col: 34, offset: 42038},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1137, col: 35, offset: 41713},
+									pos: position{line: 1137, col: 35, offset: 42039},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1137, col: 35, offset: 41713},
+											pos: position{line: 1137, col: 35, offset: 42039},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1137, col: 36, offset: 41714},
+												pos:  position{line: 1137, col: 36, offset: 42040},
 												name: "SinglePlusPassthroughPrefix",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1137, col: 64, offset: 41742},
+											pos: position{line: 1137, col: 64, offset: 42068},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1137, col: 65, offset: 41743},
+												pos:  position{line: 1137, col: 65, offset: 42069},
 												name: "WS",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1137, col: 68, offset: 41746},
+											pos: position{line: 1137, col: 68, offset: 42072},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1137, col: 69, offset: 41747},
+												pos:  position{line: 1137, col: 69, offset: 42073},
 												name: "Newline",
 											},
 										},
 										&anyMatcher{
-											line: 1137, col: 77, offset: 41755,
+											line: 1137, col: 77, offset: 42081,
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1137, col: 80, offset: 41758},
+									pos: position{line: 1137, col: 80, offset: 42084},
 									expr: &seqExpr{
-										pos: position{line: 1137, col: 81, offset: 41759},
+										pos: position{line: 1137, col: 81, offset: 42085},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1137, col: 81, offset: 41759},
+												pos: position{line: 1137, col: 81, offset: 42085},
 												expr: &seqExpr{
-													pos: position{line: 1137, col: 83, offset: 41761},
+													pos: position{line: 1137, col: 83, offset: 42087},
 													exprs: []interface{}{
 														&oneOrMoreExpr{
-															pos: position{line: 1137, col: 83, offset: 41761},
+															pos: position{line: 1137, col: 83, offset: 42087},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1137, col: 83, offset: 41761},
+																pos:  position{line: 1137, col: 83, offset: 42087},
 																name: "WS",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1137, col: 87, offset: 41765},
+															pos:  position{line: 1137, col: 87, offset: 42091},
 															name: "SinglePlusPassthroughPrefix",
 														},
 													},
 												},
 											},
 											&notExpr{
-												pos: position{line: 1137, col: 116, offset: 41794},
+												pos: position{line: 1137, col: 116, offset: 42120},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1137, col: 117, offset: 41795},
+													pos:  position{line: 1137, col: 117, offset: 42121},
 													name: "SinglePlusPassthroughPrefix",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1137, col: 145, offset: 41823},
+												pos: position{line: 1137, col: 145, offset: 42149},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1137, col: 146, offset: 41824},
+													pos:  position{line: 1137, col: 146, offset: 42150},
 													name: "Newline",
 												},
 											},
 											&anyMatcher{
-												line: 1137, col: 154, offset: 41832,
+												line: 1137, col: 154, offset: 42158,
 											},
 										},
 									},
@@ -8800,34 +8850,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1139, col: 7, offset: 41974},
+						pos: position{line: 1139, col: 7, offset: 42300},
 						run: (*parser).callonSinglePlusPassthroughContent24,
 						expr: &seqExpr{
-							pos: position{line: 1139, col: 8, offset: 41975},
+							pos: position{line: 1139, col: 8, offset: 42301},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1139, col: 8, offset: 41975},
+									pos: position{line: 1139, col: 8, offset: 42301},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1139, col: 9, offset: 41976},
+										pos:  position{line: 1139, col: 9, offset: 42302},
 										name: "WS",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1139, col: 12, offset: 41979},
+									pos: position{line: 1139, col: 12, offset: 42305},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1139, col: 13, offset: 41980},
+										pos:  position{line: 1139, col: 13, offset: 42306},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1139, col: 21, offset: 41988},
+									pos: position{line: 1139, col: 21, offset: 42314},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1139, col: 22, offset: 41989},
+										pos:  position{line: 1139, col: 22, offset: 42315},
 										name: "SinglePlusPassthroughPrefix",
 									},
 								},
 								&anyMatcher{
-									line: 1139, col: 50, offset: 42017,
+									line: 1139, col: 50, offset: 42343,
 								},
 							},
 						},
@@ -8837,42 +8887,42 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughPrefix",
-			pos:  position{line: 1143, col: 1, offset: 42099},
+			pos:  position{line: 1143, col: 1, offset: 42425},
 			expr: &litMatcher{
-				pos:        position{line: 1143, col: 32, offset: 42130},
+				pos:        position{line: 1143, col: 32, offset: 42456},
 				val:        "+++",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "TriplePlusPassthrough",
-			pos:  position{line: 1145, col: 1, offset: 42137},
+			pos:  position{line: 1145, col: 1, offset: 42463},
 			expr: &actionExpr{
-				pos: position{line: 1145, col: 26, offset: 42162},
+				pos: position{line: 1145, col: 26, offset: 42488},
 				run: (*parser).callonTriplePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1145, col: 26, offset: 42162},
+					pos: position{line: 1145, col: 26, offset: 42488},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1145, col: 26, offset: 42162},
+							pos:  position{line: 1145, col: 26, offset: 42488},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1145, col: 54, offset: 42190},
+							pos:   position{line: 1145, col: 54, offset: 42516},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1145, col: 63, offset: 42199},
+								pos:  position{line: 1145, col: 63, offset: 42525},
 								name: "TriplePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1145, col: 93, offset: 42229},
+							pos:  position{line: 1145, col: 93, offset: 42555},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1145, col: 121, offset: 42257},
+							pos: position{line: 1145, col: 121, offset: 42583},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1145, col: 122, offset: 42258},
+								pos:  position{line: 1145, col: 122, offset: 42584},
 								name: "Alphanum",
 							},
 						},
@@ -8882,63 +8932,63 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughContent",
-			pos:  position{line: 1149, col: 1, offset: 42357},
+			pos:  position{line: 1149, col: 1, offset: 42683},
 			expr: &choiceExpr{
-				pos: position{line: 1149, col: 33, offset: 42389},
+				pos: position{line: 1149, col: 33, offset: 42715},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1149, col: 34, offset: 42390},
+						pos: position{line: 1149, col: 34, offset: 42716},
 						run: (*parser).callonTriplePlusPassthroughContent2,
 						expr: &zeroOrMoreExpr{
-							pos: position{line: 1149, col: 34, offset: 42390},
+							pos: position{line: 1149, col: 34, offset: 42716},
 							expr: &seqExpr{
-								pos: position{line: 1149, col: 35, offset: 42391},
+								pos: position{line: 1149, col: 35, offset: 42717},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1149, col: 35, offset: 42391},
+										pos: position{line: 1149, col: 35, offset: 42717},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1149, col: 36, offset: 42392},
+											pos:  position{line: 1149, col: 36, offset: 42718},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1149, col: 64, offset: 42420,
+										line: 1149, col: 64, offset: 42746,
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1151, col: 7, offset: 42585},
+						pos: position{line: 1151, col: 7, offset: 42911},
 						run: (*parser).callonTriplePlusPassthroughContent8,
 						expr: &zeroOrOneExpr{
-							pos: position{line: 1151, col: 7, offset: 42585},
+							pos: position{line: 1151, col: 7, offset: 42911},
 							expr: &seqExpr{
-								pos: position{line: 1151, col: 8, offset: 42586},
+								pos: position{line: 1151, col: 8, offset: 42912},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1151, col: 8, offset: 42586},
+										pos: position{line: 1151, col: 8, offset: 42912},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1151, col: 9, offset: 42587},
+											pos:  position{line: 1151, col: 9, offset: 42913},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1151, col: 12, offset: 42590},
+										pos: position{line: 1151, col: 12, offset: 42916},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1151, col: 13, offset: 42591},
+											pos:  position{line: 1151, col: 13, offset: 42917},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1151, col: 21, offset: 42599},
+										pos: position{line: 1151, col: 21, offset: 42925},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1151, col: 22, offset: 42600},
+											pos:  position{line: 1151, col: 22, offset: 42926},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1151, col: 50, offset: 42628,
+										line: 1151, col: 50, offset: 42954,
 									},
 								},
 							},
@@ -8949,34 +8999,34 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacro",
-			pos:  position{line: 1155, col: 1, offset: 42711},
+			pos:  position{line: 1155, col: 1, offset: 43037},
 			expr: &choiceExpr{
-				pos: position{line: 1155, col: 21, offset: 42731},
+				pos: position{line: 1155, col: 21, offset: 43057},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1155, col: 21, offset: 42731},
+						pos: position{line: 1155, col: 21, offset: 43057},
 						run: (*parser).callonPassthroughMacro2,
 						expr: &seqExpr{
-							pos: position{line: 1155, col: 21, offset: 42731},
+							pos: position{line: 1155, col: 21, offset: 43057},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1155, col: 21, offset: 42731},
+									pos:        position{line: 1155, col: 21, offset: 43057},
 									val:        "pass:[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1155, col: 30, offset: 42740},
+									pos:   position{line: 1155, col: 30, offset: 43066},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1155, col: 38, offset: 42748},
+										pos: position{line: 1155, col: 38, offset: 43074},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1155, col: 39, offset: 42749},
+											pos:  position{line: 1155, col: 39, offset: 43075},
 											name: "PassthroughMacroCharacter",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1155, col: 67, offset: 42777},
+									pos:        position{line: 1155, col: 67, offset: 43103},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -8984,30 +9034,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1157, col: 5, offset: 42867},
+						pos: position{line: 1157, col: 5, offset: 43193},
 						run: (*parser).callonPassthroughMacro9,
 						expr: &seqExpr{
-							pos: position{line: 1157, col: 5, offset: 42867},
+							pos: position{line: 1157, col: 5, offset: 43193},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1157, col: 5, offset: 42867},
+									pos:        position{line: 1157, col: 5, offset: 43193},
 									val:        "pass:q[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1157, col: 15, offset: 42877},
+									pos:   position{line: 1157, col: 15, offset: 43203},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1157, col: 23, offset: 42885},
+										pos: position{line: 1157, col: 23, offset: 43211},
 										expr: &choiceExpr{
-											pos: position{line: 1157, col: 24, offset: 42886},
+											pos: position{line: 1157, col: 24, offset: 43212},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1157, col: 24, offset: 42886},
+													pos:  position{line: 1157, col: 24, offset: 43212},
 													name: "QuotedText",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1157, col: 37, offset: 42899},
+													pos:  position{line: 1157, col: 37, offset: 43225},
 													name: "PassthroughMacroCharacter",
 												},
 											},
@@ -9015,7 +9065,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1157, col: 65, offset: 42927},
+									pos:        position{line: 1157, col: 65, offset: 43253},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -9027,34 +9077,34 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacroCharacter",
-			pos:  position{line: 1161, col: 1, offset: 43017},
+			pos:  position{line: 1161, col: 1, offset: 43343},
 			expr: &choiceExpr{
-				pos: position{line: 1161, col: 31, offset: 43047},
+				pos: position{line: 1161, col: 31, offset: 43373},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1161, col: 31, offset: 43047},
+						pos:  position{line: 1161, col: 31, offset: 43373},
 						name: "Alphanums",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1161, col: 43, offset: 43059},
+						pos:  position{line: 1161, col: 43, offset: 43385},
 						name: "Spaces",
 					},
 					&actionExpr{
-						pos: position{line: 1161, col: 52, offset: 43068},
+						pos: position{line: 1161, col: 52, offset: 43394},
 						run: (*parser).callonPassthroughMacroCharacter4,
 						expr: &seqExpr{
-							pos: position{line: 1161, col: 53, offset: 43069},
+							pos: position{line: 1161, col: 53, offset: 43395},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1161, col: 53, offset: 43069},
+									pos: position{line: 1161, col: 53, offset: 43395},
 									expr: &litMatcher{
-										pos:        position{line: 1161, col: 54, offset: 43070},
+										pos:        position{line: 1161, col: 54, offset: 43396},
 										val:        "]",
 										ignoreCase: false,
 									},
 								},
 								&anyMatcher{
-									line: 1161, col: 58, offset: 43074,
+									line: 1161, col: 58, offset: 43400,
 								},
 							},
 						},
@@ -9064,16 +9114,16 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReference",
-			pos:  position{line: 1168, col: 1, offset: 43244},
+			pos:  position{line: 1168, col: 1, offset: 43570},
 			expr: &choiceExpr{
-				pos: position{line: 1168, col: 19, offset: 43262},
+				pos: position{line: 1168, col: 19, offset: 43588},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1168, col: 19, offset: 43262},
+						pos:  position{line: 1168, col: 19, offset: 43588},
 						name: "InternalCrossReference",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1168, col: 44, offset: 43287},
+						pos:  position{line: 1168, col: 44, offset: 43613},
 						name: "ExternalCrossReference",
 					},
 				},
@@ -9081,51 +9131,51 @@ var g = &grammar{
 		},
 		{
 			name: "InternalCrossReference",
-			pos:  position{line: 1170, col: 1, offset: 43312},
+			pos:  position{line: 1170, col: 1, offset: 43638},
 			expr: &choiceExpr{
-				pos: position{line: 1170, col: 27, offset: 43338},
+				pos: position{line: 1170, col: 27, offset: 43664},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1170, col: 27, offset: 43338},
+						pos: position{line: 1170, col: 27, offset: 43664},
 						run: (*parser).callonInternalCrossReference2,
 						expr: &seqExpr{
-							pos: position{line: 1170, col: 27, offset: 43338},
+							pos: position{line: 1170, col: 27, offset: 43664},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1170, col: 27, offset: 43338},
+									pos:        position{line: 1170, col: 27, offset: 43664},
 									val:        "<<",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1170, col: 32, offset: 43343},
+									pos:   position{line: 1170, col: 32, offset: 43669},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1170, col: 36, offset: 43347},
+										pos:  position{line: 1170, col: 36, offset: 43673},
 										name: "ID",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1170, col: 40, offset: 43351},
+									pos: position{line: 1170, col: 40, offset: 43677},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1170, col: 40, offset: 43351},
+										pos:  position{line: 1170, col: 40, offset: 43677},
 										name: "WS",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1170, col: 44, offset: 43355},
+									pos:        position{line: 1170, col: 44, offset: 43681},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1170, col: 48, offset: 43359},
+									pos:   position{line: 1170, col: 48, offset: 43685},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1170, col: 55, offset: 43366},
+										pos:  position{line: 1170, col: 55, offset: 43692},
 										name: "CrossReferenceLabel",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1170, col: 76, offset: 43387},
+									pos:        position{line: 1170, col: 76, offset: 43713},
 									val:        ">>",
 									ignoreCase: false,
 								},
@@ -9133,26 +9183,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1172, col: 5, offset: 43470},
+						pos: position{line: 1172, col: 5, offset: 43796},
 						run: (*parser).callonInternalCrossReference13,
 						expr: &seqExpr{
-							pos: position{line: 1172, col: 5, offset: 43470},
+							pos: position{line: 1172, col: 5, offset: 43796},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1172, col: 5, offset: 43470},
+									pos:        position{line: 1172, col: 5, offset: 43796},
 									val:        "<<",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1172, col: 10, offset: 43475},
+									pos:   position{line: 1172, col: 10, offset: 43801},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1172, col: 14, offset: 43479},
+										pos:  position{line: 1172, col: 14, offset: 43805},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1172, col: 18, offset: 43483},
+									pos:        position{line: 1172, col: 18, offset: 43809},
 									val:        ">>",
 									ignoreCase: false,
 								},
@@ -9164,31 +9214,31 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalCrossReference",
-			pos:  position{line: 1176, col: 1, offset: 43555},
+			pos:  position{line: 1176, col: 1, offset: 43881},
 			expr: &actionExpr{
-				pos: position{line: 1176, col: 27, offset: 43581},
+				pos: position{line: 1176, col: 27, offset: 43907},
 				run: (*parser).callonExternalCrossReference1,
 				expr: &seqExpr{
-					pos: position{line: 1176, col: 27, offset: 43581},
+					pos: position{line: 1176, col: 27, offset: 43907},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1176, col: 27, offset: 43581},
+							pos:        position{line: 1176, col: 27, offset: 43907},
 							val:        "xref:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1176, col: 35, offset: 43589},
+							pos:   position{line: 1176, col: 35, offset: 43915},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1176, col: 40, offset: 43594},
+								pos:  position{line: 1176, col: 40, offset: 43920},
 								name: "FileLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1176, col: 54, offset: 43608},
+							pos:   position{line: 1176, col: 54, offset: 43934},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1176, col: 72, offset: 43626},
+								pos:  position{line: 1176, col: 72, offset: 43952},
 								name: "LinkAttributes",
 							},
 						},
@@ -9198,36 +9248,36 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReferenceLabel",
-			pos:  position{line: 1180, col: 1, offset: 43756},
+			pos:  position{line: 1180, col: 1, offset: 44082},
 			expr: &actionExpr{
-				pos: position{line: 1180, col: 24, offset: 43779},
+				pos: position{line: 1180, col: 24, offset: 44105},
 				run: (*parser).callonCrossReferenceLabel1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1180, col: 24, offset: 43779},
+					pos: position{line: 1180, col: 24, offset: 44105},
 					expr: &choiceExpr{
-						pos: position{line: 1180, col: 25, offset: 43780},
+						pos: position{line: 1180, col: 25, offset: 44106},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1180, col: 25, offset: 43780},
+								pos:  position{line: 1180, col: 25, offset: 44106},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1180, col: 37, offset: 43792},
+								pos:  position{line: 1180, col: 37, offset: 44118},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1180, col: 47, offset: 43802},
+								pos: position{line: 1180, col: 47, offset: 44128},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1180, col: 47, offset: 43802},
+										pos: position{line: 1180, col: 47, offset: 44128},
 										expr: &litMatcher{
-											pos:        position{line: 1180, col: 48, offset: 43803},
+											pos:        position{line: 1180, col: 48, offset: 44129},
 											val:        ">>",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1180, col: 54, offset: 43809,
+										line: 1180, col: 54, offset: 44135,
 									},
 								},
 							},
@@ -9238,16 +9288,16 @@ var g = &grammar{
 		},
 		{
 			name: "Link",
-			pos:  position{line: 1187, col: 1, offset: 43951},
+			pos:  position{line: 1187, col: 1, offset: 44277},
 			expr: &choiceExpr{
-				pos: position{line: 1187, col: 9, offset: 43959},
+				pos: position{line: 1187, col: 9, offset: 44285},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1187, col: 9, offset: 43959},
+						pos:  position{line: 1187, col: 9, offset: 44285},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1187, col: 24, offset: 43974},
+						pos:  position{line: 1187, col: 24, offset: 44300},
 						name: "ExternalLink",
 					},
 				},
@@ -9255,40 +9305,40 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeLink",
-			pos:  position{line: 1190, col: 1, offset: 44055},
+			pos:  position{line: 1190, col: 1, offset: 44381},
 			expr: &actionExpr{
-				pos: position{line: 1190, col: 17, offset: 44071},
+				pos: position{line: 1190, col: 17, offset: 44397},
 				run: (*parser).callonRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1190, col: 17, offset: 44071},
+					pos: position{line: 1190, col: 17, offset: 44397},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1190, col: 17, offset: 44071},
+							pos:        position{line: 1190, col: 17, offset: 44397},
 							val:        "link:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1190, col: 25, offset: 44079},
+							pos:   position{line: 1190, col: 25, offset: 44405},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1190, col: 30, offset: 44084},
+								pos: position{line: 1190, col: 30, offset: 44410},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1190, col: 30, offset: 44084},
+										pos:  position{line: 1190, col: 30, offset: 44410},
 										name: "Location",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1190, col: 41, offset: 44095},
+										pos:  position{line: 1190, col: 41, offset: 44421},
 										name: "FileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1190, col: 55, offset: 44109},
+							pos:   position{line: 1190, col: 55, offset: 44435},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1190, col: 73, offset: 44127},
+								pos:  position{line: 1190, col: 73, offset: 44453},
 								name: "LinkAttributes",
 							},
 						},
@@ -9298,28 +9348,28 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalLink",
-			pos:  position{line: 1194, col: 1, offset: 44245},
+			pos:  position{line: 1194, col: 1, offset: 44571},
 			expr: &actionExpr{
-				pos: position{line: 1194, col: 17, offset: 44261},
+				pos: position{line: 1194, col: 17, offset: 44587},
 				run: (*parser).callonExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1194, col: 17, offset: 44261},
+					pos: position{line: 1194, col: 17, offset: 44587},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1194, col: 17, offset: 44261},
+							pos:   position{line: 1194, col: 17, offset: 44587},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1194, col: 22, offset: 44266},
+								pos:  position{line: 1194, col: 22, offset: 44592},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1194, col: 32, offset: 44276},
+							pos:   position{line: 1194, col: 32, offset: 44602},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1194, col: 49, offset: 44293},
+								pos: position{line: 1194, col: 49, offset: 44619},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1194, col: 50, offset: 44294},
+									pos:  position{line: 1194, col: 50, offset: 44620},
 									name: "LinkAttributes",
 								},
 							},
@@ -9330,16 +9380,16 @@ var g = &grammar{
 		},
 		{
 			name: "LinkAttributes",
-			pos:  position{line: 1198, col: 1, offset: 44387},
+			pos:  position{line: 1198, col: 1, offset: 44713},
 			expr: &choiceExpr{
-				pos: position{line: 1198, col: 19, offset: 44405},
+				pos: position{line: 1198, col: 19, offset: 44731},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1198, col: 19, offset: 44405},
+						pos:  position{line: 1198, col: 19, offset: 44731},
 						name: "TextOnlyLinkAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1198, col: 44, offset: 44430},
+						pos:  position{line: 1198, col: 44, offset: 44756},
 						name: "TextAndMoreLinkAttributes",
 					},
 				},
@@ -9347,78 +9397,78 @@ var g = &grammar{
 		},
 		{
 			name: "TextOnlyLinkAttributes",
-			pos:  position{line: 1200, col: 1, offset: 44457},
+			pos:  position{line: 1200, col: 1, offset: 44783},
 			expr: &actionExpr{
-				pos: position{line: 1200, col: 27, offset: 44483},
+				pos: position{line: 1200, col: 27, offset: 44809},
 				run: (*parser).callonTextOnlyLinkAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1200, col: 27, offset: 44483},
+					pos: position{line: 1200, col: 27, offset: 44809},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1200, col: 27, offset: 44483},
+							pos:        position{line: 1200, col: 27, offset: 44809},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1200, col: 31, offset: 44487},
+							pos:   position{line: 1200, col: 31, offset: 44813},
 							label: "text",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1200, col: 36, offset: 44492},
+								pos: position{line: 1200, col: 36, offset: 44818},
 								expr: &choiceExpr{
-									pos: position{line: 1202, col: 5, offset: 44535},
+									pos: position{line: 1202, col: 5, offset: 44861},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 1202, col: 5, offset: 44535},
+											pos: position{line: 1202, col: 5, offset: 44861},
 											run: (*parser).callonTextOnlyLinkAttributes7,
 											expr: &seqExpr{
-												pos: position{line: 1202, col: 5, offset: 44535},
+												pos: position{line: 1202, col: 5, offset: 44861},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 1202, col: 5, offset: 44535},
+														pos:        position{line: 1202, col: 5, offset: 44861},
 														val:        "\"",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 1202, col: 10, offset: 44540},
+														pos:   position{line: 1202, col: 10, offset: 44866},
 														label: "elements",
 														expr: &oneOrMoreExpr{
-															pos: position{line: 1202, col: 19, offset: 44549},
+															pos: position{line: 1202, col: 19, offset: 44875},
 															expr: &seqExpr{
-																pos: position{line: 1202, col: 20, offset: 44550},
+																pos: position{line: 1202, col: 20, offset: 44876},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1202, col: 20, offset: 44550},
+																		pos: position{line: 1202, col: 20, offset: 44876},
 																		expr: &litMatcher{
-																			pos:        position{line: 1202, col: 21, offset: 44551},
+																			pos:        position{line: 1202, col: 21, offset: 44877},
 																			val:        "\"",
 																			ignoreCase: false,
 																		},
 																	},
 																	&notExpr{
-																		pos: position{line: 1202, col: 26, offset: 44556},
+																		pos: position{line: 1202, col: 26, offset: 44882},
 																		expr: &litMatcher{
-																			pos:        position{line: 1202, col: 27, offset: 44557},
+																			pos:        position{line: 1202, col: 27, offset: 44883},
 																			val:        "]",
 																			ignoreCase: false,
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 1202, col: 32, offset: 44562},
+																		pos: position{line: 1202, col: 32, offset: 44888},
 																		alternatives: []interface{}{
 																			&ruleRefExpr{
-																				pos:  position{line: 1202, col: 32, offset: 44562},
+																				pos:  position{line: 1202, col: 32, offset: 44888},
 																				name: "SimpleWord",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1202, col: 45, offset: 44575},
+																				pos:  position{line: 1202, col: 45, offset: 44901},
 																				name: "Spaces",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1202, col: 54, offset: 44584},
+																				pos:  position{line: 1202, col: 54, offset: 44910},
 																				name: "QuotedText",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1202, col: 67, offset: 44597},
+																				pos:  position{line: 1202, col: 67, offset: 44923},
 																				name: "AnyChar",
 																			},
 																		},
@@ -9428,23 +9478,23 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 1202, col: 78, offset: 44608},
+														pos:        position{line: 1202, col: 78, offset: 44934},
 														val:        "\"",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 1202, col: 83, offset: 44613},
+														pos: position{line: 1202, col: 83, offset: 44939},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1202, col: 83, offset: 44613},
+															pos:  position{line: 1202, col: 83, offset: 44939},
 															name: "Spaces",
 														},
 													},
 													&andExpr{
-														pos: position{line: 1202, col: 91, offset: 44621},
+														pos: position{line: 1202, col: 91, offset: 44947},
 														expr: &notExpr{
-															pos: position{line: 1202, col: 93, offset: 44623},
+															pos: position{line: 1202, col: 93, offset: 44949},
 															expr: &litMatcher{
-																pos:        position{line: 1202, col: 94, offset: 44624},
+																pos:        position{line: 1202, col: 94, offset: 44950},
 																val:        "=",
 																ignoreCase: false,
 															},
@@ -9454,52 +9504,52 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 1206, col: 5, offset: 44749},
+											pos: position{line: 1206, col: 5, offset: 45075},
 											run: (*parser).callonTextOnlyLinkAttributes28,
 											expr: &seqExpr{
-												pos: position{line: 1206, col: 5, offset: 44749},
+												pos: position{line: 1206, col: 5, offset: 45075},
 												exprs: []interface{}{
 													&labeledExpr{
-														pos:   position{line: 1206, col: 5, offset: 44749},
+														pos:   position{line: 1206, col: 5, offset: 45075},
 														label: "elements",
 														expr: &oneOrMoreExpr{
-															pos: position{line: 1206, col: 14, offset: 44758},
+															pos: position{line: 1206, col: 14, offset: 45084},
 															expr: &seqExpr{
-																pos: position{line: 1206, col: 15, offset: 44759},
+																pos: position{line: 1206, col: 15, offset: 45085},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1206, col: 15, offset: 44759},
+																		pos: position{line: 1206, col: 15, offset: 45085},
 																		expr: &litMatcher{
-																			pos:        position{line: 1206, col: 16, offset: 44760},
+																			pos:        position{line: 1206, col: 16, offset: 45086},
 																			val:        "=",
 																			ignoreCase: false,
 																		},
 																	},
 																	&notExpr{
-																		pos: position{line: 1206, col: 20, offset: 44764},
+																		pos: position{line: 1206, col: 20, offset: 45090},
 																		expr: &litMatcher{
-																			pos:        position{line: 1206, col: 21, offset: 44765},
+																			pos:        position{line: 1206, col: 21, offset: 45091},
 																			val:        "]",
 																			ignoreCase: false,
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 1206, col: 26, offset: 44770},
+																		pos: position{line: 1206, col: 26, offset: 45096},
 																		alternatives: []interface{}{
 																			&ruleRefExpr{
-																				pos:  position{line: 1206, col: 26, offset: 44770},
+																				pos:  position{line: 1206, col: 26, offset: 45096},
 																				name: "SimpleWord",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1206, col: 39, offset: 44783},
+																				pos:  position{line: 1206, col: 39, offset: 45109},
 																				name: "Spaces",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1206, col: 48, offset: 44792},
+																				pos:  position{line: 1206, col: 48, offset: 45118},
 																				name: "QuotedText",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1206, col: 61, offset: 44805},
+																				pos:  position{line: 1206, col: 61, offset: 45131},
 																				name: "AnyChar",
 																			},
 																		},
@@ -9509,11 +9559,11 @@ var g = &grammar{
 														},
 													},
 													&andExpr{
-														pos: position{line: 1206, col: 72, offset: 44816},
+														pos: position{line: 1206, col: 72, offset: 45142},
 														expr: &notExpr{
-															pos: position{line: 1206, col: 74, offset: 44818},
+															pos: position{line: 1206, col: 74, offset: 45144},
 															expr: &litMatcher{
-																pos:        position{line: 1206, col: 75, offset: 44819},
+																pos:        position{line: 1206, col: 75, offset: 45145},
 																val:        "=",
 																ignoreCase: false,
 															},
@@ -9527,7 +9577,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1208, col: 9, offset: 44899},
+							pos:        position{line: 1208, col: 9, offset: 45225},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -9537,78 +9587,78 @@ var g = &grammar{
 		},
 		{
 			name: "TextAndMoreLinkAttributes",
-			pos:  position{line: 1212, col: 1, offset: 44965},
+			pos:  position{line: 1212, col: 1, offset: 45291},
 			expr: &actionExpr{
-				pos: position{line: 1212, col: 30, offset: 44994},
+				pos: position{line: 1212, col: 30, offset: 45320},
 				run: (*parser).callonTextAndMoreLinkAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1212, col: 30, offset: 44994},
+					pos: position{line: 1212, col: 30, offset: 45320},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1212, col: 30, offset: 44994},
+							pos:        position{line: 1212, col: 30, offset: 45320},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1212, col: 34, offset: 44998},
+							pos:   position{line: 1212, col: 34, offset: 45324},
 							label: "text",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1212, col: 39, offset: 45003},
+								pos: position{line: 1212, col: 39, offset: 45329},
 								expr: &choiceExpr{
-									pos: position{line: 1214, col: 5, offset: 45046},
+									pos: position{line: 1214, col: 5, offset: 45372},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 1214, col: 5, offset: 45046},
+											pos: position{line: 1214, col: 5, offset: 45372},
 											run: (*parser).callonTextAndMoreLinkAttributes7,
 											expr: &seqExpr{
-												pos: position{line: 1214, col: 5, offset: 45046},
+												pos: position{line: 1214, col: 5, offset: 45372},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 1214, col: 5, offset: 45046},
+														pos:        position{line: 1214, col: 5, offset: 45372},
 														val:        "\"",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 1214, col: 10, offset: 45051},
+														pos:   position{line: 1214, col: 10, offset: 45377},
 														label: "elements",
 														expr: &oneOrMoreExpr{
-															pos: position{line: 1214, col: 19, offset: 45060},
+															pos: position{line: 1214, col: 19, offset: 45386},
 															expr: &seqExpr{
-																pos: position{line: 1214, col: 20, offset: 45061},
+																pos: position{line: 1214, col: 20, offset: 45387},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1214, col: 20, offset: 45061},
+																		pos: position{line: 1214, col: 20, offset: 45387},
 																		expr: &litMatcher{
-																			pos:        position{line: 1214, col: 21, offset: 45062},
+																			pos:        position{line: 1214, col: 21, offset: 45388},
 																			val:        "\"",
 																			ignoreCase: false,
 																		},
 																	},
 																	&notExpr{
-																		pos: position{line: 1214, col: 26, offset: 45067},
+																		pos: position{line: 1214, col: 26, offset: 45393},
 																		expr: &litMatcher{
-																			pos:        position{line: 1214, col: 27, offset: 45068},
+																			pos:        position{line: 1214, col: 27, offset: 45394},
 																			val:        "]",
 																			ignoreCase: false,
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 1214, col: 32, offset: 45073},
+																		pos: position{line: 1214, col: 32, offset: 45399},
 																		alternatives: []interface{}{
 																			&ruleRefExpr{
-																				pos:  position{line: 1214, col: 32, offset: 45073},
+																				pos:  position{line: 1214, col: 32, offset: 45399},
 																				name: "SimpleWord",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1214, col: 45, offset: 45086},
+																				pos:  position{line: 1214, col: 45, offset: 45412},
 																				name: "Spaces",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1214, col: 54, offset: 45095},
+																				pos:  position{line: 1214, col: 54, offset: 45421},
 																				name: "QuotedText",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1214, col: 67, offset: 45108},
+																				pos:  position{line: 1214, col: 67, offset: 45434},
 																				name: "AnyChar",
 																			},
 																		},
@@ -9618,16 +9668,16 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 1214, col: 78, offset: 45119},
+														pos:        position{line: 1214, col: 78, offset: 45445},
 														val:        "\"",
 														ignoreCase: false,
 													},
 													&andExpr{
-														pos: position{line: 1214, col: 83, offset: 45124},
+														pos: position{line: 1214, col: 83, offset: 45450},
 														expr: &notExpr{
-															pos: position{line: 1214, col: 85, offset: 45126},
+															pos: position{line: 1214, col: 85, offset: 45452},
 															expr: &litMatcher{
-																pos:        position{line: 1214, col: 86, offset: 45127},
+																pos:        position{line: 1214, col: 86, offset: 45453},
 																val:        "=",
 																ignoreCase: false,
 															},
@@ -9637,68 +9687,68 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 1218, col: 5, offset: 45252},
+											pos: position{line: 1218, col: 5, offset: 45578},
 											run: (*parser).callonTextAndMoreLinkAttributes26,
 											expr: &seqExpr{
-												pos: position{line: 1218, col: 5, offset: 45252},
+												pos: position{line: 1218, col: 5, offset: 45578},
 												exprs: []interface{}{
 													&labeledExpr{
-														pos:   position{line: 1218, col: 5, offset: 45252},
+														pos:   position{line: 1218, col: 5, offset: 45578},
 														label: "elements",
 														expr: &oneOrMoreExpr{
-															pos: position{line: 1218, col: 14, offset: 45261},
+															pos: position{line: 1218, col: 14, offset: 45587},
 															expr: &seqExpr{
-																pos: position{line: 1218, col: 15, offset: 45262},
+																pos: position{line: 1218, col: 15, offset: 45588},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1218, col: 15, offset: 45262},
+																		pos: position{line: 1218, col: 15, offset: 45588},
 																		expr: &litMatcher{
-																			pos:        position{line: 1218, col: 16, offset: 45263},
+																			pos:        position{line: 1218, col: 16, offset: 45589},
 																			val:        ",",
 																			ignoreCase: false,
 																		},
 																	},
 																	&notExpr{
-																		pos: position{line: 1218, col: 20, offset: 45267},
+																		pos: position{line: 1218, col: 20, offset: 45593},
 																		expr: &litMatcher{
-																			pos:        position{line: 1218, col: 21, offset: 45268},
+																			pos:        position{line: 1218, col: 21, offset: 45594},
 																			val:        "=",
 																			ignoreCase: false,
 																		},
 																	},
 																	&notExpr{
-																		pos: position{line: 1218, col: 25, offset: 45272},
+																		pos: position{line: 1218, col: 25, offset: 45598},
 																		expr: &litMatcher{
-																			pos:        position{line: 1218, col: 26, offset: 45273},
+																			pos:        position{line: 1218, col: 26, offset: 45599},
 																			val:        "]",
 																			ignoreCase: false,
 																		},
 																	},
 																	&notExpr{
-																		pos: position{line: 1218, col: 30, offset: 45277},
+																		pos: position{line: 1218, col: 30, offset: 45603},
 																		expr: &litMatcher{
-																			pos:        position{line: 1218, col: 31, offset: 45278},
+																			pos:        position{line: 1218, col: 31, offset: 45604},
 																			val:        "\"",
 																			ignoreCase: false,
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 1218, col: 37, offset: 45284},
+																		pos: position{line: 1218, col: 37, offset: 45610},
 																		alternatives: []interface{}{
 																			&ruleRefExpr{
-																				pos:  position{line: 1218, col: 37, offset: 45284},
+																				pos:  position{line: 1218, col: 37, offset: 45610},
 																				name: "SimpleWord",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1218, col: 50, offset: 45297},
+																				pos:  position{line: 1218, col: 50, offset: 45623},
 																				name: "Spaces",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1218, col: 59, offset: 45306},
+																				pos:  position{line: 1218, col: 59, offset: 45632},
 																				name: "QuotedText",
 																			},
 																			&ruleRefExpr{
-																				pos:  position{line: 1218, col: 72, offset: 45319},
+																				pos:  position{line: 1218, col: 72, offset: 45645},
 																				name: "AnyChar",
 																			},
 																		},
@@ -9708,11 +9758,11 @@ var g = &grammar{
 														},
 													},
 													&andExpr{
-														pos: position{line: 1218, col: 83, offset: 45330},
+														pos: position{line: 1218, col: 83, offset: 45656},
 														expr: &notExpr{
-															pos: position{line: 1218, col: 85, offset: 45332},
+															pos: position{line: 1218, col: 85, offset: 45658},
 															expr: &litMatcher{
-																pos:        position{line: 1218, col: 86, offset: 45333},
+																pos:        position{line: 1218, col: 86, offset: 45659},
 																val:        "=",
 																ignoreCase: false,
 															},
@@ -9726,33 +9776,33 @@ var g = &grammar{
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1220, col: 9, offset: 45413},
+							pos: position{line: 1220, col: 9, offset: 45739},
 							expr: &litMatcher{
-								pos:        position{line: 1220, col: 9, offset: 45413},
+								pos:        position{line: 1220, col: 9, offset: 45739},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1220, col: 14, offset: 45418},
+							pos: position{line: 1220, col: 14, offset: 45744},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1220, col: 14, offset: 45418},
+								pos:  position{line: 1220, col: 14, offset: 45744},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1220, col: 18, offset: 45422},
+							pos:   position{line: 1220, col: 18, offset: 45748},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1220, col: 29, offset: 45433},
+								pos: position{line: 1220, col: 29, offset: 45759},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1220, col: 30, offset: 45434},
+									pos:  position{line: 1220, col: 30, offset: 45760},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1220, col: 49, offset: 45453},
+							pos:        position{line: 1220, col: 49, offset: 45779},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -9762,47 +9812,47 @@ var g = &grammar{
 		},
 		{
 			name: "InlineLinks",
-			pos:  position{line: 1225, col: 1, offset: 45609},
+			pos:  position{line: 1225, col: 1, offset: 45935},
 			expr: &actionExpr{
-				pos: position{line: 1226, col: 5, offset: 45629},
+				pos: position{line: 1226, col: 5, offset: 45955},
 				run: (*parser).callonInlineLinks1,
 				expr: &seqExpr{
-					pos: position{line: 1226, col: 5, offset: 45629},
+					pos: position{line: 1226, col: 5, offset: 45955},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1226, col: 5, offset: 45629},
+							pos:   position{line: 1226, col: 5, offset: 45955},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1226, col: 14, offset: 45638},
+								pos: position{line: 1226, col: 14, offset: 45964},
 								expr: &choiceExpr{
-									pos: position{line: 1226, col: 15, offset: 45639},
+									pos: position{line: 1226, col: 15, offset: 45965},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1226, col: 15, offset: 45639},
+											pos:  position{line: 1226, col: 15, offset: 45965},
 											name: "SimpleWord",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1227, col: 11, offset: 45660},
+											pos:  position{line: 1227, col: 11, offset: 45986},
 											name: "Spaces",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1228, col: 11, offset: 45678},
+											pos:  position{line: 1228, col: 11, offset: 46004},
 											name: "ResolvedLink",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1229, col: 11, offset: 45702},
+											pos:  position{line: 1229, col: 11, offset: 46028},
 											name: "Parenthesis",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1230, col: 11, offset: 45724},
+											pos:  position{line: 1230, col: 11, offset: 46050},
 											name: "AnyChars",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1231, col: 11, offset: 45743},
+											pos:  position{line: 1231, col: 11, offset: 46069},
 											name: "AnyChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1232, col: 11, offset: 45761},
+											pos:  position{line: 1232, col: 11, offset: 46087},
 											name: "Newline",
 										},
 									},
@@ -9810,7 +9860,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1232, col: 21, offset: 45771},
+							pos:  position{line: 1232, col: 21, offset: 46097},
 							name: "EOF",
 						},
 					},
@@ -9819,16 +9869,16 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLink",
-			pos:  position{line: 1236, col: 1, offset: 45841},
+			pos:  position{line: 1236, col: 1, offset: 46167},
 			expr: &choiceExpr{
-				pos: position{line: 1236, col: 17, offset: 45857},
+				pos: position{line: 1236, col: 17, offset: 46183},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1236, col: 17, offset: 45857},
+						pos:  position{line: 1236, col: 17, offset: 46183},
 						name: "ResolvedRelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1236, col: 40, offset: 45880},
+						pos:  position{line: 1236, col: 40, offset: 46206},
 						name: "ResolvedExternalLink",
 					},
 				},
@@ -9836,40 +9886,40 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedRelativeLink",
-			pos:  position{line: 1239, col: 1, offset: 46016},
+			pos:  position{line: 1239, col: 1, offset: 46342},
 			expr: &actionExpr{
-				pos: position{line: 1239, col: 25, offset: 46040},
+				pos: position{line: 1239, col: 25, offset: 46366},
 				run: (*parser).callonResolvedRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1239, col: 25, offset: 46040},
+					pos: position{line: 1239, col: 25, offset: 46366},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1239, col: 25, offset: 46040},
+							pos:        position{line: 1239, col: 25, offset: 46366},
 							val:        "link:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1239, col: 33, offset: 46048},
+							pos:   position{line: 1239, col: 33, offset: 46374},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1239, col: 38, offset: 46053},
+								pos: position{line: 1239, col: 38, offset: 46379},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1239, col: 38, offset: 46053},
+										pos:  position{line: 1239, col: 38, offset: 46379},
 										name: "ResolvedLocation",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1239, col: 57, offset: 46072},
+										pos:  position{line: 1239, col: 57, offset: 46398},
 										name: "ResolvedFileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1239, col: 79, offset: 46094},
+							pos:   position{line: 1239, col: 79, offset: 46420},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1239, col: 97, offset: 46112},
+								pos:  position{line: 1239, col: 97, offset: 46438},
 								name: "LinkAttributes",
 							},
 						},
@@ -9879,28 +9929,28 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedExternalLink",
-			pos:  position{line: 1243, col: 1, offset: 46230},
+			pos:  position{line: 1243, col: 1, offset: 46556},
 			expr: &actionExpr{
-				pos: position{line: 1243, col: 25, offset: 46254},
+				pos: position{line: 1243, col: 25, offset: 46580},
 				run: (*parser).callonResolvedExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1243, col: 25, offset: 46254},
+					pos: position{line: 1243, col: 25, offset: 46580},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1243, col: 25, offset: 46254},
+							pos:   position{line: 1243, col: 25, offset: 46580},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1243, col: 30, offset: 46259},
+								pos:  position{line: 1243, col: 30, offset: 46585},
 								name: "ResolvedLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1243, col: 48, offset: 46277},
+							pos:   position{line: 1243, col: 48, offset: 46603},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1243, col: 65, offset: 46294},
+								pos: position{line: 1243, col: 65, offset: 46620},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1243, col: 66, offset: 46295},
+									pos:  position{line: 1243, col: 66, offset: 46621},
 									name: "LinkAttributes",
 								},
 							},
@@ -9911,16 +9961,16 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedLink",
-			pos:  position{line: 1247, col: 1, offset: 46388},
+			pos:  position{line: 1247, col: 1, offset: 46714},
 			expr: &choiceExpr{
-				pos: position{line: 1247, col: 15, offset: 46402},
+				pos: position{line: 1247, col: 15, offset: 46728},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 15, offset: 46402},
+						pos:  position{line: 1247, col: 15, offset: 46728},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 30, offset: 46417},
+						pos:  position{line: 1247, col: 30, offset: 46743},
 						name: "ExternalQuotedLink",
 					},
 				},
@@ -9928,26 +9978,26 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalQuotedLink",
-			pos:  position{line: 1249, col: 1, offset: 46437},
+			pos:  position{line: 1249, col: 1, offset: 46763},
 			expr: &actionExpr{
-				pos: position{line: 1249, col: 23, offset: 46459},
+				pos: position{line: 1249, col: 23, offset: 46785},
 				run: (*parser).callonExternalQuotedLink1,
 				expr: &seqExpr{
-					pos: position{line: 1249, col: 23, offset: 46459},
+					pos: position{line: 1249, col: 23, offset: 46785},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1249, col: 23, offset: 46459},
+							pos:   position{line: 1249, col: 23, offset: 46785},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1249, col: 28, offset: 46464},
+								pos:  position{line: 1249, col: 28, offset: 46790},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1249, col: 38, offset: 46474},
+							pos:   position{line: 1249, col: 38, offset: 46800},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1249, col: 56, offset: 46492},
+								pos:  position{line: 1249, col: 56, offset: 46818},
 								name: "LinkAttributes",
 							},
 						},
@@ -9957,63 +10007,63 @@ var g = &grammar{
 		},
 		{
 			name: "ImageBlock",
-			pos:  position{line: 1256, col: 1, offset: 46827},
+			pos:  position{line: 1256, col: 1, offset: 47153},
 			expr: &actionExpr{
-				pos: position{line: 1256, col: 15, offset: 46841},
+				pos: position{line: 1256, col: 15, offset: 47167},
 				run: (*parser).callonImageBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1256, col: 15, offset: 46841},
+					pos: position{line: 1256, col: 15, offset: 47167},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1256, col: 15, offset: 46841},
+							pos:   position{line: 1256, col: 15, offset: 47167},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1256, col: 26, offset: 46852},
+								pos: position{line: 1256, col: 26, offset: 47178},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1256, col: 27, offset: 46853},
+									pos:  position{line: 1256, col: 27, offset: 47179},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1256, col: 47, offset: 46873},
+							pos:        position{line: 1256, col: 47, offset: 47199},
 							val:        "image::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1256, col: 57, offset: 46883},
+							pos:   position{line: 1256, col: 57, offset: 47209},
 							label: "path",
 							expr: &choiceExpr{
-								pos: position{line: 1256, col: 63, offset: 46889},
+								pos: position{line: 1256, col: 63, offset: 47215},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1256, col: 63, offset: 46889},
+										pos:  position{line: 1256, col: 63, offset: 47215},
 										name: "Location",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1256, col: 74, offset: 46900},
+										pos:  position{line: 1256, col: 74, offset: 47226},
 										name: "FileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1256, col: 88, offset: 46914},
+							pos:   position{line: 1256, col: 88, offset: 47240},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1256, col: 106, offset: 46932},
+								pos:  position{line: 1256, col: 106, offset: 47258},
 								name: "ImageAttributes",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1256, col: 123, offset: 46949},
+							pos: position{line: 1256, col: 123, offset: 47275},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1256, col: 123, offset: 46949},
+								pos:  position{line: 1256, col: 123, offset: 47275},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1256, col: 127, offset: 46953},
+							pos:  position{line: 1256, col: 127, offset: 47279},
 							name: "EOL",
 						},
 					},
@@ -10022,48 +10072,48 @@ var g = &grammar{
 		},
 		{
 			name: "InlineImage",
-			pos:  position{line: 1260, col: 1, offset: 47072},
+			pos:  position{line: 1260, col: 1, offset: 47398},
 			expr: &actionExpr{
-				pos: position{line: 1260, col: 16, offset: 47087},
+				pos: position{line: 1260, col: 16, offset: 47413},
 				run: (*parser).callonInlineImage1,
 				expr: &seqExpr{
-					pos: position{line: 1260, col: 16, offset: 47087},
+					pos: position{line: 1260, col: 16, offset: 47413},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1260, col: 16, offset: 47087},
+							pos:        position{line: 1260, col: 16, offset: 47413},
 							val:        "image:",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1260, col: 25, offset: 47096},
+							pos: position{line: 1260, col: 25, offset: 47422},
 							expr: &litMatcher{
-								pos:        position{line: 1260, col: 26, offset: 47097},
+								pos:        position{line: 1260, col: 26, offset: 47423},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1260, col: 30, offset: 47101},
+							pos:   position{line: 1260, col: 30, offset: 47427},
 							label: "path",
 							expr: &choiceExpr{
-								pos: position{line: 1260, col: 36, offset: 47107},
+								pos: position{line: 1260, col: 36, offset: 47433},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1260, col: 36, offset: 47107},
+										pos:  position{line: 1260, col: 36, offset: 47433},
 										name: "Location",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1260, col: 47, offset: 47118},
+										pos:  position{line: 1260, col: 47, offset: 47444},
 										name: "FileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1260, col: 61, offset: 47132},
+							pos:   position{line: 1260, col: 61, offset: 47458},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1260, col: 79, offset: 47150},
+								pos:  position{line: 1260, col: 79, offset: 47476},
 								name: "ImageAttributes",
 							},
 						},
@@ -10073,95 +10123,95 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAttributes",
-			pos:  position{line: 1264, col: 1, offset: 47271},
+			pos:  position{line: 1264, col: 1, offset: 47597},
 			expr: &actionExpr{
-				pos: position{line: 1264, col: 20, offset: 47290},
+				pos: position{line: 1264, col: 20, offset: 47616},
 				run: (*parser).callonImageAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1264, col: 20, offset: 47290},
+					pos: position{line: 1264, col: 20, offset: 47616},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1264, col: 20, offset: 47290},
+							pos:        position{line: 1264, col: 20, offset: 47616},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1264, col: 24, offset: 47294},
+							pos:   position{line: 1264, col: 24, offset: 47620},
 							label: "alt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1264, col: 28, offset: 47298},
+								pos: position{line: 1264, col: 28, offset: 47624},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1264, col: 29, offset: 47299},
+									pos:  position{line: 1264, col: 29, offset: 47625},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1264, col: 56, offset: 47326},
+							pos: position{line: 1264, col: 56, offset: 47652},
 							expr: &litMatcher{
-								pos:        position{line: 1264, col: 56, offset: 47326},
+								pos:        position{line: 1264, col: 56, offset: 47652},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1264, col: 61, offset: 47331},
+							pos:   position{line: 1264, col: 61, offset: 47657},
 							label: "width",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1264, col: 67, offset: 47337},
+								pos: position{line: 1264, col: 67, offset: 47663},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1264, col: 68, offset: 47338},
+									pos:  position{line: 1264, col: 68, offset: 47664},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1264, col: 95, offset: 47365},
+							pos: position{line: 1264, col: 95, offset: 47691},
 							expr: &litMatcher{
-								pos:        position{line: 1264, col: 95, offset: 47365},
+								pos:        position{line: 1264, col: 95, offset: 47691},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1264, col: 100, offset: 47370},
+							pos:   position{line: 1264, col: 100, offset: 47696},
 							label: "height",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1264, col: 107, offset: 47377},
+								pos: position{line: 1264, col: 107, offset: 47703},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1264, col: 108, offset: 47378},
+									pos:  position{line: 1264, col: 108, offset: 47704},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1264, col: 135, offset: 47405},
+							pos: position{line: 1264, col: 135, offset: 47731},
 							expr: &litMatcher{
-								pos:        position{line: 1264, col: 135, offset: 47405},
+								pos:        position{line: 1264, col: 135, offset: 47731},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1264, col: 140, offset: 47410},
+							pos: position{line: 1264, col: 140, offset: 47736},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1264, col: 140, offset: 47410},
+								pos:  position{line: 1264, col: 140, offset: 47736},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1264, col: 144, offset: 47414},
+							pos:   position{line: 1264, col: 144, offset: 47740},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1264, col: 155, offset: 47425},
+								pos: position{line: 1264, col: 155, offset: 47751},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1264, col: 156, offset: 47426},
+									pos:  position{line: 1264, col: 156, offset: 47752},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1264, col: 175, offset: 47445},
+							pos:        position{line: 1264, col: 175, offset: 47771},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -10171,31 +10221,31 @@ var g = &grammar{
 		},
 		{
 			name: "InlineFootnote",
-			pos:  position{line: 1271, col: 1, offset: 47735},
+			pos:  position{line: 1271, col: 1, offset: 48061},
 			expr: &choiceExpr{
-				pos: position{line: 1271, col: 19, offset: 47753},
+				pos: position{line: 1271, col: 19, offset: 48079},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1271, col: 19, offset: 47753},
+						pos: position{line: 1271, col: 19, offset: 48079},
 						run: (*parser).callonInlineFootnote2,
 						expr: &seqExpr{
-							pos: position{line: 1271, col: 19, offset: 47753},
+							pos: position{line: 1271, col: 19, offset: 48079},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1271, col: 19, offset: 47753},
+									pos:        position{line: 1271, col: 19, offset: 48079},
 									val:        "footnote:[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1271, col: 32, offset: 47766},
+									pos:   position{line: 1271, col: 32, offset: 48092},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1271, col: 41, offset: 47775},
+										pos:  position{line: 1271, col: 41, offset: 48101},
 										name: "FootnoteContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1271, col: 58, offset: 47792},
+									pos:        position{line: 1271, col: 58, offset: 48118},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -10203,42 +10253,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1273, col: 5, offset: 47860},
+						pos: position{line: 1273, col: 5, offset: 48186},
 						run: (*parser).callonInlineFootnote8,
 						expr: &seqExpr{
-							pos: position{line: 1273, col: 5, offset: 47860},
+							pos: position{line: 1273, col: 5, offset: 48186},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1273, col: 5, offset: 47860},
+									pos:        position{line: 1273, col: 5, offset: 48186},
 									val:        "footnote:",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1273, col: 17, offset: 47872},
+									pos:   position{line: 1273, col: 17, offset: 48198},
 									label: "ref",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1273, col: 22, offset: 47877},
+										pos:  position{line: 1273, col: 22, offset: 48203},
 										name: "FootnoteRef",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1273, col: 35, offset: 47890},
+									pos:        position{line: 1273, col: 35, offset: 48216},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1273, col: 39, offset: 47894},
+									pos:   position{line: 1273, col: 39, offset: 48220},
 									label: "content",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1273, col: 47, offset: 47902},
+										pos: position{line: 1273, col: 47, offset: 48228},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1273, col: 48, offset: 47903},
+											pos:  position{line: 1273, col: 48, offset: 48229},
 											name: "FootnoteContent",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1273, col: 66, offset: 47921},
+									pos:        position{line: 1273, col: 66, offset: 48247},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -10250,67 +10300,67 @@ var g = &grammar{
 		},
 		{
 			name: "FootnoteRef",
-			pos:  position{line: 1277, col: 1, offset: 47982},
+			pos:  position{line: 1277, col: 1, offset: 48308},
 			expr: &actionExpr{
-				pos: position{line: 1277, col: 16, offset: 47997},
+				pos: position{line: 1277, col: 16, offset: 48323},
 				run: (*parser).callonFootnoteRef1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 1277, col: 16, offset: 47997},
+					pos:  position{line: 1277, col: 16, offset: 48323},
 					name: "Alphanums",
 				},
 			},
 		},
 		{
 			name: "FootnoteContent",
-			pos:  position{line: 1281, col: 1, offset: 48044},
+			pos:  position{line: 1281, col: 1, offset: 48370},
 			expr: &actionExpr{
-				pos: position{line: 1281, col: 20, offset: 48063},
+				pos: position{line: 1281, col: 20, offset: 48389},
 				run: (*parser).callonFootnoteContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1281, col: 20, offset: 48063},
+					pos:   position{line: 1281, col: 20, offset: 48389},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1281, col: 29, offset: 48072},
+						pos: position{line: 1281, col: 29, offset: 48398},
 						expr: &seqExpr{
-							pos: position{line: 1281, col: 30, offset: 48073},
+							pos: position{line: 1281, col: 30, offset: 48399},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1281, col: 30, offset: 48073},
+									pos: position{line: 1281, col: 30, offset: 48399},
 									expr: &litMatcher{
-										pos:        position{line: 1281, col: 31, offset: 48074},
+										pos:        position{line: 1281, col: 31, offset: 48400},
 										val:        "]",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 1281, col: 35, offset: 48078},
+									pos: position{line: 1281, col: 35, offset: 48404},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1281, col: 36, offset: 48079},
+										pos:  position{line: 1281, col: 36, offset: 48405},
 										name: "EOL",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1281, col: 40, offset: 48083},
+									pos: position{line: 1281, col: 40, offset: 48409},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1281, col: 40, offset: 48083},
+										pos:  position{line: 1281, col: 40, offset: 48409},
 										name: "WS",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1281, col: 44, offset: 48087},
+									pos: position{line: 1281, col: 44, offset: 48413},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1281, col: 45, offset: 48088},
+										pos:  position{line: 1281, col: 45, offset: 48414},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1281, col: 61, offset: 48104},
+									pos:  position{line: 1281, col: 61, offset: 48430},
 									name: "InlineElement",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1281, col: 75, offset: 48118},
+									pos: position{line: 1281, col: 75, offset: 48444},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1281, col: 75, offset: 48118},
+										pos:  position{line: 1281, col: 75, offset: 48444},
 										name: "WS",
 									},
 								},
@@ -10322,60 +10372,60 @@ var g = &grammar{
 		},
 		{
 			name: "DelimitedBlock",
-			pos:  position{line: 1288, col: 1, offset: 48432},
+			pos:  position{line: 1288, col: 1, offset: 48758},
 			expr: &actionExpr{
-				pos: position{line: 1288, col: 19, offset: 48450},
+				pos: position{line: 1288, col: 19, offset: 48776},
 				run: (*parser).callonDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1288, col: 19, offset: 48450},
+					pos: position{line: 1288, col: 19, offset: 48776},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1288, col: 19, offset: 48450},
+							pos: position{line: 1288, col: 19, offset: 48776},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1288, col: 20, offset: 48451},
+								pos:  position{line: 1288, col: 20, offset: 48777},
 								name: "Alphanum",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1289, col: 5, offset: 48480},
+							pos:   position{line: 1289, col: 5, offset: 48806},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 1289, col: 12, offset: 48487},
+								pos: position{line: 1289, col: 12, offset: 48813},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1289, col: 12, offset: 48487},
+										pos:  position{line: 1289, col: 12, offset: 48813},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1290, col: 11, offset: 48510},
+										pos:  position{line: 1290, col: 11, offset: 48836},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1291, col: 11, offset: 48534},
+										pos:  position{line: 1291, col: 11, offset: 48860},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1292, col: 11, offset: 48558},
+										pos:  position{line: 1292, col: 11, offset: 48884},
 										name: "VerseBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1293, col: 11, offset: 48580},
+										pos:  position{line: 1293, col: 11, offset: 48906},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1294, col: 11, offset: 48602},
+										pos:  position{line: 1294, col: 11, offset: 48928},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1295, col: 11, offset: 48625},
+										pos:  position{line: 1295, col: 11, offset: 48951},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1296, col: 11, offset: 48653},
+										pos:  position{line: 1296, col: 11, offset: 48979},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1297, col: 11, offset: 48669},
+										pos:  position{line: 1297, col: 11, offset: 48995},
 										name: "CommentBlock",
 									},
 								},
@@ -10387,36 +10437,36 @@ var g = &grammar{
 		},
 		{
 			name: "BlockDelimiter",
-			pos:  position{line: 1301, col: 1, offset: 48710},
+			pos:  position{line: 1301, col: 1, offset: 49036},
 			expr: &choiceExpr{
-				pos: position{line: 1301, col: 19, offset: 48728},
+				pos: position{line: 1301, col: 19, offset: 49054},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1301, col: 19, offset: 48728},
+						pos:  position{line: 1301, col: 19, offset: 49054},
 						name: "LiteralBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1302, col: 19, offset: 48769},
+						pos:  position{line: 1302, col: 19, offset: 49095},
 						name: "FencedBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1303, col: 19, offset: 48809},
+						pos:  position{line: 1303, col: 19, offset: 49135},
 						name: "ListingBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1304, col: 19, offset: 48850},
+						pos:  position{line: 1304, col: 19, offset: 49176},
 						name: "ExampleBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1305, col: 19, offset: 48891},
+						pos:  position{line: 1305, col: 19, offset: 49217},
 						name: "CommentBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1306, col: 19, offset: 48932},
+						pos:  position{line: 1306, col: 19, offset: 49258},
 						name: "QuoteBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1307, col: 19, offset: 48970},
+						pos:  position{line: 1307, col: 19, offset: 49296},
 						name: "SidebarBlockDelimiter",
 					},
 				},
@@ -10424,24 +10474,24 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockDelimiter",
-			pos:  position{line: 1313, col: 1, offset: 49189},
+			pos:  position{line: 1313, col: 1, offset: 49515},
 			expr: &seqExpr{
-				pos: position{line: 1313, col: 25, offset: 49213},
+				pos: position{line: 1313, col: 25, offset: 49539},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1313, col: 25, offset: 49213},
+						pos:        position{line: 1313, col: 25, offset: 49539},
 						val:        "```",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1313, col: 31, offset: 49219},
+						pos: position{line: 1313, col: 31, offset: 49545},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1313, col: 31, offset: 49219},
+							pos:  position{line: 1313, col: 31, offset: 49545},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1313, col: 35, offset: 49223},
+						pos:  position{line: 1313, col: 35, offset: 49549},
 						name: "EOL",
 					},
 				},
@@ -10449,48 +10499,48 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlock",
-			pos:  position{line: 1315, col: 1, offset: 49228},
+			pos:  position{line: 1315, col: 1, offset: 49554},
 			expr: &actionExpr{
-				pos: position{line: 1315, col: 16, offset: 49243},
+				pos: position{line: 1315, col: 16, offset: 49569},
 				run: (*parser).callonFencedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1315, col: 16, offset: 49243},
+					pos: position{line: 1315, col: 16, offset: 49569},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1315, col: 16, offset: 49243},
+							pos:   position{line: 1315, col: 16, offset: 49569},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1315, col: 27, offset: 49254},
+								pos: position{line: 1315, col: 27, offset: 49580},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1315, col: 28, offset: 49255},
+									pos:  position{line: 1315, col: 28, offset: 49581},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1315, col: 48, offset: 49275},
+							pos:  position{line: 1315, col: 48, offset: 49601},
 							name: "FencedBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1315, col: 69, offset: 49296},
+							pos:   position{line: 1315, col: 69, offset: 49622},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1315, col: 77, offset: 49304},
+								pos: position{line: 1315, col: 77, offset: 49630},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1315, col: 78, offset: 49305},
+									pos:  position{line: 1315, col: 78, offset: 49631},
 									name: "FencedBlockContent",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1315, col: 100, offset: 49327},
+							pos: position{line: 1315, col: 100, offset: 49653},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1315, col: 100, offset: 49327},
+									pos:  position{line: 1315, col: 100, offset: 49653},
 									name: "FencedBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1315, col: 123, offset: 49350},
+									pos:  position{line: 1315, col: 123, offset: 49676},
 									name: "EOF",
 								},
 							},
@@ -10501,24 +10551,24 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockContent",
-			pos:  position{line: 1319, col: 1, offset: 49458},
+			pos:  position{line: 1319, col: 1, offset: 49784},
 			expr: &choiceExpr{
-				pos: position{line: 1319, col: 23, offset: 49480},
+				pos: position{line: 1319, col: 23, offset: 49806},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1319, col: 23, offset: 49480},
+						pos:  position{line: 1319, col: 23, offset: 49806},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1319, col: 35, offset: 49492},
+						pos:  position{line: 1319, col: 35, offset: 49818},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1319, col: 51, offset: 49508},
+						pos:  position{line: 1319, col: 51, offset: 49834},
 						name: "ListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1319, col: 62, offset: 49519},
+						pos:  position{line: 1319, col: 62, offset: 49845},
 						name: "FencedBlockParagraph",
 					},
 				},
@@ -10526,17 +10576,17 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockParagraph",
-			pos:  position{line: 1322, col: 1, offset: 49559},
+			pos:  position{line: 1322, col: 1, offset: 49885},
 			expr: &actionExpr{
-				pos: position{line: 1322, col: 25, offset: 49583},
+				pos: position{line: 1322, col: 25, offset: 49909},
 				run: (*parser).callonFencedBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1322, col: 25, offset: 49583},
+					pos:   position{line: 1322, col: 25, offset: 49909},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1322, col: 31, offset: 49589},
+						pos: position{line: 1322, col: 31, offset: 49915},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1322, col: 32, offset: 49590},
+							pos:  position{line: 1322, col: 32, offset: 49916},
 							name: "FencedBlockParagraphLine",
 						},
 					},
@@ -10545,32 +10595,32 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockParagraphLine",
-			pos:  position{line: 1326, col: 1, offset: 49703},
+			pos:  position{line: 1326, col: 1, offset: 50029},
 			expr: &actionExpr{
-				pos: position{line: 1326, col: 29, offset: 49731},
+				pos: position{line: 1326, col: 29, offset: 50057},
 				run: (*parser).callonFencedBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1326, col: 29, offset: 49731},
+					pos: position{line: 1326, col: 29, offset: 50057},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1326, col: 29, offset: 49731},
+							pos: position{line: 1326, col: 29, offset: 50057},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1326, col: 30, offset: 49732},
+								pos:  position{line: 1326, col: 30, offset: 50058},
 								name: "FencedBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1326, col: 51, offset: 49753},
+							pos: position{line: 1326, col: 51, offset: 50079},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1326, col: 52, offset: 49754},
+								pos:  position{line: 1326, col: 52, offset: 50080},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1326, col: 62, offset: 49764},
+							pos:   position{line: 1326, col: 62, offset: 50090},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1326, col: 68, offset: 49770},
+								pos:  position{line: 1326, col: 68, offset: 50096},
 								name: "InlineElements",
 							},
 						},
@@ -10580,24 +10630,24 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockDelimiter",
-			pos:  position{line: 1333, col: 1, offset: 50008},
+			pos:  position{line: 1333, col: 1, offset: 50334},
 			expr: &seqExpr{
-				pos: position{line: 1333, col: 26, offset: 50033},
+				pos: position{line: 1333, col: 26, offset: 50359},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1333, col: 26, offset: 50033},
+						pos:        position{line: 1333, col: 26, offset: 50359},
 						val:        "----",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1333, col: 33, offset: 50040},
+						pos: position{line: 1333, col: 33, offset: 50366},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1333, col: 33, offset: 50040},
+							pos:  position{line: 1333, col: 33, offset: 50366},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1333, col: 37, offset: 50044},
+						pos:  position{line: 1333, col: 37, offset: 50370},
 						name: "EOL",
 					},
 				},
@@ -10605,48 +10655,48 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlock",
-			pos:  position{line: 1335, col: 1, offset: 50049},
+			pos:  position{line: 1335, col: 1, offset: 50375},
 			expr: &actionExpr{
-				pos: position{line: 1335, col: 17, offset: 50065},
+				pos: position{line: 1335, col: 17, offset: 50391},
 				run: (*parser).callonListingBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1335, col: 17, offset: 50065},
+					pos: position{line: 1335, col: 17, offset: 50391},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1335, col: 17, offset: 50065},
+							pos:   position{line: 1335, col: 17, offset: 50391},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1335, col: 28, offset: 50076},
+								pos: position{line: 1335, col: 28, offset: 50402},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1335, col: 29, offset: 50077},
+									pos:  position{line: 1335, col: 29, offset: 50403},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1335, col: 49, offset: 50097},
+							pos:  position{line: 1335, col: 49, offset: 50423},
 							name: "ListingBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1335, col: 71, offset: 50119},
+							pos:   position{line: 1335, col: 71, offset: 50445},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1335, col: 79, offset: 50127},
+								pos: position{line: 1335, col: 79, offset: 50453},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1335, col: 80, offset: 50128},
+									pos:  position{line: 1335, col: 80, offset: 50454},
 									name: "ListingBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1335, col: 103, offset: 50151},
+							pos: position{line: 1335, col: 103, offset: 50477},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1335, col: 103, offset: 50151},
+									pos:  position{line: 1335, col: 103, offset: 50477},
 									name: "ListingBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1335, col: 127, offset: 50175},
+									pos:  position{line: 1335, col: 127, offset: 50501},
 									name: "EOF",
 								},
 							},
@@ -10657,20 +10707,20 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockElement",
-			pos:  position{line: 1339, col: 1, offset: 50284},
+			pos:  position{line: 1339, col: 1, offset: 50610},
 			expr: &choiceExpr{
-				pos: position{line: 1339, col: 24, offset: 50307},
+				pos: position{line: 1339, col: 24, offset: 50633},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1339, col: 24, offset: 50307},
+						pos:  position{line: 1339, col: 24, offset: 50633},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1339, col: 40, offset: 50323},
+						pos:  position{line: 1339, col: 40, offset: 50649},
 						name: "ListingBlockParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1339, col: 64, offset: 50347},
+						pos:  position{line: 1339, col: 64, offset: 50673},
 						name: "BlankLine",
 					},
 				},
@@ -10678,17 +10728,17 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockParagraph",
-			pos:  position{line: 1341, col: 1, offset: 50358},
+			pos:  position{line: 1341, col: 1, offset: 50684},
 			expr: &actionExpr{
-				pos: position{line: 1341, col: 26, offset: 50383},
+				pos: position{line: 1341, col: 26, offset: 50709},
 				run: (*parser).callonListingBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1341, col: 26, offset: 50383},
+					pos:   position{line: 1341, col: 26, offset: 50709},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1341, col: 32, offset: 50389},
+						pos: position{line: 1341, col: 32, offset: 50715},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1341, col: 33, offset: 50390},
+							pos:  position{line: 1341, col: 33, offset: 50716},
 							name: "ListingBlockParagraphLine",
 						},
 					},
@@ -10697,61 +10747,61 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockParagraphLine",
-			pos:  position{line: 1345, col: 1, offset: 50509},
+			pos:  position{line: 1345, col: 1, offset: 50835},
 			expr: &actionExpr{
-				pos: position{line: 1345, col: 30, offset: 50538},
+				pos: position{line: 1345, col: 30, offset: 50864},
 				run: (*parser).callonListingBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1345, col: 30, offset: 50538},
+					pos: position{line: 1345, col: 30, offset: 50864},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1345, col: 30, offset: 50538},
+							pos: position{line: 1345, col: 30, offset: 50864},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1345, col: 31, offset: 50539},
+								pos:  position{line: 1345, col: 31, offset: 50865},
 								name: "ListingBlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1345, col: 53, offset: 50561},
+							pos:   position{line: 1345, col: 53, offset: 50887},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1345, col: 59, offset: 50567},
+								pos: position{line: 1345, col: 59, offset: 50893},
 								run: (*parser).callonListingBlockParagraphLine6,
 								expr: &seqExpr{
-									pos: position{line: 1345, col: 59, offset: 50567},
+									pos: position{line: 1345, col: 59, offset: 50893},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1345, col: 59, offset: 50567},
+											pos: position{line: 1345, col: 59, offset: 50893},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1345, col: 60, offset: 50568},
+												pos:  position{line: 1345, col: 60, offset: 50894},
 												name: "EOF",
 											},
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1345, col: 64, offset: 50572},
+											pos: position{line: 1345, col: 64, offset: 50898},
 											expr: &choiceExpr{
-												pos: position{line: 1345, col: 65, offset: 50573},
+												pos: position{line: 1345, col: 65, offset: 50899},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1345, col: 65, offset: 50573},
+														pos:  position{line: 1345, col: 65, offset: 50899},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1345, col: 77, offset: 50585},
+														pos:  position{line: 1345, col: 77, offset: 50911},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 1345, col: 87, offset: 50595},
+														pos: position{line: 1345, col: 87, offset: 50921},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 1345, col: 87, offset: 50595},
+																pos: position{line: 1345, col: 87, offset: 50921},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 1345, col: 88, offset: 50596},
+																	pos:  position{line: 1345, col: 88, offset: 50922},
 																	name: "EOL",
 																},
 															},
 															&anyMatcher{
-																line: 1345, col: 92, offset: 50600,
+																line: 1345, col: 92, offset: 50926,
 															},
 														},
 													},
@@ -10763,7 +10813,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1345, col: 128, offset: 50636},
+							pos:  position{line: 1345, col: 128, offset: 50962},
 							name: "EOL",
 						},
 					},
@@ -10772,24 +10822,24 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockDelimiter",
-			pos:  position{line: 1352, col: 1, offset: 50962},
+			pos:  position{line: 1352, col: 1, offset: 51288},
 			expr: &seqExpr{
-				pos: position{line: 1352, col: 26, offset: 50987},
+				pos: position{line: 1352, col: 26, offset: 51313},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1352, col: 26, offset: 50987},
+						pos:        position{line: 1352, col: 26, offset: 51313},
 						val:        "====",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1352, col: 33, offset: 50994},
+						pos: position{line: 1352, col: 33, offset: 51320},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1352, col: 33, offset: 50994},
+							pos:  position{line: 1352, col: 33, offset: 51320},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1352, col: 37, offset: 50998},
+						pos:  position{line: 1352, col: 37, offset: 51324},
 						name: "EOL",
 					},
 				},
@@ -10797,50 +10847,50 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlock",
-			pos:  position{line: 1354, col: 1, offset: 51003},
+			pos:  position{line: 1354, col: 1, offset: 51329},
 			expr: &actionExpr{
-				pos: position{line: 1354, col: 17, offset: 51019},
+				pos: position{line: 1354, col: 17, offset: 51345},
 				run: (*parser).callonExampleBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1354, col: 17, offset: 51019},
+					pos: position{line: 1354, col: 17, offset: 51345},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1354, col: 17, offset: 51019},
+							pos:   position{line: 1354, col: 17, offset: 51345},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1354, col: 28, offset: 51030},
+								pos: position{line: 1354, col: 28, offset: 51356},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1354, col: 29, offset: 51031},
+									pos:  position{line: 1354, col: 29, offset: 51357},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1354, col: 49, offset: 51051},
+							pos:  position{line: 1354, col: 49, offset: 51377},
 							name: "ExampleBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1354, col: 71, offset: 51073},
+							pos:   position{line: 1354, col: 71, offset: 51399},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1354, col: 79, offset: 51081},
+								pos: position{line: 1354, col: 79, offset: 51407},
 								expr: &choiceExpr{
-									pos: position{line: 1354, col: 80, offset: 51082},
+									pos: position{line: 1354, col: 80, offset: 51408},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1354, col: 80, offset: 51082},
+											pos:  position{line: 1354, col: 80, offset: 51408},
 											name: "BlankLine",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1354, col: 92, offset: 51094},
+											pos:  position{line: 1354, col: 92, offset: 51420},
 											name: "FileInclusion",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1354, col: 108, offset: 51110},
+											pos:  position{line: 1354, col: 108, offset: 51436},
 											name: "ListItem",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1354, col: 119, offset: 51121},
+											pos:  position{line: 1354, col: 119, offset: 51447},
 											name: "ExampleBlockParagraph",
 										},
 									},
@@ -10848,14 +10898,14 @@ var g = &grammar{
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1354, col: 145, offset: 51147},
+							pos: position{line: 1354, col: 145, offset: 51473},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1354, col: 145, offset: 51147},
+									pos:  position{line: 1354, col: 145, offset: 51473},
 									name: "ExampleBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1354, col: 169, offset: 51171},
+									pos:  position{line: 1354, col: 169, offset: 51497},
 									name: "EOF",
 								},
 							},
@@ -10866,17 +10916,17 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockParagraph",
-			pos:  position{line: 1359, col: 1, offset: 51298},
+			pos:  position{line: 1359, col: 1, offset: 51624},
 			expr: &actionExpr{
-				pos: position{line: 1359, col: 26, offset: 51323},
+				pos: position{line: 1359, col: 26, offset: 51649},
 				run: (*parser).callonExampleBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1359, col: 26, offset: 51323},
+					pos:   position{line: 1359, col: 26, offset: 51649},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1359, col: 32, offset: 51329},
+						pos: position{line: 1359, col: 32, offset: 51655},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1359, col: 33, offset: 51330},
+							pos:  position{line: 1359, col: 33, offset: 51656},
 							name: "ExampleBlockParagraphLine",
 						},
 					},
@@ -10885,32 +10935,32 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockParagraphLine",
-			pos:  position{line: 1363, col: 1, offset: 51444},
+			pos:  position{line: 1363, col: 1, offset: 51770},
 			expr: &actionExpr{
-				pos: position{line: 1363, col: 30, offset: 51473},
+				pos: position{line: 1363, col: 30, offset: 51799},
 				run: (*parser).callonExampleBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1363, col: 30, offset: 51473},
+					pos: position{line: 1363, col: 30, offset: 51799},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1363, col: 30, offset: 51473},
+							pos: position{line: 1363, col: 30, offset: 51799},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1363, col: 31, offset: 51474},
+								pos:  position{line: 1363, col: 31, offset: 51800},
 								name: "ExampleBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1363, col: 53, offset: 51496},
+							pos: position{line: 1363, col: 53, offset: 51822},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1363, col: 54, offset: 51497},
+								pos:  position{line: 1363, col: 54, offset: 51823},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1363, col: 64, offset: 51507},
+							pos:   position{line: 1363, col: 64, offset: 51833},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1363, col: 70, offset: 51513},
+								pos:  position{line: 1363, col: 70, offset: 51839},
 								name: "InlineElements",
 							},
 						},
@@ -10920,24 +10970,24 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockDelimiter",
-			pos:  position{line: 1370, col: 1, offset: 51749},
+			pos:  position{line: 1370, col: 1, offset: 52075},
 			expr: &seqExpr{
-				pos: position{line: 1370, col: 24, offset: 51772},
+				pos: position{line: 1370, col: 24, offset: 52098},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1370, col: 24, offset: 51772},
+						pos:        position{line: 1370, col: 24, offset: 52098},
 						val:        "____",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1370, col: 31, offset: 51779},
+						pos: position{line: 1370, col: 31, offset: 52105},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1370, col: 31, offset: 51779},
+							pos:  position{line: 1370, col: 31, offset: 52105},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1370, col: 35, offset: 51783},
+						pos:  position{line: 1370, col: 35, offset: 52109},
 						name: "EOL",
 					},
 				},
@@ -10945,48 +10995,48 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlock",
-			pos:  position{line: 1372, col: 1, offset: 51813},
+			pos:  position{line: 1372, col: 1, offset: 52139},
 			expr: &actionExpr{
-				pos: position{line: 1372, col: 15, offset: 51827},
+				pos: position{line: 1372, col: 15, offset: 52153},
 				run: (*parser).callonQuoteBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1372, col: 15, offset: 51827},
+					pos: position{line: 1372, col: 15, offset: 52153},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1372, col: 15, offset: 51827},
+							pos:   position{line: 1372, col: 15, offset: 52153},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1372, col: 26, offset: 51838},
+								pos: position{line: 1372, col: 26, offset: 52164},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1372, col: 27, offset: 51839},
+									pos:  position{line: 1372, col: 27, offset: 52165},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1372, col: 47, offset: 51859},
+							pos:  position{line: 1372, col: 47, offset: 52185},
 							name: "QuoteBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1372, col: 67, offset: 51879},
+							pos:   position{line: 1372, col: 67, offset: 52205},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1372, col: 75, offset: 51887},
+								pos: position{line: 1372, col: 75, offset: 52213},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1372, col: 76, offset: 51888},
+									pos:  position{line: 1372, col: 76, offset: 52214},
 									name: "QuoteBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1372, col: 97, offset: 51909},
+							pos: position{line: 1372, col: 97, offset: 52235},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1372, col: 97, offset: 51909},
+									pos:  position{line: 1372, col: 97, offset: 52235},
 									name: "QuoteBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1372, col: 119, offset: 51931},
+									pos:  position{line: 1372, col: 119, offset: 52257},
 									name: "EOF",
 								},
 							},
@@ -10997,99 +11047,99 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockElement",
-			pos:  position{line: 1376, col: 1, offset: 52038},
+			pos:  position{line: 1376, col: 1, offset: 52364},
 			expr: &actionExpr{
-				pos: position{line: 1377, col: 5, offset: 52064},
+				pos: position{line: 1377, col: 5, offset: 52390},
 				run: (*parser).callonQuoteBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1377, col: 5, offset: 52064},
+					pos: position{line: 1377, col: 5, offset: 52390},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1377, col: 5, offset: 52064},
+							pos: position{line: 1377, col: 5, offset: 52390},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1377, col: 6, offset: 52065},
+								pos:  position{line: 1377, col: 6, offset: 52391},
 								name: "QuoteBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1377, col: 26, offset: 52085},
+							pos: position{line: 1377, col: 26, offset: 52411},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1377, col: 27, offset: 52086},
+								pos:  position{line: 1377, col: 27, offset: 52412},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1377, col: 31, offset: 52090},
+							pos:   position{line: 1377, col: 31, offset: 52416},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1377, col: 40, offset: 52099},
+								pos: position{line: 1377, col: 40, offset: 52425},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1377, col: 40, offset: 52099},
+										pos:  position{line: 1377, col: 40, offset: 52425},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1378, col: 15, offset: 52124},
+										pos:  position{line: 1378, col: 15, offset: 52450},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1379, col: 15, offset: 52152},
+										pos:  position{line: 1379, col: 15, offset: 52478},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1380, col: 15, offset: 52178},
+										pos:  position{line: 1380, col: 15, offset: 52504},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1381, col: 15, offset: 52201},
+										pos:  position{line: 1381, col: 15, offset: 52527},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1382, col: 15, offset: 52227},
+										pos:  position{line: 1382, col: 15, offset: 52553},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1383, col: 15, offset: 52254},
+										pos:  position{line: 1383, col: 15, offset: 52580},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1384, col: 15, offset: 52281},
+										pos:  position{line: 1384, col: 15, offset: 52607},
 										name: "CommentBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1385, col: 15, offset: 52308},
+										pos:  position{line: 1385, col: 15, offset: 52634},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1386, col: 15, offset: 52340},
+										pos:  position{line: 1386, col: 15, offset: 52666},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1387, col: 15, offset: 52366},
+										pos:  position{line: 1387, col: 15, offset: 52692},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1388, col: 15, offset: 52393},
+										pos:  position{line: 1388, col: 15, offset: 52719},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1389, col: 15, offset: 52414},
+										pos:  position{line: 1389, col: 15, offset: 52740},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1390, col: 15, offset: 52442},
+										pos:  position{line: 1390, col: 15, offset: 52768},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1391, col: 15, offset: 52486},
+										pos:  position{line: 1391, col: 15, offset: 52812},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1392, col: 15, offset: 52524},
+										pos:  position{line: 1392, col: 15, offset: 52850},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1393, col: 15, offset: 52565},
+										pos:  position{line: 1393, col: 15, offset: 52891},
 										name: "QuoteBlockParagraph",
 									},
 								},
@@ -11101,17 +11151,17 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockParagraph",
-			pos:  position{line: 1397, col: 1, offset: 52624},
+			pos:  position{line: 1397, col: 1, offset: 52950},
 			expr: &actionExpr{
-				pos: position{line: 1397, col: 24, offset: 52647},
+				pos: position{line: 1397, col: 24, offset: 52973},
 				run: (*parser).callonQuoteBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1397, col: 24, offset: 52647},
+					pos:   position{line: 1397, col: 24, offset: 52973},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1397, col: 30, offset: 52653},
+						pos: position{line: 1397, col: 30, offset: 52979},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1397, col: 31, offset: 52654},
+							pos:  position{line: 1397, col: 31, offset: 52980},
 							name: "InlineElements",
 						},
 					},
@@ -11120,49 +11170,49 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlock",
-			pos:  position{line: 1406, col: 1, offset: 53000},
+			pos:  position{line: 1406, col: 1, offset: 53326},
 			expr: &actionExpr{
-				pos: position{line: 1406, col: 15, offset: 53014},
+				pos: position{line: 1406, col: 15, offset: 53340},
 				run: (*parser).callonVerseBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1406, col: 15, offset: 53014},
+					pos: position{line: 1406, col: 15, offset: 53340},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1406, col: 15, offset: 53014},
+							pos:   position{line: 1406, col: 15, offset: 53340},
 							label: "attributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1406, col: 27, offset: 53026},
+								pos:  position{line: 1406, col: 27, offset: 53352},
 								name: "ElementAttributes",
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1407, col: 5, offset: 53050},
+							pos: position{line: 1407, col: 5, offset: 53376},
 							run: (*parser).callonVerseBlock5,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1411, col: 5, offset: 53236},
+							pos:  position{line: 1411, col: 5, offset: 53562},
 							name: "QuoteBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1411, col: 25, offset: 53256},
+							pos:   position{line: 1411, col: 25, offset: 53582},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1411, col: 33, offset: 53264},
+								pos: position{line: 1411, col: 33, offset: 53590},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1411, col: 34, offset: 53265},
+									pos:  position{line: 1411, col: 34, offset: 53591},
 									name: "VerseBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1411, col: 55, offset: 53286},
+							pos: position{line: 1411, col: 55, offset: 53612},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1411, col: 55, offset: 53286},
+									pos:  position{line: 1411, col: 55, offset: 53612},
 									name: "QuoteBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1411, col: 77, offset: 53308},
+									pos:  position{line: 1411, col: 77, offset: 53634},
 									name: "EOF",
 								},
 							},
@@ -11173,20 +11223,20 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockElement",
-			pos:  position{line: 1415, col: 1, offset: 53423},
+			pos:  position{line: 1415, col: 1, offset: 53749},
 			expr: &choiceExpr{
-				pos: position{line: 1415, col: 22, offset: 53444},
+				pos: position{line: 1415, col: 22, offset: 53770},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1415, col: 22, offset: 53444},
+						pos:  position{line: 1415, col: 22, offset: 53770},
 						name: "VerseFileInclude",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1415, col: 41, offset: 53463},
+						pos:  position{line: 1415, col: 41, offset: 53789},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1415, col: 53, offset: 53475},
+						pos:  position{line: 1415, col: 53, offset: 53801},
 						name: "VerseBlockParagraph",
 					},
 				},
@@ -11194,25 +11244,25 @@ var g = &grammar{
 		},
 		{
 			name: "VerseFileInclude",
-			pos:  position{line: 1417, col: 1, offset: 53496},
+			pos:  position{line: 1417, col: 1, offset: 53822},
 			expr: &actionExpr{
-				pos: position{line: 1417, col: 21, offset: 53516},
+				pos: position{line: 1417, col: 21, offset: 53842},
 				run: (*parser).callonVerseFileInclude1,
 				expr: &seqExpr{
-					pos: position{line: 1417, col: 21, offset: 53516},
+					pos: position{line: 1417, col: 21, offset: 53842},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1417, col: 21, offset: 53516},
+							pos: position{line: 1417, col: 21, offset: 53842},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1417, col: 22, offset: 53517},
+								pos:  position{line: 1417, col: 22, offset: 53843},
 								name: "QuoteBlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1417, col: 42, offset: 53537},
+							pos:   position{line: 1417, col: 42, offset: 53863},
 							label: "include",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1417, col: 51, offset: 53546},
+								pos:  position{line: 1417, col: 51, offset: 53872},
 								name: "FileInclusion",
 							},
 						},
@@ -11222,17 +11272,17 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraph",
-			pos:  position{line: 1422, col: 1, offset: 53608},
+			pos:  position{line: 1422, col: 1, offset: 53934},
 			expr: &actionExpr{
-				pos: position{line: 1422, col: 24, offset: 53631},
+				pos: position{line: 1422, col: 24, offset: 53957},
 				run: (*parser).callonVerseBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1422, col: 24, offset: 53631},
+					pos:   position{line: 1422, col: 24, offset: 53957},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1422, col: 30, offset: 53637},
+						pos: position{line: 1422, col: 30, offset: 53963},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1422, col: 31, offset: 53638},
+							pos:  position{line: 1422, col: 31, offset: 53964},
 							name: "VerseBlockParagraphLine",
 						},
 					},
@@ -11241,49 +11291,49 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraphLine",
-			pos:  position{line: 1426, col: 1, offset: 53728},
+			pos:  position{line: 1426, col: 1, offset: 54054},
 			expr: &actionExpr{
-				pos: position{line: 1426, col: 28, offset: 53755},
+				pos: position{line: 1426, col: 28, offset: 54081},
 				run: (*parser).callonVerseBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1426, col: 28, offset: 53755},
+					pos: position{line: 1426, col: 28, offset: 54081},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1426, col: 28, offset: 53755},
+							pos: position{line: 1426, col: 28, offset: 54081},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1426, col: 29, offset: 53756},
+								pos:  position{line: 1426, col: 29, offset: 54082},
 								name: "QuoteBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1426, col: 49, offset: 53776},
+							pos: position{line: 1426, col: 49, offset: 54102},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1426, col: 50, offset: 53777},
+								pos:  position{line: 1426, col: 50, offset: 54103},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1426, col: 60, offset: 53787},
+							pos:   position{line: 1426, col: 60, offset: 54113},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1426, col: 66, offset: 53793},
+								pos: position{line: 1426, col: 66, offset: 54119},
 								run: (*parser).callonVerseBlockParagraphLine8,
 								expr: &seqExpr{
-									pos: position{line: 1426, col: 66, offset: 53793},
+									pos: position{line: 1426, col: 66, offset: 54119},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 1426, col: 66, offset: 53793},
+											pos:   position{line: 1426, col: 66, offset: 54119},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1426, col: 75, offset: 53802},
+												pos: position{line: 1426, col: 75, offset: 54128},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1426, col: 76, offset: 53803},
+													pos:  position{line: 1426, col: 76, offset: 54129},
 													name: "VerseBlockParagraphLineElement",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1426, col: 109, offset: 53836},
+											pos:  position{line: 1426, col: 109, offset: 54162},
 											name: "EOL",
 										},
 									},
@@ -11296,83 +11346,83 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraphLineElement",
-			pos:  position{line: 1432, col: 1, offset: 53932},
+			pos:  position{line: 1432, col: 1, offset: 54258},
 			expr: &actionExpr{
-				pos: position{line: 1432, col: 35, offset: 53966},
+				pos: position{line: 1432, col: 35, offset: 54292},
 				run: (*parser).callonVerseBlockParagraphLineElement1,
 				expr: &seqExpr{
-					pos: position{line: 1432, col: 35, offset: 53966},
+					pos: position{line: 1432, col: 35, offset: 54292},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1432, col: 35, offset: 53966},
+							pos: position{line: 1432, col: 35, offset: 54292},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1432, col: 36, offset: 53967},
+								pos:  position{line: 1432, col: 36, offset: 54293},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1432, col: 40, offset: 53971},
+							pos: position{line: 1432, col: 40, offset: 54297},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1432, col: 41, offset: 53972},
+								pos:  position{line: 1432, col: 41, offset: 54298},
 								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1433, col: 5, offset: 53987},
+							pos:   position{line: 1433, col: 5, offset: 54313},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1433, col: 14, offset: 53996},
+								pos: position{line: 1433, col: 14, offset: 54322},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1433, col: 14, offset: 53996},
+										pos:  position{line: 1433, col: 14, offset: 54322},
 										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1434, col: 11, offset: 54014},
+										pos:  position{line: 1434, col: 11, offset: 54340},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1435, col: 11, offset: 54037},
+										pos:  position{line: 1435, col: 11, offset: 54363},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1436, col: 11, offset: 54053},
+										pos:  position{line: 1436, col: 11, offset: 54379},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1437, col: 11, offset: 54076},
+										pos:  position{line: 1437, col: 11, offset: 54402},
 										name: "InlineFootnote",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1438, col: 11, offset: 54102},
+										pos:  position{line: 1438, col: 11, offset: 54428},
 										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1439, col: 11, offset: 54124},
+										pos:  position{line: 1439, col: 11, offset: 54450},
 										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1440, col: 11, offset: 54150},
+										pos:  position{line: 1440, col: 11, offset: 54476},
 										name: "InlineUserMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1441, col: 11, offset: 54177},
+										pos:  position{line: 1441, col: 11, offset: 54503},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1442, col: 11, offset: 54218},
+										pos:  position{line: 1442, col: 11, offset: 54544},
 										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1443, col: 11, offset: 54245},
+										pos:  position{line: 1443, col: 11, offset: 54571},
 										name: "Parenthesis",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1444, col: 11, offset: 54267},
+										pos:  position{line: 1444, col: 11, offset: 54593},
 										name: "AnyChars",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1445, col: 11, offset: 54286},
+										pos:  position{line: 1445, col: 11, offset: 54612},
 										name: "AnyChar",
 									},
 								},
@@ -11384,24 +11434,24 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockDelimiter",
-			pos:  position{line: 1452, col: 1, offset: 54515},
+			pos:  position{line: 1452, col: 1, offset: 54841},
 			expr: &seqExpr{
-				pos: position{line: 1452, col: 26, offset: 54540},
+				pos: position{line: 1452, col: 26, offset: 54866},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1452, col: 26, offset: 54540},
+						pos:        position{line: 1452, col: 26, offset: 54866},
 						val:        "****",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1452, col: 33, offset: 54547},
+						pos: position{line: 1452, col: 33, offset: 54873},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1452, col: 33, offset: 54547},
+							pos:  position{line: 1452, col: 33, offset: 54873},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1452, col: 37, offset: 54551},
+						pos:  position{line: 1452, col: 37, offset: 54877},
 						name: "EOL",
 					},
 				},
@@ -11409,48 +11459,48 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlock",
-			pos:  position{line: 1454, col: 1, offset: 54556},
+			pos:  position{line: 1454, col: 1, offset: 54882},
 			expr: &actionExpr{
-				pos: position{line: 1454, col: 17, offset: 54572},
+				pos: position{line: 1454, col: 17, offset: 54898},
 				run: (*parser).callonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1454, col: 17, offset: 54572},
+					pos: position{line: 1454, col: 17, offset: 54898},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1454, col: 17, offset: 54572},
+							pos:   position{line: 1454, col: 17, offset: 54898},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1454, col: 28, offset: 54583},
+								pos: position{line: 1454, col: 28, offset: 54909},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1454, col: 29, offset: 54584},
+									pos:  position{line: 1454, col: 29, offset: 54910},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1454, col: 49, offset: 54604},
+							pos:  position{line: 1454, col: 49, offset: 54930},
 							name: "SidebarBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1454, col: 71, offset: 54626},
+							pos:   position{line: 1454, col: 71, offset: 54952},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1454, col: 79, offset: 54634},
+								pos: position{line: 1454, col: 79, offset: 54960},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1454, col: 80, offset: 54635},
+									pos:  position{line: 1454, col: 80, offset: 54961},
 									name: "SidebarBlockContent",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1454, col: 104, offset: 54659},
+							pos: position{line: 1454, col: 104, offset: 54985},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1454, col: 104, offset: 54659},
+									pos:  position{line: 1454, col: 104, offset: 54985},
 									name: "SidebarBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1454, col: 128, offset: 54683},
+									pos:  position{line: 1454, col: 128, offset: 55009},
 									name: "EOF",
 								},
 							},
@@ -11461,28 +11511,28 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockContent",
-			pos:  position{line: 1458, col: 1, offset: 54792},
+			pos:  position{line: 1458, col: 1, offset: 55118},
 			expr: &choiceExpr{
-				pos: position{line: 1458, col: 24, offset: 54815},
+				pos: position{line: 1458, col: 24, offset: 55141},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 24, offset: 54815},
+						pos:  position{line: 1458, col: 24, offset: 55141},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 36, offset: 54827},
+						pos:  position{line: 1458, col: 36, offset: 55153},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 52, offset: 54843},
+						pos:  position{line: 1458, col: 52, offset: 55169},
 						name: "ListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 63, offset: 54854},
+						pos:  position{line: 1458, col: 63, offset: 55180},
 						name: "NonSidebarBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 81, offset: 54872},
+						pos:  position{line: 1458, col: 81, offset: 55198},
 						name: "SidebarBlockParagraph",
 					},
 				},
@@ -11490,25 +11540,25 @@ var g = &grammar{
 		},
 		{
 			name: "NonSidebarBlock",
-			pos:  position{line: 1460, col: 1, offset: 54895},
+			pos:  position{line: 1460, col: 1, offset: 55221},
 			expr: &actionExpr{
-				pos: position{line: 1460, col: 20, offset: 54914},
+				pos: position{line: 1460, col: 20, offset: 55240},
 				run: (*parser).callonNonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1460, col: 20, offset: 54914},
+					pos: position{line: 1460, col: 20, offset: 55240},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1460, col: 20, offset: 54914},
+							pos: position{line: 1460, col: 20, offset: 55240},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1460, col: 21, offset: 54915},
+								pos:  position{line: 1460, col: 21, offset: 55241},
 								name: "SidebarBlock",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1460, col: 34, offset: 54928},
+							pos:   position{line: 1460, col: 34, offset: 55254},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1460, col: 43, offset: 54937},
+								pos:  position{line: 1460, col: 43, offset: 55263},
 								name: "DelimitedBlock",
 							},
 						},
@@ -11518,17 +11568,17 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockParagraph",
-			pos:  position{line: 1465, col: 1, offset: 55000},
+			pos:  position{line: 1465, col: 1, offset: 55326},
 			expr: &actionExpr{
-				pos: position{line: 1465, col: 26, offset: 55025},
+				pos: position{line: 1465, col: 26, offset: 55351},
 				run: (*parser).callonSidebarBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1465, col: 26, offset: 55025},
+					pos:   position{line: 1465, col: 26, offset: 55351},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1465, col: 32, offset: 55031},
+						pos: position{line: 1465, col: 32, offset: 55357},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1465, col: 33, offset: 55032},
+							pos:  position{line: 1465, col: 33, offset: 55358},
 							name: "SidebarBlockParagraphLine",
 						},
 					},
@@ -11537,32 +11587,32 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockParagraphLine",
-			pos:  position{line: 1469, col: 1, offset: 55146},
+			pos:  position{line: 1469, col: 1, offset: 55472},
 			expr: &actionExpr{
-				pos: position{line: 1469, col: 30, offset: 55175},
+				pos: position{line: 1469, col: 30, offset: 55501},
 				run: (*parser).callonSidebarBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1469, col: 30, offset: 55175},
+					pos: position{line: 1469, col: 30, offset: 55501},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1469, col: 30, offset: 55175},
+							pos: position{line: 1469, col: 30, offset: 55501},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1469, col: 31, offset: 55176},
+								pos:  position{line: 1469, col: 31, offset: 55502},
 								name: "SidebarBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1469, col: 53, offset: 55198},
+							pos: position{line: 1469, col: 53, offset: 55524},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1469, col: 54, offset: 55199},
+								pos:  position{line: 1469, col: 54, offset: 55525},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1469, col: 64, offset: 55209},
+							pos:   position{line: 1469, col: 64, offset: 55535},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1469, col: 70, offset: 55215},
+								pos:  position{line: 1469, col: 70, offset: 55541},
 								name: "InlineElements",
 							},
 						},
@@ -11572,59 +11622,59 @@ var g = &grammar{
 		},
 		{
 			name: "Table",
-			pos:  position{line: 1477, col: 1, offset: 55446},
+			pos:  position{line: 1477, col: 1, offset: 55772},
 			expr: &actionExpr{
-				pos: position{line: 1477, col: 10, offset: 55455},
+				pos: position{line: 1477, col: 10, offset: 55781},
 				run: (*parser).callonTable1,
 				expr: &seqExpr{
-					pos: position{line: 1477, col: 10, offset: 55455},
+					pos: position{line: 1477, col: 10, offset: 55781},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1477, col: 10, offset: 55455},
+							pos:   position{line: 1477, col: 10, offset: 55781},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1477, col: 21, offset: 55466},
+								pos: position{line: 1477, col: 21, offset: 55792},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1477, col: 22, offset: 55467},
+									pos:  position{line: 1477, col: 22, offset: 55793},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1477, col: 42, offset: 55487},
+							pos:  position{line: 1477, col: 42, offset: 55813},
 							name: "TableDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1478, col: 5, offset: 55506},
+							pos:   position{line: 1478, col: 5, offset: 55832},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1478, col: 12, offset: 55513},
+								pos: position{line: 1478, col: 12, offset: 55839},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1478, col: 13, offset: 55514},
+									pos:  position{line: 1478, col: 13, offset: 55840},
 									name: "TableLineHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1479, col: 5, offset: 55536},
+							pos:   position{line: 1479, col: 5, offset: 55862},
 							label: "lines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1479, col: 11, offset: 55542},
+								pos: position{line: 1479, col: 11, offset: 55868},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1479, col: 12, offset: 55543},
+									pos:  position{line: 1479, col: 12, offset: 55869},
 									name: "TableLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1480, col: 6, offset: 55560},
+							pos: position{line: 1480, col: 6, offset: 55886},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1480, col: 6, offset: 55560},
+									pos:  position{line: 1480, col: 6, offset: 55886},
 									name: "TableDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1480, col: 23, offset: 55577},
+									pos:  position{line: 1480, col: 23, offset: 55903},
 									name: "EOF",
 								},
 							},
@@ -11635,19 +11685,19 @@ var g = &grammar{
 		},
 		{
 			name: "TableCellSeparator",
-			pos:  position{line: 1484, col: 1, offset: 55692},
+			pos:  position{line: 1484, col: 1, offset: 56018},
 			expr: &seqExpr{
-				pos: position{line: 1484, col: 23, offset: 55714},
+				pos: position{line: 1484, col: 23, offset: 56040},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1484, col: 23, offset: 55714},
+						pos:        position{line: 1484, col: 23, offset: 56040},
 						val:        "|",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1484, col: 27, offset: 55718},
+						pos: position{line: 1484, col: 27, offset: 56044},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1484, col: 27, offset: 55718},
+							pos:  position{line: 1484, col: 27, offset: 56044},
 							name: "WS",
 						},
 					},
@@ -11656,24 +11706,24 @@ var g = &grammar{
 		},
 		{
 			name: "TableDelimiter",
-			pos:  position{line: 1486, col: 1, offset: 55723},
+			pos:  position{line: 1486, col: 1, offset: 56049},
 			expr: &seqExpr{
-				pos: position{line: 1486, col: 19, offset: 55741},
+				pos: position{line: 1486, col: 19, offset: 56067},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1486, col: 19, offset: 55741},
+						pos:        position{line: 1486, col: 19, offset: 56067},
 						val:        "|===",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1486, col: 26, offset: 55748},
+						pos: position{line: 1486, col: 26, offset: 56074},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1486, col: 26, offset: 55748},
+							pos:  position{line: 1486, col: 26, offset: 56074},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1486, col: 30, offset: 55752},
+						pos:  position{line: 1486, col: 30, offset: 56078},
 						name: "EOL",
 					},
 				},
@@ -11681,37 +11731,37 @@ var g = &grammar{
 		},
 		{
 			name: "TableLineHeader",
-			pos:  position{line: 1489, col: 1, offset: 55820},
+			pos:  position{line: 1489, col: 1, offset: 56146},
 			expr: &actionExpr{
-				pos: position{line: 1489, col: 20, offset: 55839},
+				pos: position{line: 1489, col: 20, offset: 56165},
 				run: (*parser).callonTableLineHeader1,
 				expr: &seqExpr{
-					pos: position{line: 1489, col: 20, offset: 55839},
+					pos: position{line: 1489, col: 20, offset: 56165},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1489, col: 20, offset: 55839},
+							pos: position{line: 1489, col: 20, offset: 56165},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1489, col: 21, offset: 55840},
+								pos:  position{line: 1489, col: 21, offset: 56166},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1489, col: 36, offset: 55855},
+							pos:   position{line: 1489, col: 36, offset: 56181},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1489, col: 42, offset: 55861},
+								pos: position{line: 1489, col: 42, offset: 56187},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1489, col: 43, offset: 55862},
+									pos:  position{line: 1489, col: 43, offset: 56188},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1489, col: 55, offset: 55874},
+							pos:  position{line: 1489, col: 55, offset: 56200},
 							name: "EOL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1489, col: 59, offset: 55878},
+							pos:  position{line: 1489, col: 59, offset: 56204},
 							name: "BlankLine",
 						},
 					},
@@ -11720,39 +11770,39 @@ var g = &grammar{
 		},
 		{
 			name: "TableLine",
-			pos:  position{line: 1493, col: 1, offset: 55946},
+			pos:  position{line: 1493, col: 1, offset: 56272},
 			expr: &actionExpr{
-				pos: position{line: 1493, col: 14, offset: 55959},
+				pos: position{line: 1493, col: 14, offset: 56285},
 				run: (*parser).callonTableLine1,
 				expr: &seqExpr{
-					pos: position{line: 1493, col: 14, offset: 55959},
+					pos: position{line: 1493, col: 14, offset: 56285},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1493, col: 14, offset: 55959},
+							pos: position{line: 1493, col: 14, offset: 56285},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1493, col: 15, offset: 55960},
+								pos:  position{line: 1493, col: 15, offset: 56286},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1493, col: 30, offset: 55975},
+							pos:   position{line: 1493, col: 30, offset: 56301},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1493, col: 36, offset: 55981},
+								pos: position{line: 1493, col: 36, offset: 56307},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1493, col: 37, offset: 55982},
+									pos:  position{line: 1493, col: 37, offset: 56308},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1493, col: 49, offset: 55994},
+							pos:  position{line: 1493, col: 49, offset: 56320},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1493, col: 53, offset: 55998},
+							pos: position{line: 1493, col: 53, offset: 56324},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1493, col: 53, offset: 55998},
+								pos:  position{line: 1493, col: 53, offset: 56324},
 								name: "BlankLine",
 							},
 						},
@@ -11762,54 +11812,54 @@ var g = &grammar{
 		},
 		{
 			name: "TableCell",
-			pos:  position{line: 1497, col: 1, offset: 56067},
+			pos:  position{line: 1497, col: 1, offset: 56393},
 			expr: &actionExpr{
-				pos: position{line: 1497, col: 14, offset: 56080},
+				pos: position{line: 1497, col: 14, offset: 56406},
 				run: (*parser).callonTableCell1,
 				expr: &seqExpr{
-					pos: position{line: 1497, col: 14, offset: 56080},
+					pos: position{line: 1497, col: 14, offset: 56406},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1497, col: 14, offset: 56080},
+							pos:  position{line: 1497, col: 14, offset: 56406},
 							name: "TableCellSeparator",
 						},
 						&labeledExpr{
-							pos:   position{line: 1497, col: 33, offset: 56099},
+							pos:   position{line: 1497, col: 33, offset: 56425},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1497, col: 42, offset: 56108},
+								pos: position{line: 1497, col: 42, offset: 56434},
 								expr: &seqExpr{
-									pos: position{line: 1497, col: 43, offset: 56109},
+									pos: position{line: 1497, col: 43, offset: 56435},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1497, col: 43, offset: 56109},
+											pos: position{line: 1497, col: 43, offset: 56435},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1497, col: 44, offset: 56110},
+												pos:  position{line: 1497, col: 44, offset: 56436},
 												name: "TableCellSeparator",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1497, col: 63, offset: 56129},
+											pos: position{line: 1497, col: 63, offset: 56455},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1497, col: 64, offset: 56130},
+												pos:  position{line: 1497, col: 64, offset: 56456},
 												name: "EOL",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1497, col: 68, offset: 56134},
+											pos: position{line: 1497, col: 68, offset: 56460},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1497, col: 68, offset: 56134},
+												pos:  position{line: 1497, col: 68, offset: 56460},
 												name: "WS",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1497, col: 72, offset: 56138},
+											pos:  position{line: 1497, col: 72, offset: 56464},
 											name: "InlineElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1497, col: 86, offset: 56152},
+											pos: position{line: 1497, col: 86, offset: 56478},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1497, col: 86, offset: 56152},
+												pos:  position{line: 1497, col: 86, offset: 56478},
 												name: "WS",
 											},
 										},
@@ -11823,73 +11873,73 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockDelimiter",
-			pos:  position{line: 1504, col: 1, offset: 56398},
+			pos:  position{line: 1504, col: 1, offset: 56724},
 			expr: &litMatcher{
-				pos:        position{line: 1504, col: 26, offset: 56423},
+				pos:        position{line: 1504, col: 26, offset: 56749},
 				val:        "////",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "CommentBlock",
-			pos:  position{line: 1506, col: 1, offset: 56431},
+			pos:  position{line: 1506, col: 1, offset: 56757},
 			expr: &actionExpr{
-				pos: position{line: 1506, col: 17, offset: 56447},
+				pos: position{line: 1506, col: 17, offset: 56773},
 				run: (*parser).callonCommentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1506, col: 17, offset: 56447},
+					pos: position{line: 1506, col: 17, offset: 56773},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1506, col: 17, offset: 56447},
+							pos:  position{line: 1506, col: 17, offset: 56773},
 							name: "CommentBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1506, col: 39, offset: 56469},
+							pos: position{line: 1506, col: 39, offset: 56795},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1506, col: 39, offset: 56469},
+								pos:  position{line: 1506, col: 39, offset: 56795},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1506, col: 43, offset: 56473},
+							pos:  position{line: 1506, col: 43, offset: 56799},
 							name: "Newline",
 						},
 						&labeledExpr{
-							pos:   position{line: 1506, col: 51, offset: 56481},
+							pos:   position{line: 1506, col: 51, offset: 56807},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1506, col: 59, offset: 56489},
+								pos: position{line: 1506, col: 59, offset: 56815},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1506, col: 60, offset: 56490},
+									pos:  position{line: 1506, col: 60, offset: 56816},
 									name: "CommentBlockLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1506, col: 81, offset: 56511},
+							pos: position{line: 1506, col: 81, offset: 56837},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1506, col: 82, offset: 56512},
+									pos: position{line: 1506, col: 82, offset: 56838},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1506, col: 82, offset: 56512},
+											pos:  position{line: 1506, col: 82, offset: 56838},
 											name: "CommentBlockDelimiter",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1506, col: 104, offset: 56534},
+											pos: position{line: 1506, col: 104, offset: 56860},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1506, col: 104, offset: 56534},
+												pos:  position{line: 1506, col: 104, offset: 56860},
 												name: "WS",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1506, col: 108, offset: 56538},
+											pos:  position{line: 1506, col: 108, offset: 56864},
 											name: "EOL",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1506, col: 115, offset: 56545},
+									pos:  position{line: 1506, col: 115, offset: 56871},
 									name: "EOF",
 								},
 							},
@@ -11900,45 +11950,45 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockLine",
-			pos:  position{line: 1510, col: 1, offset: 56651},
+			pos:  position{line: 1510, col: 1, offset: 56977},
 			expr: &actionExpr{
-				pos: position{line: 1510, col: 21, offset: 56671},
+				pos: position{line: 1510, col: 21, offset: 56997},
 				run: (*parser).callonCommentBlockLine1,
 				expr: &seqExpr{
-					pos: position{line: 1510, col: 21, offset: 56671},
+					pos: position{line: 1510, col: 21, offset: 56997},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 1510, col: 21, offset: 56671},
+							pos: position{line: 1510, col: 21, offset: 56997},
 							expr: &choiceExpr{
-								pos: position{line: 1510, col: 22, offset: 56672},
+								pos: position{line: 1510, col: 22, offset: 56998},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1510, col: 22, offset: 56672},
+										pos:  position{line: 1510, col: 22, offset: 56998},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1510, col: 34, offset: 56684},
+										pos:  position{line: 1510, col: 34, offset: 57010},
 										name: "Spaces",
 									},
 									&seqExpr{
-										pos: position{line: 1510, col: 44, offset: 56694},
+										pos: position{line: 1510, col: 44, offset: 57020},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1510, col: 44, offset: 56694},
+												pos: position{line: 1510, col: 44, offset: 57020},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1510, col: 45, offset: 56695},
+													pos:  position{line: 1510, col: 45, offset: 57021},
 													name: "CommentBlockDelimiter",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1510, col: 67, offset: 56717},
+												pos: position{line: 1510, col: 67, offset: 57043},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1510, col: 68, offset: 56718},
+													pos:  position{line: 1510, col: 68, offset: 57044},
 													name: "EOL",
 												},
 											},
 											&anyMatcher{
-												line: 1510, col: 72, offset: 56722,
+												line: 1510, col: 72, offset: 57048,
 											},
 										},
 									},
@@ -11946,7 +11996,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1510, col: 77, offset: 56727},
+							pos:  position{line: 1510, col: 77, offset: 57053},
 							name: "EOL",
 						},
 					},
@@ -11955,42 +12005,42 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1514, col: 1, offset: 56767},
+			pos:  position{line: 1514, col: 1, offset: 57093},
 			expr: &actionExpr{
-				pos: position{line: 1514, col: 22, offset: 56788},
+				pos: position{line: 1514, col: 22, offset: 57114},
 				run: (*parser).callonSingleLineComment1,
 				expr: &seqExpr{
-					pos: position{line: 1514, col: 22, offset: 56788},
+					pos: position{line: 1514, col: 22, offset: 57114},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1514, col: 22, offset: 56788},
+							pos: position{line: 1514, col: 22, offset: 57114},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1514, col: 23, offset: 56789},
+								pos:  position{line: 1514, col: 23, offset: 57115},
 								name: "CommentBlockDelimiter",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1514, col: 45, offset: 56811},
+							pos: position{line: 1514, col: 45, offset: 57137},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1514, col: 45, offset: 56811},
+								pos:  position{line: 1514, col: 45, offset: 57137},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1514, col: 49, offset: 56815},
+							pos:        position{line: 1514, col: 49, offset: 57141},
 							val:        "//",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1514, col: 54, offset: 56820},
+							pos:   position{line: 1514, col: 54, offset: 57146},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1514, col: 63, offset: 56829},
+								pos:  position{line: 1514, col: 63, offset: 57155},
 								name: "SingleLineCommentContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1514, col: 89, offset: 56855},
+							pos:  position{line: 1514, col: 89, offset: 57181},
 							name: "EOL",
 						},
 					},
@@ -11999,35 +12049,35 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineCommentContent",
-			pos:  position{line: 1518, col: 1, offset: 56920},
+			pos:  position{line: 1518, col: 1, offset: 57246},
 			expr: &actionExpr{
-				pos: position{line: 1518, col: 29, offset: 56948},
+				pos: position{line: 1518, col: 29, offset: 57274},
 				run: (*parser).callonSingleLineCommentContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1518, col: 29, offset: 56948},
+					pos: position{line: 1518, col: 29, offset: 57274},
 					expr: &choiceExpr{
-						pos: position{line: 1518, col: 30, offset: 56949},
+						pos: position{line: 1518, col: 30, offset: 57275},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1518, col: 30, offset: 56949},
+								pos:  position{line: 1518, col: 30, offset: 57275},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1518, col: 42, offset: 56961},
+								pos:  position{line: 1518, col: 42, offset: 57287},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1518, col: 52, offset: 56971},
+								pos: position{line: 1518, col: 52, offset: 57297},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1518, col: 52, offset: 56971},
+										pos: position{line: 1518, col: 52, offset: 57297},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1518, col: 53, offset: 56972},
+											pos:  position{line: 1518, col: 53, offset: 57298},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 1518, col: 58, offset: 56977,
+										line: 1518, col: 58, offset: 57303,
 									},
 								},
 							},
@@ -12038,20 +12088,20 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlock",
-			pos:  position{line: 1526, col: 1, offset: 57286},
+			pos:  position{line: 1526, col: 1, offset: 57612},
 			expr: &choiceExpr{
-				pos: position{line: 1526, col: 17, offset: 57302},
+				pos: position{line: 1526, col: 17, offset: 57628},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1526, col: 17, offset: 57302},
+						pos:  position{line: 1526, col: 17, offset: 57628},
 						name: "ParagraphWithLiteralAttribute",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1526, col: 49, offset: 57334},
+						pos:  position{line: 1526, col: 49, offset: 57660},
 						name: "ParagraphWithHeadingSpaces",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1526, col: 78, offset: 57363},
+						pos:  position{line: 1526, col: 78, offset: 57689},
 						name: "ParagraphWithLiteralBlockDelimiter",
 					},
 				},
@@ -12059,38 +12109,38 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlockDelimiter",
-			pos:  position{line: 1528, col: 1, offset: 57399},
+			pos:  position{line: 1528, col: 1, offset: 57725},
 			expr: &litMatcher{
-				pos:        position{line: 1528, col: 26, offset: 57424},
+				pos:        position{line: 1528, col: 26, offset: 57750},
 				val:        "....",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "ParagraphWithHeadingSpaces",
-			pos:  position{line: 1531, col: 1, offset: 57496},
+			pos:  position{line: 1531, col: 1, offset: 57822},
 			expr: &actionExpr{
-				pos: position{line: 1531, col: 31, offset: 57526},
+				pos: position{line: 1531, col: 31, offset: 57852},
 				run: (*parser).callonParagraphWithHeadingSpaces1,
 				expr: &seqExpr{
-					pos: position{line: 1531, col: 31, offset: 57526},
+					pos: position{line: 1531, col: 31, offset: 57852},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1531, col: 31, offset: 57526},
+							pos:   position{line: 1531, col: 31, offset: 57852},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1531, col: 42, offset: 57537},
+								pos: position{line: 1531, col: 42, offset: 57863},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1531, col: 43, offset: 57538},
+									pos:  position{line: 1531, col: 43, offset: 57864},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1531, col: 63, offset: 57558},
+							pos:   position{line: 1531, col: 63, offset: 57884},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1531, col: 70, offset: 57565},
+								pos:  position{line: 1531, col: 70, offset: 57891},
 								name: "ParagraphWithHeadingSpacesLines",
 							},
 						},
@@ -12100,54 +12150,54 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpacesLines",
-			pos:  position{line: 1536, col: 1, offset: 57795},
+			pos:  position{line: 1536, col: 1, offset: 58121},
 			expr: &actionExpr{
-				pos: position{line: 1537, col: 5, offset: 57835},
+				pos: position{line: 1537, col: 5, offset: 58161},
 				run: (*parser).callonParagraphWithHeadingSpacesLines1,
 				expr: &seqExpr{
-					pos: position{line: 1537, col: 5, offset: 57835},
+					pos: position{line: 1537, col: 5, offset: 58161},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1537, col: 5, offset: 57835},
+							pos:   position{line: 1537, col: 5, offset: 58161},
 							label: "firstLine",
 							expr: &actionExpr{
-								pos: position{line: 1537, col: 16, offset: 57846},
+								pos: position{line: 1537, col: 16, offset: 58172},
 								run: (*parser).callonParagraphWithHeadingSpacesLines4,
 								expr: &seqExpr{
-									pos: position{line: 1537, col: 16, offset: 57846},
+									pos: position{line: 1537, col: 16, offset: 58172},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1537, col: 16, offset: 57846},
+											pos:  position{line: 1537, col: 16, offset: 58172},
 											name: "WS",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1537, col: 19, offset: 57849},
+											pos: position{line: 1537, col: 19, offset: 58175},
 											expr: &choiceExpr{
-												pos: position{line: 1537, col: 20, offset: 57850},
+												pos: position{line: 1537, col: 20, offset: 58176},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1537, col: 20, offset: 57850},
+														pos:  position{line: 1537, col: 20, offset: 58176},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1537, col: 32, offset: 57862},
+														pos:  position{line: 1537, col: 32, offset: 58188},
 														name: "Spaces",
 													},
 													&actionExpr{
-														pos: position{line: 1537, col: 41, offset: 57871},
+														pos: position{line: 1537, col: 41, offset: 58197},
 														run: (*parser).callonParagraphWithHeadingSpacesLines11,
 														expr: &seqExpr{
-															pos: position{line: 1537, col: 42, offset: 57872},
+															pos: position{line: 1537, col: 42, offset: 58198},
 															exprs: []interface{}{
 																&notExpr{
-																	pos: position{line: 1537, col: 42, offset: 57872},
+																	pos: position{line: 1537, col: 42, offset: 58198},
 																	expr: &ruleRefExpr{
-																		pos:  position{line: 1537, col: 43, offset: 57873},
+																		pos:  position{line: 1537, col: 43, offset: 58199},
 																		name: "EOL",
 																	},
 																},
 																&anyMatcher{
-																	line: 1537, col: 48, offset: 57878,
+																	line: 1537, col: 48, offset: 58204,
 																},
 															},
 														},
@@ -12160,58 +12210,58 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1541, col: 8, offset: 57969},
+							pos:  position{line: 1541, col: 8, offset: 58295},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 1542, col: 5, offset: 58032},
+							pos:   position{line: 1542, col: 5, offset: 58358},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1542, col: 16, offset: 58043},
+								pos: position{line: 1542, col: 16, offset: 58369},
 								expr: &actionExpr{
-									pos: position{line: 1543, col: 9, offset: 58053},
+									pos: position{line: 1543, col: 9, offset: 58379},
 									run: (*parser).callonParagraphWithHeadingSpacesLines19,
 									expr: &seqExpr{
-										pos: position{line: 1543, col: 9, offset: 58053},
+										pos: position{line: 1543, col: 9, offset: 58379},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1543, col: 9, offset: 58053},
+												pos: position{line: 1543, col: 9, offset: 58379},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1543, col: 10, offset: 58054},
+													pos:  position{line: 1543, col: 10, offset: 58380},
 													name: "BlankLine",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1544, col: 9, offset: 58073},
+												pos:   position{line: 1544, col: 9, offset: 58399},
 												label: "otherLine",
 												expr: &actionExpr{
-													pos: position{line: 1544, col: 20, offset: 58084},
+													pos: position{line: 1544, col: 20, offset: 58410},
 													run: (*parser).callonParagraphWithHeadingSpacesLines24,
 													expr: &oneOrMoreExpr{
-														pos: position{line: 1544, col: 20, offset: 58084},
+														pos: position{line: 1544, col: 20, offset: 58410},
 														expr: &choiceExpr{
-															pos: position{line: 1544, col: 21, offset: 58085},
+															pos: position{line: 1544, col: 21, offset: 58411},
 															alternatives: []interface{}{
 																&ruleRefExpr{
-																	pos:  position{line: 1544, col: 21, offset: 58085},
+																	pos:  position{line: 1544, col: 21, offset: 58411},
 																	name: "Alphanums",
 																},
 																&ruleRefExpr{
-																	pos:  position{line: 1544, col: 33, offset: 58097},
+																	pos:  position{line: 1544, col: 33, offset: 58423},
 																	name: "Spaces",
 																},
 																&seqExpr{
-																	pos: position{line: 1544, col: 43, offset: 58107},
+																	pos: position{line: 1544, col: 43, offset: 58433},
 																	exprs: []interface{}{
 																		&notExpr{
-																			pos: position{line: 1544, col: 43, offset: 58107},
+																			pos: position{line: 1544, col: 43, offset: 58433},
 																			expr: &ruleRefExpr{
-																				pos:  position{line: 1544, col: 44, offset: 58108},
+																				pos:  position{line: 1544, col: 44, offset: 58434},
 																				name: "EOL",
 																			},
 																		},
 																		&anyMatcher{
-																			line: 1544, col: 49, offset: 58113,
+																			line: 1544, col: 49, offset: 58439,
 																		},
 																	},
 																},
@@ -12221,7 +12271,7 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1546, col: 12, offset: 58170},
+												pos:  position{line: 1546, col: 12, offset: 58496},
 												name: "EOL",
 											},
 										},
@@ -12235,72 +12285,72 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 1553, col: 1, offset: 58400},
+			pos:  position{line: 1553, col: 1, offset: 58726},
 			expr: &actionExpr{
-				pos: position{line: 1553, col: 39, offset: 58438},
+				pos: position{line: 1553, col: 39, offset: 58764},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
 				expr: &seqExpr{
-					pos: position{line: 1553, col: 39, offset: 58438},
+					pos: position{line: 1553, col: 39, offset: 58764},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1553, col: 39, offset: 58438},
+							pos:   position{line: 1553, col: 39, offset: 58764},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1553, col: 50, offset: 58449},
+								pos: position{line: 1553, col: 50, offset: 58775},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1553, col: 51, offset: 58450},
+									pos:  position{line: 1553, col: 51, offset: 58776},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1554, col: 9, offset: 58478},
+							pos:  position{line: 1554, col: 9, offset: 58804},
 							name: "LiteralBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1554, col: 31, offset: 58500},
+							pos: position{line: 1554, col: 31, offset: 58826},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1554, col: 31, offset: 58500},
+								pos:  position{line: 1554, col: 31, offset: 58826},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1554, col: 35, offset: 58504},
+							pos:  position{line: 1554, col: 35, offset: 58830},
 							name: "Newline",
 						},
 						&labeledExpr{
-							pos:   position{line: 1554, col: 43, offset: 58512},
+							pos:   position{line: 1554, col: 43, offset: 58838},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1554, col: 50, offset: 58519},
+								pos:  position{line: 1554, col: 50, offset: 58845},
 								name: "ParagraphWithLiteralBlockDelimiterLines",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1554, col: 92, offset: 58561},
+							pos: position{line: 1554, col: 92, offset: 58887},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1554, col: 93, offset: 58562},
+									pos: position{line: 1554, col: 93, offset: 58888},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1554, col: 93, offset: 58562},
+											pos:  position{line: 1554, col: 93, offset: 58888},
 											name: "LiteralBlockDelimiter",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1554, col: 115, offset: 58584},
+											pos: position{line: 1554, col: 115, offset: 58910},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1554, col: 115, offset: 58584},
+												pos:  position{line: 1554, col: 115, offset: 58910},
 												name: "WS",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1554, col: 119, offset: 58588},
+											pos:  position{line: 1554, col: 119, offset: 58914},
 											name: "EOL",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1554, col: 126, offset: 58595},
+									pos:  position{line: 1554, col: 126, offset: 58921},
 									name: "EOF",
 								},
 							},
@@ -12311,17 +12361,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLines",
-			pos:  position{line: 1559, col: 1, offset: 58754},
+			pos:  position{line: 1559, col: 1, offset: 59080},
 			expr: &actionExpr{
-				pos: position{line: 1559, col: 44, offset: 58797},
+				pos: position{line: 1559, col: 44, offset: 59123},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1559, col: 44, offset: 58797},
+					pos:   position{line: 1559, col: 44, offset: 59123},
 					label: "lines",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 1559, col: 50, offset: 58803},
+						pos: position{line: 1559, col: 50, offset: 59129},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1559, col: 51, offset: 58804},
+							pos:  position{line: 1559, col: 51, offset: 59130},
 							name: "ParagraphWithLiteralBlockDelimiterLine",
 						},
 					},
@@ -12330,51 +12380,51 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLine",
-			pos:  position{line: 1563, col: 1, offset: 58888},
+			pos:  position{line: 1563, col: 1, offset: 59214},
 			expr: &actionExpr{
-				pos: position{line: 1564, col: 5, offset: 58943},
+				pos: position{line: 1564, col: 5, offset: 59269},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
 				expr: &seqExpr{
-					pos: position{line: 1564, col: 5, offset: 58943},
+					pos: position{line: 1564, col: 5, offset: 59269},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1564, col: 5, offset: 58943},
+							pos:   position{line: 1564, col: 5, offset: 59269},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1564, col: 11, offset: 58949},
+								pos: position{line: 1564, col: 11, offset: 59275},
 								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
 								expr: &zeroOrMoreExpr{
-									pos: position{line: 1564, col: 11, offset: 58949},
+									pos: position{line: 1564, col: 11, offset: 59275},
 									expr: &choiceExpr{
-										pos: position{line: 1564, col: 12, offset: 58950},
+										pos: position{line: 1564, col: 12, offset: 59276},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1564, col: 12, offset: 58950},
+												pos:  position{line: 1564, col: 12, offset: 59276},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1564, col: 24, offset: 58962},
+												pos:  position{line: 1564, col: 24, offset: 59288},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 1564, col: 34, offset: 58972},
+												pos: position{line: 1564, col: 34, offset: 59298},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 1564, col: 34, offset: 58972},
+														pos: position{line: 1564, col: 34, offset: 59298},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1564, col: 35, offset: 58973},
+															pos:  position{line: 1564, col: 35, offset: 59299},
 															name: "LiteralBlockDelimiter",
 														},
 													},
 													&notExpr{
-														pos: position{line: 1564, col: 57, offset: 58995},
+														pos: position{line: 1564, col: 57, offset: 59321},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1564, col: 58, offset: 58996},
+															pos:  position{line: 1564, col: 58, offset: 59322},
 															name: "EOL",
 														},
 													},
 													&anyMatcher{
-														line: 1564, col: 62, offset: 59000,
+														line: 1564, col: 62, offset: 59326,
 													},
 												},
 											},
@@ -12384,7 +12434,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1566, col: 8, offset: 59049},
+							pos:  position{line: 1566, col: 8, offset: 59375},
 							name: "EOL",
 						},
 					},
@@ -12393,33 +12443,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 1571, col: 1, offset: 59175},
+			pos:  position{line: 1571, col: 1, offset: 59501},
 			expr: &actionExpr{
-				pos: position{line: 1572, col: 5, offset: 59213},
+				pos: position{line: 1572, col: 5, offset: 59539},
 				run: (*parser).callonParagraphWithLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 1572, col: 5, offset: 59213},
+					pos: position{line: 1572, col: 5, offset: 59539},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1572, col: 5, offset: 59213},
+							pos:   position{line: 1572, col: 5, offset: 59539},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1572, col: 16, offset: 59224},
+								pos: position{line: 1572, col: 16, offset: 59550},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1572, col: 17, offset: 59225},
+									pos:  position{line: 1572, col: 17, offset: 59551},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1573, col: 5, offset: 59249},
+							pos: position{line: 1573, col: 5, offset: 59575},
 							run: (*parser).callonParagraphWithLiteralAttribute6,
 						},
 						&labeledExpr{
-							pos:   position{line: 1580, col: 5, offset: 59463},
+							pos:   position{line: 1580, col: 5, offset: 59789},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1580, col: 12, offset: 59470},
+								pos:  position{line: 1580, col: 12, offset: 59796},
 								name: "ParagraphWithLiteralAttributeLines",
 							},
 						},
@@ -12429,12 +12479,12 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralKind",
-			pos:  position{line: 1584, col: 1, offset: 59620},
+			pos:  position{line: 1584, col: 1, offset: 59946},
 			expr: &actionExpr{
-				pos: position{line: 1584, col: 16, offset: 59635},
+				pos: position{line: 1584, col: 16, offset: 59961},
 				run: (*parser).callonLiteralKind1,
 				expr: &litMatcher{
-					pos:        position{line: 1584, col: 16, offset: 59635},
+					pos:        position{line: 1584, col: 16, offset: 59961},
 					val:        "literal",
 					ignoreCase: false,
 				},
@@ -12442,17 +12492,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLines",
-			pos:  position{line: 1589, col: 1, offset: 59718},
+			pos:  position{line: 1589, col: 1, offset: 60044},
 			expr: &actionExpr{
-				pos: position{line: 1589, col: 39, offset: 59756},
+				pos: position{line: 1589, col: 39, offset: 60082},
 				run: (*parser).callonParagraphWithLiteralAttributeLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1589, col: 39, offset: 59756},
+					pos:   position{line: 1589, col: 39, offset: 60082},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1589, col: 45, offset: 59762},
+						pos: position{line: 1589, col: 45, offset: 60088},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1589, col: 46, offset: 59763},
+							pos:  position{line: 1589, col: 46, offset: 60089},
 							name: "ParagraphWithLiteralAttributeLine",
 						},
 					},
@@ -12461,54 +12511,54 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLine",
-			pos:  position{line: 1593, col: 1, offset: 59843},
+			pos:  position{line: 1593, col: 1, offset: 60169},
 			expr: &actionExpr{
-				pos: position{line: 1593, col: 38, offset: 59880},
+				pos: position{line: 1593, col: 38, offset: 60206},
 				run: (*parser).callonParagraphWithLiteralAttributeLine1,
 				expr: &seqExpr{
-					pos: position{line: 1593, col: 38, offset: 59880},
+					pos: position{line: 1593, col: 38, offset: 60206},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1593, col: 38, offset: 59880},
+							pos:   position{line: 1593, col: 38, offset: 60206},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1593, col: 44, offset: 59886},
+								pos: position{line: 1593, col: 44, offset: 60212},
 								run: (*parser).callonParagraphWithLiteralAttributeLine4,
 								expr: &seqExpr{
-									pos: position{line: 1593, col: 44, offset: 59886},
+									pos: position{line: 1593, col: 44, offset: 60212},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1593, col: 44, offset: 59886},
+											pos: position{line: 1593, col: 44, offset: 60212},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1593, col: 46, offset: 59888},
+												pos:  position{line: 1593, col: 46, offset: 60214},
 												name: "BlankLine",
 											},
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1593, col: 57, offset: 59899},
+											pos: position{line: 1593, col: 57, offset: 60225},
 											expr: &choiceExpr{
-												pos: position{line: 1593, col: 58, offset: 59900},
+												pos: position{line: 1593, col: 58, offset: 60226},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1593, col: 58, offset: 59900},
+														pos:  position{line: 1593, col: 58, offset: 60226},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1593, col: 70, offset: 59912},
+														pos:  position{line: 1593, col: 70, offset: 60238},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 1593, col: 80, offset: 59922},
+														pos: position{line: 1593, col: 80, offset: 60248},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 1593, col: 80, offset: 59922},
+																pos: position{line: 1593, col: 80, offset: 60248},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 1593, col: 81, offset: 59923},
+																	pos:  position{line: 1593, col: 81, offset: 60249},
 																	name: "EOL",
 																},
 															},
 															&anyMatcher{
-																line: 1593, col: 86, offset: 59928,
+																line: 1593, col: 86, offset: 60254,
 															},
 														},
 													},
@@ -12520,7 +12570,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1595, col: 4, offset: 59969},
+							pos:  position{line: 1595, col: 4, offset: 60295},
 							name: "EOL",
 						},
 					},
@@ -12529,28 +12579,28 @@ var g = &grammar{
 		},
 		{
 			name: "IndexTerm",
-			pos:  position{line: 1602, col: 1, offset: 60143},
+			pos:  position{line: 1602, col: 1, offset: 60469},
 			expr: &actionExpr{
-				pos: position{line: 1602, col: 14, offset: 60156},
+				pos: position{line: 1602, col: 14, offset: 60482},
 				run: (*parser).callonIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1602, col: 14, offset: 60156},
+					pos: position{line: 1602, col: 14, offset: 60482},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1602, col: 14, offset: 60156},
+							pos:        position{line: 1602, col: 14, offset: 60482},
 							val:        "((",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1602, col: 19, offset: 60161},
+							pos:   position{line: 1602, col: 19, offset: 60487},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1602, col: 25, offset: 60167},
+								pos:  position{line: 1602, col: 25, offset: 60493},
 								name: "IndexTermContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1602, col: 43, offset: 60185},
+							pos:        position{line: 1602, col: 43, offset: 60511},
 							val:        "))",
 							ignoreCase: false,
 						},
@@ -12560,52 +12610,52 @@ var g = &grammar{
 		},
 		{
 			name: "IndexTermContent",
-			pos:  position{line: 1606, col: 1, offset: 60250},
+			pos:  position{line: 1606, col: 1, offset: 60576},
 			expr: &actionExpr{
-				pos: position{line: 1606, col: 21, offset: 60270},
+				pos: position{line: 1606, col: 21, offset: 60596},
 				run: (*parser).callonIndexTermContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1606, col: 21, offset: 60270},
+					pos:   position{line: 1606, col: 21, offset: 60596},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1606, col: 30, offset: 60279},
+						pos: position{line: 1606, col: 30, offset: 60605},
 						expr: &choiceExpr{
-							pos: position{line: 1606, col: 31, offset: 60280},
+							pos: position{line: 1606, col: 31, offset: 60606},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1606, col: 31, offset: 60280},
+									pos:  position{line: 1606, col: 31, offset: 60606},
 									name: "SimpleWord",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1606, col: 44, offset: 60293},
+									pos:  position{line: 1606, col: 44, offset: 60619},
 									name: "QuotedText",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1606, col: 57, offset: 60306},
+									pos:  position{line: 1606, col: 57, offset: 60632},
 									name: "WS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1606, col: 62, offset: 60311},
+									pos:  position{line: 1606, col: 62, offset: 60637},
 									name: "AnyChars",
 								},
 								&actionExpr{
-									pos: position{line: 1606, col: 73, offset: 60322},
+									pos: position{line: 1606, col: 73, offset: 60648},
 									run: (*parser).callonIndexTermContent9,
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1606, col: 73, offset: 60322},
+										pos: position{line: 1606, col: 73, offset: 60648},
 										expr: &seqExpr{
-											pos: position{line: 1606, col: 74, offset: 60323},
+											pos: position{line: 1606, col: 74, offset: 60649},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1606, col: 74, offset: 60323},
+													pos: position{line: 1606, col: 74, offset: 60649},
 													expr: &litMatcher{
-														pos:        position{line: 1606, col: 75, offset: 60324},
+														pos:        position{line: 1606, col: 75, offset: 60650},
 														val:        "))",
 														ignoreCase: false,
 													},
 												},
 												&anyMatcher{
-													line: 1606, col: 80, offset: 60329,
+													line: 1606, col: 80, offset: 60655,
 												},
 											},
 										},
@@ -12619,61 +12669,61 @@ var g = &grammar{
 		},
 		{
 			name: "ConcealedIndexTerm",
-			pos:  position{line: 1612, col: 1, offset: 60436},
+			pos:  position{line: 1612, col: 1, offset: 60762},
 			expr: &actionExpr{
-				pos: position{line: 1612, col: 23, offset: 60458},
+				pos: position{line: 1612, col: 23, offset: 60784},
 				run: (*parser).callonConcealedIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1612, col: 23, offset: 60458},
+					pos: position{line: 1612, col: 23, offset: 60784},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1612, col: 23, offset: 60458},
+							pos:        position{line: 1612, col: 23, offset: 60784},
 							val:        "(((",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1612, col: 29, offset: 60464},
+							pos:   position{line: 1612, col: 29, offset: 60790},
 							label: "term1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1612, col: 36, offset: 60471},
+								pos:  position{line: 1612, col: 36, offset: 60797},
 								name: "ConcealedIndexTermContent",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1613, col: 5, offset: 60503},
+							pos:   position{line: 1613, col: 5, offset: 60829},
 							label: "term2",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1613, col: 11, offset: 60509},
+								pos: position{line: 1613, col: 11, offset: 60835},
 								expr: &actionExpr{
-									pos: position{line: 1613, col: 12, offset: 60510},
+									pos: position{line: 1613, col: 12, offset: 60836},
 									run: (*parser).callonConcealedIndexTerm8,
 									expr: &seqExpr{
-										pos: position{line: 1613, col: 12, offset: 60510},
+										pos: position{line: 1613, col: 12, offset: 60836},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1613, col: 12, offset: 60510},
+												pos: position{line: 1613, col: 12, offset: 60836},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1613, col: 12, offset: 60510},
+													pos:  position{line: 1613, col: 12, offset: 60836},
 													name: "WS",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1613, col: 16, offset: 60514},
+												pos:        position{line: 1613, col: 16, offset: 60840},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1613, col: 20, offset: 60518},
+												pos: position{line: 1613, col: 20, offset: 60844},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1613, col: 20, offset: 60518},
+													pos:  position{line: 1613, col: 20, offset: 60844},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1613, col: 24, offset: 60522},
+												pos:   position{line: 1613, col: 24, offset: 60848},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1613, col: 33, offset: 60531},
+													pos:  position{line: 1613, col: 33, offset: 60857},
 													name: "ConcealedIndexTermContent",
 												},
 											},
@@ -12683,40 +12733,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1614, col: 5, offset: 60589},
+							pos:   position{line: 1614, col: 5, offset: 60915},
 							label: "term3",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1614, col: 11, offset: 60595},
+								pos: position{line: 1614, col: 11, offset: 60921},
 								expr: &actionExpr{
-									pos: position{line: 1614, col: 12, offset: 60596},
+									pos: position{line: 1614, col: 12, offset: 60922},
 									run: (*parser).callonConcealedIndexTerm19,
 									expr: &seqExpr{
-										pos: position{line: 1614, col: 12, offset: 60596},
+										pos: position{line: 1614, col: 12, offset: 60922},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1614, col: 12, offset: 60596},
+												pos: position{line: 1614, col: 12, offset: 60922},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1614, col: 12, offset: 60596},
+													pos:  position{line: 1614, col: 12, offset: 60922},
 													name: "WS",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1614, col: 16, offset: 60600},
+												pos:        position{line: 1614, col: 16, offset: 60926},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1614, col: 20, offset: 60604},
+												pos: position{line: 1614, col: 20, offset: 60930},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1614, col: 20, offset: 60604},
+													pos:  position{line: 1614, col: 20, offset: 60930},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1614, col: 24, offset: 60608},
+												pos:   position{line: 1614, col: 24, offset: 60934},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1614, col: 33, offset: 60617},
+													pos:  position{line: 1614, col: 33, offset: 60943},
 													name: "ConcealedIndexTermContent",
 												},
 											},
@@ -12726,7 +12776,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1615, col: 5, offset: 60675},
+							pos:        position{line: 1615, col: 5, offset: 61001},
 							val:        ")))",
 							ignoreCase: false,
 						},
@@ -12736,21 +12786,21 @@ var g = &grammar{
 		},
 		{
 			name: "ConcealedIndexTermContent",
-			pos:  position{line: 1619, col: 1, offset: 60754},
+			pos:  position{line: 1619, col: 1, offset: 61080},
 			expr: &actionExpr{
-				pos: position{line: 1619, col: 30, offset: 60783},
+				pos: position{line: 1619, col: 30, offset: 61109},
 				run: (*parser).callonConcealedIndexTermContent1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1619, col: 30, offset: 60783},
+					pos: position{line: 1619, col: 30, offset: 61109},
 					expr: &choiceExpr{
-						pos: position{line: 1619, col: 31, offset: 60784},
+						pos: position{line: 1619, col: 31, offset: 61110},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1619, col: 31, offset: 60784},
+								pos:  position{line: 1619, col: 31, offset: 61110},
 								name: "Alphanum",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1619, col: 42, offset: 60795},
+								pos:  position{line: 1619, col: 42, offset: 61121},
 								name: "WS",
 							},
 						},
@@ -12760,29 +12810,29 @@ var g = &grammar{
 		},
 		{
 			name: "BlankLine",
-			pos:  position{line: 1625, col: 1, offset: 60940},
+			pos:  position{line: 1625, col: 1, offset: 61266},
 			expr: &actionExpr{
-				pos: position{line: 1625, col: 14, offset: 60953},
+				pos: position{line: 1625, col: 14, offset: 61279},
 				run: (*parser).callonBlankLine1,
 				expr: &seqExpr{
-					pos: position{line: 1625, col: 14, offset: 60953},
+					pos: position{line: 1625, col: 14, offset: 61279},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1625, col: 14, offset: 60953},
+							pos: position{line: 1625, col: 14, offset: 61279},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1625, col: 15, offset: 60954},
+								pos:  position{line: 1625, col: 15, offset: 61280},
 								name: "EOF",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1625, col: 19, offset: 60958},
+							pos: position{line: 1625, col: 19, offset: 61284},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1625, col: 19, offset: 60958},
+								pos:  position{line: 1625, col: 19, offset: 61284},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1625, col: 23, offset: 60962},
+							pos:  position{line: 1625, col: 23, offset: 61288},
 							name: "EOL",
 						},
 					},
@@ -12791,9 +12841,9 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanum",
-			pos:  position{line: 1632, col: 1, offset: 61109},
+			pos:  position{line: 1632, col: 1, offset: 61435},
 			expr: &charClassMatcher{
-				pos:        position{line: 1632, col: 13, offset: 61121},
+				pos:        position{line: 1632, col: 13, offset: 61447},
 				val:        "[\\pL0-9]",
 				ranges:     []rune{'0', '9'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12803,37 +12853,37 @@ var g = &grammar{
 		},
 		{
 			name: "Parenthesis",
-			pos:  position{line: 1634, col: 1, offset: 61131},
+			pos:  position{line: 1634, col: 1, offset: 61457},
 			expr: &choiceExpr{
-				pos: position{line: 1634, col: 16, offset: 61146},
+				pos: position{line: 1634, col: 16, offset: 61472},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1634, col: 16, offset: 61146},
+						pos:        position{line: 1634, col: 16, offset: 61472},
 						val:        "(",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1634, col: 22, offset: 61152},
+						pos:        position{line: 1634, col: 22, offset: 61478},
 						val:        ")",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1634, col: 28, offset: 61158},
+						pos:        position{line: 1634, col: 28, offset: 61484},
 						val:        "[",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1634, col: 34, offset: 61164},
+						pos:        position{line: 1634, col: 34, offset: 61490},
 						val:        "]",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1634, col: 40, offset: 61170},
+						pos:        position{line: 1634, col: 40, offset: 61496},
 						val:        "{",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1634, col: 46, offset: 61176},
+						pos:        position{line: 1634, col: 46, offset: 61502},
 						val:        "}",
 						ignoreCase: false,
 					},
@@ -12842,14 +12892,14 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanums",
-			pos:  position{line: 1636, col: 1, offset: 61182},
+			pos:  position{line: 1636, col: 1, offset: 61508},
 			expr: &actionExpr{
-				pos: position{line: 1636, col: 14, offset: 61195},
+				pos: position{line: 1636, col: 14, offset: 61521},
 				run: (*parser).callonAlphanums1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1636, col: 14, offset: 61195},
+					pos: position{line: 1636, col: 14, offset: 61521},
 					expr: &charClassMatcher{
-						pos:        position{line: 1636, col: 14, offset: 61195},
+						pos:        position{line: 1636, col: 14, offset: 61521},
 						val:        "[\\pL0-9]",
 						ranges:     []rune{'0', '9'},
 						classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12861,37 +12911,37 @@ var g = &grammar{
 		},
 		{
 			name: "PunctuationMark",
-			pos:  position{line: 1640, col: 1, offset: 61241},
+			pos:  position{line: 1640, col: 1, offset: 61567},
 			expr: &choiceExpr{
-				pos: position{line: 1640, col: 20, offset: 61260},
+				pos: position{line: 1640, col: 20, offset: 61586},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1640, col: 20, offset: 61260},
+						pos:        position{line: 1640, col: 20, offset: 61586},
 						val:        ".",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1640, col: 26, offset: 61266},
+						pos:        position{line: 1640, col: 26, offset: 61592},
 						val:        "?",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1640, col: 32, offset: 61272},
+						pos:        position{line: 1640, col: 32, offset: 61598},
 						val:        "!",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1640, col: 38, offset: 61278},
+						pos:        position{line: 1640, col: 38, offset: 61604},
 						val:        ",",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1640, col: 44, offset: 61284},
+						pos:        position{line: 1640, col: 44, offset: 61610},
 						val:        ";",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1640, col: 50, offset: 61290},
+						pos:        position{line: 1640, col: 50, offset: 61616},
 						val:        ":",
 						ignoreCase: false,
 					},
@@ -12900,38 +12950,38 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleWord",
-			pos:  position{line: 1642, col: 1, offset: 61295},
+			pos:  position{line: 1642, col: 1, offset: 61621},
 			expr: &actionExpr{
-				pos: position{line: 1642, col: 15, offset: 61309},
+				pos: position{line: 1642, col: 15, offset: 61635},
 				run: (*parser).callonSimpleWord1,
 				expr: &seqExpr{
-					pos: position{line: 1642, col: 15, offset: 61309},
+					pos: position{line: 1642, col: 15, offset: 61635},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1642, col: 15, offset: 61309},
+							pos:  position{line: 1642, col: 15, offset: 61635},
 							name: "Alphanums",
 						},
 						&andExpr{
-							pos: position{line: 1642, col: 25, offset: 61319},
+							pos: position{line: 1642, col: 25, offset: 61645},
 							expr: &choiceExpr{
-								pos: position{line: 1642, col: 27, offset: 61321},
+								pos: position{line: 1642, col: 27, offset: 61647},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1642, col: 27, offset: 61321},
+										pos:  position{line: 1642, col: 27, offset: 61647},
 										name: "WS",
 									},
 									&litMatcher{
-										pos:        position{line: 1642, col: 32, offset: 61326},
+										pos:        position{line: 1642, col: 32, offset: 61652},
 										val:        ",",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 1642, col: 38, offset: 61332},
+										pos:        position{line: 1642, col: 38, offset: 61658},
 										val:        "]",
 										ignoreCase: false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1642, col: 44, offset: 61338},
+										pos:  position{line: 1642, col: 44, offset: 61664},
 										name: "EOL",
 									},
 								},
@@ -12943,42 +12993,42 @@ var g = &grammar{
 		},
 		{
 			name: "AnyChars",
-			pos:  position{line: 1646, col: 1, offset: 61545},
+			pos:  position{line: 1646, col: 1, offset: 61871},
 			expr: &choiceExpr{
-				pos: position{line: 1646, col: 13, offset: 61557},
+				pos: position{line: 1646, col: 13, offset: 61883},
 				alternatives: []interface{}{
 					&oneOrMoreExpr{
-						pos: position{line: 1646, col: 13, offset: 61557},
+						pos: position{line: 1646, col: 13, offset: 61883},
 						expr: &choiceExpr{
-							pos: position{line: 1648, col: 5, offset: 61714},
+							pos: position{line: 1648, col: 5, offset: 62040},
 							alternatives: []interface{}{
 								&actionExpr{
-									pos: position{line: 1648, col: 5, offset: 61714},
+									pos: position{line: 1648, col: 5, offset: 62040},
 									run: (*parser).callonAnyChars4,
 									expr: &seqExpr{
-										pos: position{line: 1648, col: 6, offset: 61715},
+										pos: position{line: 1648, col: 6, offset: 62041},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1648, col: 6, offset: 61715},
+												pos: position{line: 1648, col: 6, offset: 62041},
 												expr: &choiceExpr{
-													pos: position{line: 1648, col: 8, offset: 61717},
+													pos: position{line: 1648, col: 8, offset: 62043},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1648, col: 8, offset: 61717},
+															pos:  position{line: 1648, col: 8, offset: 62043},
 															name: "Alphanum",
 														},
 														&litMatcher{
-															pos:        position{line: 1648, col: 19, offset: 61728},
+															pos:        position{line: 1648, col: 19, offset: 62054},
 															val:        ",",
 															ignoreCase: false,
 														},
 														&litMatcher{
-															pos:        position{line: 1648, col: 25, offset: 61734},
+															pos:        position{line: 1648, col: 25, offset: 62060},
 															val:        ";",
 															ignoreCase: false,
 														},
 														&litMatcher{
-															pos:        position{line: 1648, col: 31, offset: 61740},
+															pos:        position{line: 1648, col: 31, offset: 62066},
 															val:        "}",
 															ignoreCase: false,
 														},
@@ -12986,71 +13036,71 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1648, col: 36, offset: 61745},
+												pos:  position{line: 1648, col: 36, offset: 62071},
 												name: "ConstrainedQuotedTextMarker",
 											},
 										},
 									},
 								},
 								&actionExpr{
-									pos: position{line: 1652, col: 8, offset: 61893},
+									pos: position{line: 1652, col: 8, offset: 62219},
 									run: (*parser).callonAnyChars13,
 									expr: &seqExpr{
-										pos: position{line: 1652, col: 9, offset: 61894},
+										pos: position{line: 1652, col: 9, offset: 62220},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1652, col: 9, offset: 61894},
+												pos:  position{line: 1652, col: 9, offset: 62220},
 												name: "Alphanums",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1652, col: 19, offset: 61904},
+												pos: position{line: 1652, col: 19, offset: 62230},
 												expr: &seqExpr{
-													pos: position{line: 1652, col: 20, offset: 61905},
+													pos: position{line: 1652, col: 20, offset: 62231},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 1652, col: 20, offset: 61905},
+															pos: position{line: 1652, col: 20, offset: 62231},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1652, col: 21, offset: 61906},
+																pos:  position{line: 1652, col: 21, offset: 62232},
 																name: "Newline",
 															},
 														},
 														&notExpr{
-															pos: position{line: 1652, col: 29, offset: 61914},
+															pos: position{line: 1652, col: 29, offset: 62240},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1652, col: 30, offset: 61915},
+																pos:  position{line: 1652, col: 30, offset: 62241},
 																name: "WS",
 															},
 														},
 														&notExpr{
-															pos: position{line: 1652, col: 33, offset: 61918},
+															pos: position{line: 1652, col: 33, offset: 62244},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1652, col: 34, offset: 61919},
+																pos:  position{line: 1652, col: 34, offset: 62245},
 																name: "Parenthesis",
 															},
 														},
 														&notExpr{
-															pos: position{line: 1652, col: 46, offset: 61931},
+															pos: position{line: 1652, col: 46, offset: 62257},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1652, col: 47, offset: 61932},
+																pos:  position{line: 1652, col: 47, offset: 62258},
 																name: "UnconstrainedQuotedTextPrefix",
 															},
 														},
 														&notExpr{
-															pos: position{line: 1652, col: 77, offset: 61962},
+															pos: position{line: 1652, col: 77, offset: 62288},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1652, col: 78, offset: 61963},
+																pos:  position{line: 1652, col: 78, offset: 62289},
 																name: "LabeledListItemSeparator",
 															},
 														},
 														&notExpr{
-															pos: position{line: 1652, col: 103, offset: 61988},
+															pos: position{line: 1652, col: 103, offset: 62314},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1652, col: 104, offset: 61989},
+																pos:  position{line: 1652, col: 104, offset: 62315},
 																name: "PunctuationMark",
 															},
 														},
 														&anyMatcher{
-															line: 1652, col: 120, offset: 62005,
+															line: 1652, col: 120, offset: 62331,
 														},
 													},
 												},
@@ -13062,10 +13112,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1655, col: 7, offset: 62063},
+						pos: position{line: 1655, col: 7, offset: 62389},
 						run: (*parser).callonAnyChars31,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1655, col: 7, offset: 62063},
+							pos:  position{line: 1655, col: 7, offset: 62389},
 							name: "PunctuationMark",
 						},
 					},
@@ -13074,22 +13124,22 @@ var g = &grammar{
 		},
 		{
 			name: "AnyChar",
-			pos:  position{line: 1660, col: 1, offset: 62353},
+			pos:  position{line: 1660, col: 1, offset: 62679},
 			expr: &actionExpr{
-				pos: position{line: 1660, col: 12, offset: 62364},
+				pos: position{line: 1660, col: 12, offset: 62690},
 				run: (*parser).callonAnyChar1,
 				expr: &seqExpr{
-					pos: position{line: 1660, col: 12, offset: 62364},
+					pos: position{line: 1660, col: 12, offset: 62690},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1660, col: 12, offset: 62364},
+							pos: position{line: 1660, col: 12, offset: 62690},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1660, col: 13, offset: 62365},
+								pos:  position{line: 1660, col: 13, offset: 62691},
 								name: "EOF",
 							},
 						},
 						&anyMatcher{
-							line: 1660, col: 17, offset: 62369,
+							line: 1660, col: 17, offset: 62695,
 						},
 					},
 				},
@@ -13097,35 +13147,35 @@ var g = &grammar{
 		},
 		{
 			name: "Spaces",
-			pos:  position{line: 1664, col: 1, offset: 62521},
+			pos:  position{line: 1664, col: 1, offset: 62847},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1664, col: 11, offset: 62531},
+				pos: position{line: 1664, col: 11, offset: 62857},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1664, col: 11, offset: 62531},
+					pos:  position{line: 1664, col: 11, offset: 62857},
 					name: "WS",
 				},
 			},
 		},
 		{
 			name: "FileLocation",
-			pos:  position{line: 1666, col: 1, offset: 62537},
+			pos:  position{line: 1666, col: 1, offset: 62863},
 			expr: &actionExpr{
-				pos: position{line: 1666, col: 17, offset: 62553},
+				pos: position{line: 1666, col: 17, offset: 62879},
 				run: (*parser).callonFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1666, col: 17, offset: 62553},
+					pos:   position{line: 1666, col: 17, offset: 62879},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1666, col: 26, offset: 62562},
+						pos: position{line: 1666, col: 26, offset: 62888},
 						expr: &choiceExpr{
-							pos: position{line: 1666, col: 27, offset: 62563},
+							pos: position{line: 1666, col: 27, offset: 62889},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1666, col: 27, offset: 62563},
+									pos:  position{line: 1666, col: 27, offset: 62889},
 									name: "FILENAME",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1666, col: 38, offset: 62574},
+									pos:  position{line: 1666, col: 38, offset: 62900},
 									name: "DocumentAttributeSubstitution",
 								},
 							},
@@ -13136,42 +13186,42 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedFileLocation",
-			pos:  position{line: 1670, col: 1, offset: 62666},
+			pos:  position{line: 1670, col: 1, offset: 62992},
 			expr: &actionExpr{
-				pos: position{line: 1670, col: 25, offset: 62690},
+				pos: position{line: 1670, col: 25, offset: 63016},
 				run: (*parser).callonResolvedFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1670, col: 25, offset: 62690},
+					pos:   position{line: 1670, col: 25, offset: 63016},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1670, col: 34, offset: 62699},
+						pos: position{line: 1670, col: 34, offset: 63025},
 						expr: &seqExpr{
-							pos: position{line: 1670, col: 35, offset: 62700},
+							pos: position{line: 1670, col: 35, offset: 63026},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1670, col: 35, offset: 62700},
+									pos: position{line: 1670, col: 35, offset: 63026},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1670, col: 36, offset: 62701},
+										pos:  position{line: 1670, col: 36, offset: 63027},
 										name: "EOL",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1670, col: 40, offset: 62705},
+									pos: position{line: 1670, col: 40, offset: 63031},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1670, col: 41, offset: 62706},
+										pos:  position{line: 1670, col: 41, offset: 63032},
 										name: "WS",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1670, col: 44, offset: 62709},
+									pos: position{line: 1670, col: 44, offset: 63035},
 									expr: &litMatcher{
-										pos:        position{line: 1670, col: 45, offset: 62710},
+										pos:        position{line: 1670, col: 45, offset: 63036},
 										val:        "[",
 										ignoreCase: false,
 									},
 								},
 								&anyMatcher{
-									line: 1670, col: 49, offset: 62714,
+									line: 1670, col: 49, offset: 63040,
 								},
 							},
 						},
@@ -13181,31 +13231,31 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 1674, col: 1, offset: 62778},
+			pos:  position{line: 1674, col: 1, offset: 63104},
 			expr: &actionExpr{
-				pos: position{line: 1674, col: 13, offset: 62790},
+				pos: position{line: 1674, col: 13, offset: 63116},
 				run: (*parser).callonLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1674, col: 13, offset: 62790},
+					pos:   position{line: 1674, col: 13, offset: 63116},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 1674, col: 23, offset: 62800},
+						pos: position{line: 1674, col: 23, offset: 63126},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1674, col: 23, offset: 62800},
+								pos:  position{line: 1674, col: 23, offset: 63126},
 								name: "URL_SCHEME",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 1674, col: 34, offset: 62811},
+								pos: position{line: 1674, col: 34, offset: 63137},
 								expr: &choiceExpr{
-									pos: position{line: 1674, col: 35, offset: 62812},
+									pos: position{line: 1674, col: 35, offset: 63138},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1674, col: 35, offset: 62812},
+											pos:  position{line: 1674, col: 35, offset: 63138},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1674, col: 46, offset: 62823},
+											pos:  position{line: 1674, col: 46, offset: 63149},
 											name: "DocumentAttributeSubstitution",
 										},
 									},
@@ -13218,26 +13268,26 @@ var g = &grammar{
 		},
 		{
 			name: "FILENAME",
-			pos:  position{line: 1678, col: 1, offset: 62916},
+			pos:  position{line: 1678, col: 1, offset: 63242},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1678, col: 13, offset: 62928},
+				pos: position{line: 1678, col: 13, offset: 63254},
 				expr: &choiceExpr{
-					pos: position{line: 1678, col: 14, offset: 62929},
+					pos: position{line: 1678, col: 14, offset: 63255},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 1678, col: 14, offset: 62929},
+							pos:        position{line: 1678, col: 14, offset: 63255},
 							val:        "[ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789~:/?#@!$&;=()*+,_%]",
 							chars:      []rune{'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '~', ':', '/', '?', '#', '@', '!', '$', '&', ';', '=', '(', ')', '*', '+', ',', '_', '%'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&litMatcher{
-							pos:        position{line: 1678, col: 99, offset: 63014},
+							pos:        position{line: 1678, col: 99, offset: 63340},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1678, col: 105, offset: 63020},
+							pos:        position{line: 1678, col: 105, offset: 63346},
 							val:        ".",
 							ignoreCase: false,
 						},
@@ -13247,22 +13297,22 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLocation",
-			pos:  position{line: 1680, col: 1, offset: 63141},
+			pos:  position{line: 1680, col: 1, offset: 63467},
 			expr: &actionExpr{
-				pos: position{line: 1680, col: 21, offset: 63161},
+				pos: position{line: 1680, col: 21, offset: 63487},
 				run: (*parser).callonResolvedLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1680, col: 21, offset: 63161},
+					pos:   position{line: 1680, col: 21, offset: 63487},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 1680, col: 31, offset: 63171},
+						pos: position{line: 1680, col: 31, offset: 63497},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1680, col: 31, offset: 63171},
+								pos:  position{line: 1680, col: 31, offset: 63497},
 								name: "URL_SCHEME",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1680, col: 42, offset: 63182},
+								pos:  position{line: 1680, col: 42, offset: 63508},
 								name: "RESOLVED_FILENAME",
 							},
 						},
@@ -13272,26 +13322,26 @@ var g = &grammar{
 		},
 		{
 			name: "RESOLVED_FILENAME",
-			pos:  position{line: 1684, col: 1, offset: 63261},
+			pos:  position{line: 1684, col: 1, offset: 63587},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1684, col: 22, offset: 63282},
+				pos: position{line: 1684, col: 22, offset: 63608},
 				expr: &choiceExpr{
-					pos: position{line: 1684, col: 23, offset: 63283},
+					pos: position{line: 1684, col: 23, offset: 63609},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 1684, col: 23, offset: 63283},
+							pos:        position{line: 1684, col: 23, offset: 63609},
 							val:        "[ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789~:/?#@!$&;=()*+_,%{}]",
 							chars:      []rune{'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '~', ':', '/', '?', '#', '@', '!', '$', '&', ';', '=', '(', ')', '*', '+', '_', ',', '%', '{', '}'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&litMatcher{
-							pos:        position{line: 1684, col: 110, offset: 63370},
+							pos:        position{line: 1684, col: 110, offset: 63696},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1684, col: 116, offset: 63376},
+							pos:        position{line: 1684, col: 116, offset: 63702},
 							val:        ".",
 							ignoreCase: false,
 						},
@@ -13301,54 +13351,54 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 1686, col: 1, offset: 63425},
+			pos:  position{line: 1686, col: 1, offset: 63751},
 			expr: &actionExpr{
-				pos: position{line: 1686, col: 8, offset: 63432},
+				pos: position{line: 1686, col: 8, offset: 63758},
 				run: (*parser).callonURL1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1686, col: 8, offset: 63432},
+					pos: position{line: 1686, col: 8, offset: 63758},
 					expr: &choiceExpr{
-						pos: position{line: 1686, col: 9, offset: 63433},
+						pos: position{line: 1686, col: 9, offset: 63759},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1686, col: 9, offset: 63433},
+								pos:  position{line: 1686, col: 9, offset: 63759},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 1686, col: 22, offset: 63446},
+								pos: position{line: 1686, col: 22, offset: 63772},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1686, col: 22, offset: 63446},
+										pos: position{line: 1686, col: 22, offset: 63772},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1686, col: 23, offset: 63447},
+											pos:  position{line: 1686, col: 23, offset: 63773},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1686, col: 31, offset: 63455},
+										pos: position{line: 1686, col: 31, offset: 63781},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1686, col: 32, offset: 63456},
+											pos:  position{line: 1686, col: 32, offset: 63782},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1686, col: 35, offset: 63459},
+										pos: position{line: 1686, col: 35, offset: 63785},
 										expr: &litMatcher{
-											pos:        position{line: 1686, col: 36, offset: 63460},
+											pos:        position{line: 1686, col: 36, offset: 63786},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1686, col: 40, offset: 63464},
+										pos: position{line: 1686, col: 40, offset: 63790},
 										expr: &litMatcher{
-											pos:        position{line: 1686, col: 41, offset: 63465},
+											pos:        position{line: 1686, col: 41, offset: 63791},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1686, col: 46, offset: 63470,
+										line: 1686, col: 46, offset: 63796,
 									},
 								},
 							},
@@ -13359,32 +13409,32 @@ var g = &grammar{
 		},
 		{
 			name: "URL_SCHEME",
-			pos:  position{line: 1690, col: 1, offset: 63511},
+			pos:  position{line: 1690, col: 1, offset: 63837},
 			expr: &choiceExpr{
-				pos: position{line: 1690, col: 15, offset: 63525},
+				pos: position{line: 1690, col: 15, offset: 63851},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1690, col: 15, offset: 63525},
+						pos:        position{line: 1690, col: 15, offset: 63851},
 						val:        "http://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1690, col: 27, offset: 63537},
+						pos:        position{line: 1690, col: 27, offset: 63863},
 						val:        "https://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1690, col: 40, offset: 63550},
+						pos:        position{line: 1690, col: 40, offset: 63876},
 						val:        "ftp://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1690, col: 51, offset: 63561},
+						pos:        position{line: 1690, col: 51, offset: 63887},
 						val:        "irc://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1690, col: 62, offset: 63572},
+						pos:        position{line: 1690, col: 62, offset: 63898},
 						val:        "mailto:",
 						ignoreCase: false,
 					},
@@ -13393,78 +13443,78 @@ var g = &grammar{
 		},
 		{
 			name: "ID",
-			pos:  position{line: 1692, col: 1, offset: 63583},
+			pos:  position{line: 1692, col: 1, offset: 63909},
 			expr: &actionExpr{
-				pos: position{line: 1692, col: 7, offset: 63589},
+				pos: position{line: 1692, col: 7, offset: 63915},
 				run: (*parser).callonID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1692, col: 7, offset: 63589},
+					pos: position{line: 1692, col: 7, offset: 63915},
 					expr: &choiceExpr{
-						pos: position{line: 1692, col: 8, offset: 63590},
+						pos: position{line: 1692, col: 8, offset: 63916},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1692, col: 8, offset: 63590},
+								pos:  position{line: 1692, col: 8, offset: 63916},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 1692, col: 21, offset: 63603},
+								pos: position{line: 1692, col: 21, offset: 63929},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1692, col: 21, offset: 63603},
+										pos: position{line: 1692, col: 21, offset: 63929},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1692, col: 22, offset: 63604},
+											pos:  position{line: 1692, col: 22, offset: 63930},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1692, col: 30, offset: 63612},
+										pos: position{line: 1692, col: 30, offset: 63938},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1692, col: 31, offset: 63613},
+											pos:  position{line: 1692, col: 31, offset: 63939},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1692, col: 34, offset: 63616},
+										pos: position{line: 1692, col: 34, offset: 63942},
 										expr: &litMatcher{
-											pos:        position{line: 1692, col: 35, offset: 63617},
+											pos:        position{line: 1692, col: 35, offset: 63943},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1692, col: 39, offset: 63621},
+										pos: position{line: 1692, col: 39, offset: 63947},
 										expr: &litMatcher{
-											pos:        position{line: 1692, col: 40, offset: 63622},
+											pos:        position{line: 1692, col: 40, offset: 63948},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1692, col: 44, offset: 63626},
+										pos: position{line: 1692, col: 44, offset: 63952},
 										expr: &litMatcher{
-											pos:        position{line: 1692, col: 45, offset: 63627},
+											pos:        position{line: 1692, col: 45, offset: 63953},
 											val:        "<<",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1692, col: 50, offset: 63632},
+										pos: position{line: 1692, col: 50, offset: 63958},
 										expr: &litMatcher{
-											pos:        position{line: 1692, col: 51, offset: 63633},
+											pos:        position{line: 1692, col: 51, offset: 63959},
 											val:        ">>",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1692, col: 56, offset: 63638},
+										pos: position{line: 1692, col: 56, offset: 63964},
 										expr: &litMatcher{
-											pos:        position{line: 1692, col: 57, offset: 63639},
+											pos:        position{line: 1692, col: 57, offset: 63965},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1692, col: 62, offset: 63644,
+										line: 1692, col: 62, offset: 63970,
 									},
 								},
 							},
@@ -13475,12 +13525,12 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 1696, col: 1, offset: 63685},
+			pos:  position{line: 1696, col: 1, offset: 64011},
 			expr: &actionExpr{
-				pos: position{line: 1696, col: 10, offset: 63694},
+				pos: position{line: 1696, col: 10, offset: 64020},
 				run: (*parser).callonDIGIT1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1696, col: 10, offset: 63694},
+					pos:        position{line: 1696, col: 10, offset: 64020},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -13490,25 +13540,25 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 1700, col: 1, offset: 63736},
+			pos:  position{line: 1700, col: 1, offset: 64062},
 			expr: &actionExpr{
-				pos: position{line: 1700, col: 11, offset: 63746},
+				pos: position{line: 1700, col: 11, offset: 64072},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 1700, col: 11, offset: 63746},
+					pos: position{line: 1700, col: 11, offset: 64072},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1700, col: 11, offset: 63746},
+							pos: position{line: 1700, col: 11, offset: 64072},
 							expr: &litMatcher{
-								pos:        position{line: 1700, col: 11, offset: 63746},
+								pos:        position{line: 1700, col: 11, offset: 64072},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1700, col: 16, offset: 63751},
+							pos: position{line: 1700, col: 16, offset: 64077},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1700, col: 16, offset: 63751},
+								pos:  position{line: 1700, col: 16, offset: 64077},
 								name: "DIGIT",
 							},
 						},
@@ -13518,20 +13568,20 @@ var g = &grammar{
 		},
 		{
 			name: "WS",
-			pos:  position{line: 1704, col: 1, offset: 63803},
+			pos:  position{line: 1704, col: 1, offset: 64129},
 			expr: &choiceExpr{
-				pos: position{line: 1704, col: 7, offset: 63809},
+				pos: position{line: 1704, col: 7, offset: 64135},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1704, col: 7, offset: 63809},
+						pos:        position{line: 1704, col: 7, offset: 64135},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1704, col: 13, offset: 63815},
+						pos: position{line: 1704, col: 13, offset: 64141},
 						run: (*parser).callonWS3,
 						expr: &litMatcher{
-							pos:        position{line: 1704, col: 13, offset: 63815},
+							pos:        position{line: 1704, col: 13, offset: 64141},
 							val:        "\t",
 							ignoreCase: false,
 						},
@@ -13541,22 +13591,22 @@ var g = &grammar{
 		},
 		{
 			name: "Newline",
-			pos:  position{line: 1708, col: 1, offset: 63856},
+			pos:  position{line: 1708, col: 1, offset: 64182},
 			expr: &choiceExpr{
-				pos: position{line: 1708, col: 12, offset: 63867},
+				pos: position{line: 1708, col: 12, offset: 64193},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1708, col: 12, offset: 63867},
+						pos:        position{line: 1708, col: 12, offset: 64193},
 						val:        "\r\n",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1708, col: 21, offset: 63876},
+						pos:        position{line: 1708, col: 21, offset: 64202},
 						val:        "\r",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1708, col: 28, offset: 63883},
+						pos:        position{line: 1708, col: 28, offset: 64209},
 						val:        "\n",
 						ignoreCase: false,
 					},
@@ -13565,26 +13615,26 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1710, col: 1, offset: 63889},
+			pos:  position{line: 1710, col: 1, offset: 64215},
 			expr: &notExpr{
-				pos: position{line: 1710, col: 8, offset: 63896},
+				pos: position{line: 1710, col: 8, offset: 64222},
 				expr: &anyMatcher{
-					line: 1710, col: 9, offset: 63897,
+					line: 1710, col: 9, offset: 64223,
 				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1712, col: 1, offset: 63900},
+			pos:  position{line: 1712, col: 1, offset: 64226},
 			expr: &choiceExpr{
-				pos: position{line: 1712, col: 8, offset: 63907},
+				pos: position{line: 1712, col: 8, offset: 64233},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1712, col: 8, offset: 63907},
+						pos:  position{line: 1712, col: 8, offset: 64233},
 						name: "Newline",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1712, col: 18, offset: 63917},
+						pos:  position{line: 1712, col: 18, offset: 64243},
 						name: "EOF",
 					},
 				},
@@ -13638,6 +13688,16 @@ func (p *parser) callonAsciidocDocumentBlocks1() (interface{}, error) {
 	return p.cur.onAsciidocDocumentBlocks1(stack["header"], stack["others"])
 }
 
+func (c *current) onDocumentBlock5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonDocumentBlock5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentBlock5()
+}
+
 func (c *current) onDocumentBlock1(block interface{}) (interface{}, error) {
 	return block, nil
 }
@@ -13648,6 +13708,16 @@ func (p *parser) callonDocumentBlock1() (interface{}, error) {
 	return p.cur.onDocumentBlock1(stack["block"])
 }
 
+func (c *current) onDocumentBlockWithinDelimitedBlock5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonDocumentBlockWithinDelimitedBlock5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentBlockWithinDelimitedBlock5()
+}
+
 func (c *current) onDocumentBlockWithinDelimitedBlock1(block interface{}) (interface{}, error) {
 	return block, nil
 }
@@ -13658,6 +13728,16 @@ func (p *parser) callonDocumentBlockWithinDelimitedBlock1() (interface{}, error)
 	return p.cur.onDocumentBlockWithinDelimitedBlock1(stack["block"])
 }
 
+func (c *current) onTextDocumentBlock5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonTextDocumentBlock5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTextDocumentBlock5()
+}
+
 func (c *current) onTextDocumentBlock1(block interface{}) (interface{}, error) {
 	return block, nil
 }
@@ -13901,7 +13981,7 @@ func (p *parser) callonDocumentAttributeSubstitution1() (interface{}, error) {
 }
 
 func (c *current) onElementAttributes1(attrs interface{}) (interface{}, error) {
-	return types.NewElementAttributes(attrs.([]interface{})), nil
+	return types.NewElementAttributes(attrs.([]interface{}))
 }
 
 func (p *parser) callonElementAttributes1() (interface{}, error) {
@@ -15248,6 +15328,16 @@ func (p *parser) callonInlineElements1() (interface{}, error) {
 	return p.cur.onInlineElements1(stack["elements"])
 }
 
+func (c *current) onInlineElement7() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonInlineElement7() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElement7()
+}
+
 func (c *current) onInlineElement1(element interface{}) (interface{}, error) {
 	return element, nil
 }
@@ -15383,6 +15473,16 @@ func (p *parser) callonDoubleQuoteBoldText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteBoldText1(stack["elements"])
 }
 
+func (c *current) onDoubleQuoteBoldTextElement5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonDoubleQuoteBoldTextElement5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteBoldTextElement5()
+}
+
 func (c *current) onDoubleQuoteBoldTextElement1(element interface{}) (interface{}, error) {
 	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
 	return element, nil
@@ -15427,6 +15527,16 @@ func (p *parser) callonSingleQuoteBoldText12() (interface{}, error) {
 	return p.cur.onSingleQuoteBoldText12(stack["elements"])
 }
 
+func (c *current) onSingleQuoteBoldTextElement5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonSingleQuoteBoldTextElement5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteBoldTextElement5()
+}
+
 func (c *current) onSingleQuoteBoldTextElement1(element interface{}) (interface{}, error) {
 	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
 	return element, nil
@@ -15496,6 +15606,16 @@ func (p *parser) callonDoubleQuoteItalicText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteItalicText1(stack["elements"])
 }
 
+func (c *current) onDoubleQuoteItalicTextElement5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonDoubleQuoteItalicTextElement5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteItalicTextElement5()
+}
+
 func (c *current) onDoubleQuoteItalicTextElement1(element interface{}) (interface{}, error) {
 	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
 	return element, nil
@@ -15540,6 +15660,16 @@ func (p *parser) callonSingleQuoteItalicText12() (interface{}, error) {
 	return p.cur.onSingleQuoteItalicText12(stack["elements"])
 }
 
+func (c *current) onSingleQuoteItalicTextElement5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonSingleQuoteItalicTextElement5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteItalicTextElement5()
+}
+
 func (c *current) onSingleQuoteItalicTextElement1(element interface{}) (interface{}, error) {
 	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
 	return element, nil
@@ -15609,6 +15739,16 @@ func (p *parser) callonDoubleQuoteMonospaceText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteMonospaceText1(stack["elements"])
 }
 
+func (c *current) onDoubleQuoteMonospaceTextElement5() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonDoubleQuoteMonospaceTextElement5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteMonospaceTextElement5()
+}
+
 func (c *current) onDoubleQuoteMonospaceTextElement1(element interface{}) (interface{}, error) {
 	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
 	return element, nil
@@ -15653,6 +15793,16 @@ func (p *parser) callonSingleQuoteMonospaceText12() (interface{}, error) {
 	return p.cur.onSingleQuoteMonospaceText12(stack["elements"])
 }
 
+func (c *current) onSingleQuoteMonospaceTextElement3() (bool, error) {
+	return checkCancellation(c)
+}
+
+func (p *parser) callonSingleQuoteMonospaceTextElement3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMonospaceTextElement3()
+}
+
 func (c *current) onSingleQuoteMonospaceTextElement1(element interface{}) (interface{}, error) {
 	// word with quote punctuation is only accepted if nothing matched before, so we have a chance to stop
 	return element, nil
@@ -25,6 +25,7 @@ What is the answer to the Ultimate Question?:: 42`
 				types.LabeledList{
 					Attributes: types.ElementAttributes{
 						types.AttrTitle: "Q&A",
+						types.AttrStyle: "qanda",
 						types.AttrQandA: nil,
 					},
 					Items: []types.LabeledListItem{
@@ -0,0 +1,189 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// the key of the context in the global store of the parser
+const contextKey = "context"
+
+// checkCancellation is called by the grammar in the rules which are frequently evaluated (including while backtracking),
+// and stops the parsing immediately if the context in the global store of the parser is done.
+func checkCancellation(c *current) (bool, error) {
+	if ctx, ok := c.globalStore[contextKey].(context.Context); ok {
+		select {
+		case <-ctx.Done():
+			// panics are recovered by the parser, which returns the value as an error
+			panic(ctx.Err())
+		default:
+		}
+	}
+	return true, nil
+}
+
+// resources tracks the resources used while parsing a document and the files it includes
+type resources struct {
+	ctx              context.Context
+	maxIncludedBytes int64
+	includedBytes    int64
+	maxDepth         int
+	maxNodes         int
+	nodes            int
+}
+
+func newResources(ctx context.Context, config configuration.Configuration) *resources {
+	return &resources{
+		ctx:              ctx,
+		maxIncludedBytes: config.MaxIncludedBytes(),
+		maxDepth:         config.MaxNestingDepth(),
+		maxNodes:         config.MaxNodes(),
+	}
+}
+
+// options returns the options of the parser to stop parsing when the context is done
+func (r *resources) options() []Option {
+	return []Option{GlobalStore(contextKey, r.ctx)}
+}
+
+// err returns an error if the context is done
+func (r *resources) err() error {
+	return r.ctx.Err()
+}
+
+// include returns a reader which counts the bytes read from the given file to include, and which
+// fails with a `configuration.LimitError` if the total size of the included files exceeds the limit
+func (r *resources) include(f io.Reader) io.Reader {
+	if r.maxIncludedBytes <= 0 {
+		return f
+	}
+	return &includedBytesReader{
+		r:         f,
+		resources: r,
+	}
+}
+
+type includedBytesReader struct {
+	r         io.Reader
+	resources *resources
+}
+
+func (r *includedBytesReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.resources.includedBytes += int64(n)
+	if r.resources.includedBytes > r.resources.maxIncludedBytes {
+		return n, &configuration.LimitError{
+			Limit: configuration.IncludedBytesLimit,
+			Max:   r.resources.maxIncludedBytes,
+		}
+	}
+	return n, err
+}
+
+// checkLimits returns a `configuration.LimitError` if the blocks of a draft document (ie, the root document or an included file)
+// exceed the maximum nesting depth, or if the elements parsed so far in all the draft documents exceed the maximum number of nodes.
+// It is called as soon as each draft document is parsed, so that the parsing stops before the files it includes are read.
+// The elements which are not kept in the final document (eg: blank lines, comments or attribute declarations) are not counted,
+// so that the draft documents do not exceed limits which the final document would not exceed
+func (r *resources) checkLimits(blocks []interface{}) error {
+	if r.maxDepth <= 0 && r.maxNodes <= 0 {
+		return nil
+	}
+	return types.Walk(blocks, types.Visitor{
+		Enter: func(element interface{}, ancestors types.Ancestors) error {
+			switch e := element.(type) {
+			case types.BlankLine, types.SingleLineComment, types.FileInclusion, types.ContinuedListItemElement,
+				types.DocumentAttributeDeclaration, types.DocumentAttributeReset, types.DocumentAttributeSubstitution:
+				return nil
+			case types.DelimitedBlock:
+				if e.Kind == types.Comment {
+					return types.SkipChildren
+				}
+			}
+			// same depth as in the final document, whose elements are in the document itself
+			if r.maxDepth > 0 && len(ancestors)+1 > r.maxDepth {
+				return &configuration.LimitError{
+					Limit: configuration.NestingDepthLimit,
+					Max:   int64(r.maxDepth),
+				}
+			}
+			r.nodes++
+			if r.maxNodes > 0 && r.nodes > r.maxNodes {
+				return &configuration.LimitError{
+					Limit: configuration.NodesLimit,
+					Max:   int64(r.maxNodes),
+				}
+			}
+			if _, ok := element.(types.Footnote); ok {
+				// the content of the footnotes is moved out of the elements of the final document
+				return types.SkipChildren
+			}
+			return nil
+		},
+	})
+}
+
+// isFatal returns true if the given error must stop the parsing of the document,
+// rather than being reported while the parsing continues (eg: when a file cannot be included)
+func isFatal(err error) bool {
+	if _, ok := err.(*configuration.LimitError); ok {
+		return true
+	}
+	return err == context.Canceled || err == context.DeadlineExceeded
+}
+
+// limitInputSize reads the given document, and returns a `configuration.LimitError` if its size exceeds the limit
+func limitInputSize(r io.Reader, config configuration.Configuration) (io.Reader, error) {
+	max := config.MaxInputSize()
+	if max <= 0 {
+		return r, nil
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > max {
+		return nil, &configuration.LimitError{
+			Limit: configuration.InputSizeLimit,
+			Max:   max,
+		}
+	}
+	return bytes.NewReader(content), nil
+}
+
+// checkDocumentLimits returns a `configuration.LimitError` if the given document exceeds
+// the maximum nesting depth or the maximum number of nodes
+func checkDocumentLimits(doc types.Document, config configuration.Configuration) error {
+	maxDepth, maxNodes := config.MaxNestingDepth(), config.MaxNodes()
+	if maxDepth <= 0 && maxNodes <= 0 {
+		return nil
+	}
+	nodes := 0
+	return types.Walk(doc, types.Visitor{
+		Enter: func(element interface{}, ancestors types.Ancestors) error {
+			if len(ancestors) == 0 {
+				// the document itself
+				return nil
+			}
+			if maxDepth > 0 && len(ancestors) > maxDepth {
+				return &configuration.LimitError{
+					Limit: configuration.NestingDepthLimit,
+					Max:   int64(maxDepth),
+				}
+			}
+			nodes++
+			if maxNodes > 0 && nodes > maxNodes {
+				return &configuration.LimitError{
+					Limit: configuration.NodesLimit,
+					Max:   int64(maxNodes),
+				}
+			}
+			return nil
+		},
+	})
+}
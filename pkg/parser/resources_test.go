@@ -0,0 +1,220 @@
+package parser_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing/fstest"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resources", func() {
+
+	Context("cancellation", func() {
+
+		It("should not parse with cancelled context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := parser.ParseDocumentContext(ctx, strings.NewReader("a paragraph"), configuration.NewConfiguration())
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("should stop parsing when deadline is exceeded", func() {
+			source := strings.Repeat("a paragraph with *bold*, _italic_ and `monospace` content\n\n", 10000)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			_, err := parser.ParseDocumentContext(ctx, strings.NewReader(source), configuration.NewConfiguration())
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("should stop parsing included file when context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			config := configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithIncludeResolver(cancellingResolver{
+					resolver: configuration.FSIncludeResolver{
+						FS: fstest.MapFS{
+							"chapter.adoc": {Data: []byte("a paragraph")},
+						},
+					},
+					cancel: cancel,
+				}))
+			_, err := parser.ParseDraftDocumentContext(ctx, strings.NewReader("include::chapter.adoc[]"), config)
+			Expect(err).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("limits", func() {
+
+		It("should fail when input size exceeds limit", func() {
+			_, err := parser.ParseDocument(strings.NewReader("a paragraph"), configuration.NewConfiguration(
+				configuration.WithMaxInputSize(10)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.InputSizeLimit,
+				Max:   10,
+			}))
+			Expect(err).To(MatchError("document exceeds the maximum input size of 10"))
+		})
+
+		It("should not fail when input size does not exceed limit", func() {
+			_, err := parser.ParseDocument(strings.NewReader("a paragraph"), configuration.NewConfiguration(
+				configuration.WithMaxInputSize(11)))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when nesting depth exceeds limit", func() {
+			source := `* level 1
+** level 2
+*** level 3`
+			_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithMaxNestingDepth(5)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.NestingDepthLimit,
+				Max:   5,
+			}))
+			_, err = parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithMaxNestingDepth(8)))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when number of nodes exceeds limit", func() {
+			source := strings.Repeat("a paragraph\n\n", 10)
+			_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithMaxNodes(15)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.NodesLimit,
+				Max:   15,
+			}))
+			_, err = parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithMaxNodes(20)))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail before including files when number of nodes exceeds limit", func() {
+			resolver := &countingResolver{
+				resolver: configuration.FSIncludeResolver{
+					FS: fstest.MapFS{
+						"chapter.adoc": {Data: []byte("a paragraph")},
+					},
+				},
+			}
+			source := strings.Repeat("a paragraph\n\n", 10) + "include::chapter.adoc[]"
+			_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithIncludeResolver(resolver),
+				configuration.WithMaxNodes(15)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.NodesLimit,
+				Max:   15,
+			}))
+			Expect(resolver.count).To(Equal(0))
+		})
+
+		It("should fail before including files when nesting depth exceeds limit", func() {
+			resolver := &countingResolver{
+				resolver: configuration.FSIncludeResolver{
+					FS: fstest.MapFS{
+						"chapter.adoc": {Data: []byte("a paragraph")},
+					},
+				},
+			}
+			source := `****
+====
+a paragraph
+====
+****
+
+include::chapter.adoc[]`
+			_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithIncludeResolver(resolver),
+				configuration.WithMaxNestingDepth(3)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.NestingDepthLimit,
+				Max:   3,
+			}))
+			Expect(resolver.count).To(Equal(0))
+		})
+
+		It("should fail when nodes of included files exceed limit", func() {
+			fs := fstest.MapFS{
+				"chapter.adoc": {Data: []byte(strings.Repeat("a paragraph\n\n", 10))},
+			}
+			_, err := parser.ParseDocument(strings.NewReader("include::chapter.adoc[]\n\ninclude::chapter.adoc[]"), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithFS(fs),
+				configuration.WithMaxNodes(30)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.NodesLimit,
+				Max:   30,
+			}))
+		})
+
+		It("should fail when included bytes exceed limit", func() {
+			fs := fstest.MapFS{
+				"chapter-a.adoc": {Data: []byte("a paragraph\n\ninclude::chapter-b.adoc[]")},
+				"chapter-b.adoc": {Data: []byte("another paragraph")},
+			}
+			_, err := parser.ParseDocument(strings.NewReader("include::chapter-a.adoc[]"), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithFS(fs),
+				configuration.WithMaxIncludedBytes(50)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.IncludedBytesLimit,
+				Max:   50,
+			}))
+			// 38 + 17 bytes
+			_, err = parser.ParseDocument(strings.NewReader("include::chapter-a.adoc[]"), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithFS(fs),
+				configuration.WithMaxIncludedBytes(55)))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when included bytes exceed limit in file with latin1 encoding", func() {
+			fs := fstest.MapFS{
+				"chapter.adoc": {Data: []byte("caf\xe9 au lait, cr\xe8me br\xfbl\xe9e")},
+			}
+			_, err := parser.ParseDocument(strings.NewReader("include::chapter.adoc[encoding=iso-8859-1]"), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithFS(fs),
+				configuration.WithMaxIncludedBytes(10)))
+			Expect(err).To(Equal(&configuration.LimitError{
+				Limit: configuration.IncludedBytesLimit,
+				Max:   10,
+			}))
+		})
+	})
+})
+
+// cancellingResolver an include resolver which cancels the context of the parsing when a file is included
+type cancellingResolver struct {
+	resolver configuration.IncludeResolver
+	cancel   context.CancelFunc
+}
+
+func (r cancellingResolver) Resolve(ctx context.Context, target string, attributes types.ElementAttributes, includingFile string) (io.ReadCloser, string, error) {
+	r.cancel()
+	return r.resolver.Resolve(ctx, target, attributes, includingFile)
+}
+
+// countingResolver an include resolver which counts the files to include
+type countingResolver struct {
+	resolver configuration.IncludeResolver
+	count    int
+}
+
+func (r *countingResolver) Resolve(ctx context.Context, target string, attributes types.ElementAttributes, includingFile string) (io.ReadCloser, string, error) {
+	r.count++
+	return r.resolver.Resolve(ctx, target, attributes, includingFile)
+}
@@ -1464,7 +1464,8 @@ a paragraph`
 					},
 					types.Section{
 						Attributes: types.ElementAttributes{
-							types.AttrID: "_section_1_2",
+							types.AttrID:         "_section_1_2",
+							types.AttrOriginalID: "_section_1",
 						},
 						Level:    1,
 						Title:    section1bTitle,
@@ -0,0 +1,181 @@
+package asciidoc
+
+import (
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Render converts the given draft document back into Asciidoc, and writes the result in the given `output`.
+// The output is "canonical", ie:
+// - headings use the `=` markers and lists use the `*` (or `-`), `.` and `::` markers, without indentation
+// - element attributes are written on their own lines, before the element (eg: `[[id]]`, `.title` and `[style,key=value]`)
+// - table rows are written on a single line, with a space before and after each cell separator
+// - blocks are separated by a single blank line
+// Comments, document attribute declarations and file inclusions are retained.
+func Render(doc types.DraftDocument, output io.Writer) error {
+	lines := []string{}
+	if len(doc.FrontMatter.Content) > 0 {
+		content, err := yaml.Marshal(doc.FrontMatter.Content)
+		if err != nil {
+			return errors.Wrap(err, "unable to render front-matter")
+		}
+		lines = append(lines, "---", strings.TrimSuffix(string(content), "\n"), "---")
+	}
+	blocks, err := renderBlocks(doc.Blocks, true)
+	if err != nil {
+		return errors.Wrap(err, "unable to render document")
+	}
+	lines = append(lines, blocks...)
+	if len(lines) == 0 {
+		return nil
+	}
+	_, err = io.WriteString(output, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// renderBlocks renders the given blocks. If `normalize` is true, the blocks are separated by a single blank
+// line (when needed) and the leading, trailing and consecutive blank lines are discarded. Otherwise, the blank lines
+// are retained as-is (eg: within a delimited block, where they are part of the content)
+func renderBlocks(blocks []interface{}, normalize bool) ([]string, error) {
+	result := []string{}
+	var previous interface{}
+	blankLines := 0
+	for _, block := range blocks {
+		if _, ok := block.(types.BlankLine); ok {
+			if !normalize {
+				result = append(result, "")
+			}
+			blankLines++
+			continue
+		}
+		if !normalize {
+			lines, err := renderBlock(block)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, lines...)
+			continue
+		}
+		if previous != nil && (blankLines > 0 || needsBlankLine(previous, block)) {
+			result = append(result, "")
+		}
+		lines, err := renderBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, lines...)
+		previous = block
+		blankLines = 0
+	}
+	return result, nil
+}
+
+// needsBlankLine returns `true` if a blank line must be inserted between the given blocks
+// (which were not separated by a blank line in the source document)
+func needsBlankLine(previous, next interface{}) bool {
+	switch {
+	case isContinuation(next):
+		// the continuation carries its own blank lines
+		return false
+	case isFileInclusion(previous) || isFileInclusion(next):
+		// the content of the included file may be part of the previous/next block
+		return false
+	case isComment(previous) || isComment(next):
+		return false
+	case isAttributeDeclaration(previous) && isAttributeDeclaration(next):
+		return false
+	case isDocumentTitle(previous) && isAttributeDeclaration(next):
+		// attribute declarations in the document header
+		return false
+	case (isListItem(previous) || isContinuation(previous)) && isListItem(next):
+		return false
+	default:
+		return true
+	}
+}
+
+func isContinuation(block interface{}) bool {
+	_, ok := block.(types.ContinuedListItemElement)
+	return ok
+}
+
+func isFileInclusion(block interface{}) bool {
+	_, ok := block.(types.FileInclusion)
+	return ok
+}
+
+func isComment(block interface{}) bool {
+	_, ok := block.(types.SingleLineComment)
+	return ok
+}
+
+func isAttributeDeclaration(block interface{}) bool {
+	switch block.(type) {
+	case types.DocumentAttributeDeclaration, types.DocumentAttributeReset:
+		return true
+	default:
+		return false
+	}
+}
+
+func isDocumentTitle(block interface{}) bool {
+	s, ok := block.(types.Section)
+	return ok && s.Level == 0
+}
+
+func isListItem(block interface{}) bool {
+	switch block.(type) {
+	case types.OrderedListItem, types.UnorderedListItem, types.LabeledListItem:
+		return true
+	default:
+		return false
+	}
+}
+
+// nolint: gocyclo
+func renderBlock(block interface{}) ([]string, error) {
+	switch b := block.(type) {
+	case types.Section:
+		return renderSection(b)
+	case types.DocumentAttributeDeclaration:
+		if b.Value == "" {
+			return []string{":" + b.Name + ":"}, nil
+		}
+		return []string{":" + b.Name + ": " + b.Value}, nil
+	case types.DocumentAttributeReset:
+		return []string{":" + b.Name + "!:"}, nil
+	case types.SingleLineComment:
+		return []string{"//" + b.Content}, nil
+	case types.TableOfContentsPlaceHolder:
+		return []string{"toc::[]"}, nil
+	case types.FileInclusion:
+		return []string{b.RawText}, nil
+	case types.UserMacro:
+		return []string{b.RawText}, nil
+	case types.Paragraph:
+		return renderParagraph(b)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(b)
+	case types.LiteralBlock:
+		return renderLiteralBlock(b)
+	case types.ImageBlock:
+		return renderImageBlock(b)
+	case types.Table:
+		return renderTable(b)
+	case types.UnorderedListItem:
+		return renderUnorderedListItem(b)
+	case types.OrderedListItem:
+		return renderOrderedListItem(b)
+	case types.LabeledListItem:
+		return renderLabeledListItem(b)
+	case types.ContinuedListItemElement:
+		return renderContinuedListItemElement(b)
+	default:
+		return nil, errors.Errorf("unsupported type of block: %T", block)
+	}
+}
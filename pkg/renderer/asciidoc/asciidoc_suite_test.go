@@ -0,0 +1,15 @@
+package asciidoc_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestAsciidoc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Asciidoc Suite")
+}
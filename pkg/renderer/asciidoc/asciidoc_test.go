@@ -0,0 +1,217 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("asciidoc", func() {
+
+	Context("document header", func() {
+
+		It("document with title, authors, revision and attributes", func() {
+			source := `= Document Title
+John Doe <john@example.com>
+v1.0, 2019-01-01: Initial
+:toc:
+:author-note: some note
+
+
+
+Preamble`
+			expected := `= Document Title
+John Doe <john@example.com>
+v1.0, 2019-01-01: Initial
+:toc:
+:author-note: some note
+
+Preamble
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("document with front-matter", func() {
+			source := `---
+draft: true
+---
+= Document Title`
+			expected := `---
+draft: true
+---
+= Document Title
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+	})
+
+	Context("sections", func() {
+
+		It("sections with custom ids, comments and file inclusions", func() {
+			source := `[[custom]]
+==   Section A
+
+
+=== Sub Section
+// a comment
+include::chapter.adoc[]`
+			expected := `[[custom]]
+== Section A
+
+=== Sub Section
+// a comment
+include::chapter.adoc[]
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+	})
+
+	Context("lists", func() {
+
+		It("unordered list with nested items, checklist and continuation", func() {
+			source := `*   item 1
+** item 1.1
+* [x] done
++
+continuation`
+			expected := `* item 1
+** item 1.1
+* [x] done
++
+continuation
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("ordered lists with implicit and explicit numbering", func() {
+			source := `. first
+.. nested
+
+a. alpha`
+			expected := `. first
+.. nested
+
+a. alpha
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("labeled list", func() {
+			source := `term 1:: description
+term 2:::`
+			expected := `term 1:: description
+term 2:::
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+	})
+
+	Context("blocks", func() {
+
+		It("source and quote blocks", func() {
+			source := `[source,go]
+.Example
+----
+func main() {
+
+}
+----
+[quote, Mark Twain,Notes]
+____
+quote
+____`
+			expected := `.Example
+[source,go]
+----
+func main() {
+
+}
+----
+
+[quote,Mark Twain,Notes]
+____
+quote
+____
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("admonitions, image and literal blocks", func() {
+			source := `[NOTE]
+note
+
+WARNING: warn
+
+image::foo.png[Foo,100,50]
+[.lead]
+....
+literal
+....`
+			expected := `NOTE: note
+
+WARNING: warn
+
+image::foo.png[Foo,100,50]
+
+[.lead]
+....
+literal
+....
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("table", func() {
+			source := `[cols="2*", options="header"]
+|===
+|Column 1 |Column 2
+
+|a |b
+|c   | d
+|===`
+			expected := `[cols=2*,options=header]
+|===
+| Column 1 | Column 2
+
+| a | b
+| c | d
+|===
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+	})
+
+	Context("inline elements", func() {
+
+		It("quoted texts, cross-references, links and images", func() {
+			source := `a *bold* and **b**old, _italic_, ` + "`mono`" + `, ~sub~ and ^sup^ +
+see <<custom,here>>, https://example.com[Example, role=ext] and image:logo.png[Logo, 10]`
+			expected := `a *bold* and **b**old, _italic_, ` + "`mono`" + `, ~sub~ and ^sup^ +
+see <<custom,here>>, https://example.com[Example,role=ext] and image:logo.png[Logo,10]
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("bare URLs", func() {
+			source := `see https://example.com and *https://example.com[]*`
+			expected := `see https://example.com and *https://example.com[]*
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+
+		It("link text with commas", func() {
+			source := `a link to http://website.com["A, B, and C", role=foo]`
+			expected := `a link to http://website.com["A, B, and C",role=foo]
+`
+			Expect(RenderAsciidoc(source)).To(Equal(expected))
+		})
+	})
+
+	It("should not format document which would not be parsed into the same content", func() {
+		source := `\*not bold*`
+		_, err := RenderAsciidoc(source)
+		Expect(err).To(MatchError("unable to format document: the formatted content does not match the source content"))
+	})
+
+})
@@ -0,0 +1,113 @@
+package asciidoc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// the attributes which are rendered by the element they belong to (eg: the kind of a source block),
+// or which are not part of the source document (eg: the `customID` flag)
+var implicitAttributes = map[string]bool{
+	types.AttrID:               true,
+	types.AttrCustomID:         true,
+	types.AttrOriginalID:       true,
+	types.AttrStyle:            true,
+	types.AttrTitle:            true,
+	types.AttrRole:             true,
+	types.AttrKind:             true,
+	types.AttrLanguage:         true,
+	types.AttrQuoteAuthor:      true,
+	types.AttrQuoteTitle:       true,
+	types.AttrAdmonitionKind:   true,
+	types.AttrCheckStyle:       true,
+	types.AttrLiteralBlockType: true,
+	types.AttrAuthors:          true,
+	types.AttrRevision:         true,
+}
+
+// renderAttributes renders the attributes of a block on their own lines, ie: the anchor (`[[id]]`),
+// the title (`.title`), the role (`[.role]`) and the other attributes (`[style,positional,key=value]`).
+// The attributes with the given keys are skipped (because they are rendered within the block itself)
+func renderAttributes(attrs types.ElementAttributes, skip ...string) []string {
+	result := []string{}
+	skipped := map[string]bool{}
+	for _, k := range skip {
+		skipped[k] = true
+	}
+	if id := attrs.GetAsString(types.AttrID); id != "" && !skipped[types.AttrID] {
+		result = append(result, "[["+id+"]]")
+	}
+	if title := attrs.GetAsString(types.AttrTitle); title != "" && !skipped[types.AttrTitle] {
+		result = append(result, "."+title)
+	}
+	if role := attrs.GetAsString(types.AttrRole); role != "" {
+		result = append(result, "[."+role+"]")
+	}
+	attributes := []string{}
+	switch attrs[types.AttrKind] {
+	case types.Source:
+		attributes = append(attributes, "source")
+		if language := attrs.GetAsString(types.AttrLanguage); language != "" {
+			attributes = append(attributes, language)
+		}
+	case types.Quote, types.Verse:
+		attributes = append(attributes, attrs.GetAsString(types.AttrKind))
+		author := attrs.GetAsString(types.AttrQuoteAuthor)
+		title := attrs.GetAsString(types.AttrQuoteTitle)
+		if author != "" || title != "" {
+			attributes = append(attributes, author)
+		}
+		if title != "" {
+			attributes = append(attributes, title)
+		}
+	}
+	if kind, ok := attrs[types.AttrAdmonitionKind].(types.AdmonitionKind); ok && !skipped[types.AttrAdmonitionKind] {
+		attributes = append(attributes, strings.ToUpper(string(kind)))
+	}
+	attributes = append(attributes, renderOtherAttributes(attrs, skipped)...)
+	if len(attributes) > 0 {
+		result = append(result, "["+strings.Join(attributes, ",")+"]")
+	}
+	return result
+}
+
+// renderOtherAttributes renders the attributes which have no specific syntax: first, the style (if any) and the ones
+// without value (eg: `linenums` or `%step`), then the `key=value` ones, sorted by key
+func renderOtherAttributes(attrs types.ElementAttributes, skipped map[string]bool) []string {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		if !implicitAttributes[k] && !skipped[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	positionals := []string{}
+	style := attrs.GetAsString(types.AttrStyle)
+	if v, found := attrs[style]; found && v == nil && !skipped[style] {
+		positionals = append(positionals, style)
+	}
+	named := []string{}
+	for _, k := range keys {
+		if k == style && attrs[k] == nil {
+			continue
+		}
+		if attrs[k] == nil {
+			positionals = append(positionals, k)
+			continue
+		}
+		named = append(named, k+"="+quoteAttributeValue(fmt.Sprintf("%v", attrs[k])))
+	}
+	return append(positionals, named...)
+}
+
+// quoteAttributeValue wraps the given value in double quotes if it contains a character which
+// would otherwise end the value (eg: a comma)
+func quoteAttributeValue(value string) string {
+	if value == "" || strings.ContainsAny(value, ",]\"") || strings.TrimSpace(value) != value {
+		return `"` + value + `"`
+	}
+	return value
+}
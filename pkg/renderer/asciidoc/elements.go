@@ -0,0 +1,323 @@
+package asciidoc
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderSection(s types.Section) ([]string, error) {
+	title, err := renderInlineElements(s.Title)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	result := []string{}
+	heading := strings.Repeat("=", s.Level+1) + " " + title
+	if id := s.Attributes.GetAsString(types.AttrID); id != "" && strings.TrimRightFunc(title, unicode.IsSpace) != title {
+		// retain the inline anchor, since the title ends with a space in this case
+		heading = heading + "[[" + id + "]]"
+		result = append(result, renderAttributes(s.Attributes, types.AttrID)...)
+	} else {
+		result = append(result, renderAttributes(s.Attributes)...)
+	}
+	result = append(result, heading)
+	if s.Level == 0 {
+		if authors, ok := s.Attributes[types.AttrAuthors].([]types.DocumentAuthor); ok && len(authors) > 0 {
+			result = append(result, renderAuthors(authors))
+		}
+		if revision, ok := s.Attributes[types.AttrRevision].(types.DocumentRevision); ok {
+			result = append(result, renderRevision(revision))
+		}
+	}
+	return result, nil
+}
+
+func renderAuthors(authors []types.DocumentAuthor) string {
+	result := make([]string, len(authors))
+	for i, author := range authors {
+		result[i] = strings.TrimSpace(author.FullName)
+		if author.Email != "" {
+			result[i] += " <" + author.Email + ">"
+		}
+	}
+	return strings.Join(result, "; ")
+}
+
+func renderRevision(revision types.DocumentRevision) string {
+	result := ""
+	if revision.Revnumber != "" {
+		result = "v" + revision.Revnumber
+	}
+	if revision.Revdate != "" {
+		if result != "" {
+			result += ", "
+		}
+		result += revision.Revdate
+	}
+	if revision.Revremark != "" {
+		result += ": " + revision.Revremark
+	}
+	return result
+}
+
+func renderParagraph(p types.Paragraph) ([]string, error) {
+	lines, err := renderLines(p.Lines)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render paragraph")
+	}
+	if kind, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok && len(lines) > 0 {
+		lines[0] = strings.ToUpper(string(kind)) + ": " + lines[0]
+		return append(renderAttributes(p.Attributes, types.AttrAdmonitionKind), lines...), nil
+	}
+	return append(renderAttributes(p.Attributes), lines...), nil
+}
+
+// renderLines renders each line of a paragraph
+func renderLines(lines [][]interface{}) ([]string, error) {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		l, err := renderInlineElements(line)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = l
+	}
+	return result, nil
+}
+
+// the delimiters of the blocks, indexed by their kind
+var delimiters = map[types.BlockKind]string{
+	types.Fenced:  "```",
+	types.Listing: "----",
+	types.Source:  "----",
+	types.Example: "====",
+	types.Comment: "////",
+	types.Quote:   "____",
+	types.Verse:   "____",
+	types.Sidebar: "****",
+}
+
+func renderDelimitedBlock(b types.DelimitedBlock) ([]string, error) {
+	delimiter, found := delimiters[b.Kind]
+	if !found {
+		return nil, errors.Errorf("unsupported kind of delimited block: '%s'", b.Kind)
+	}
+	var content []string
+	var err error
+	switch b.Kind {
+	case types.Example, types.Quote, types.Sidebar:
+		content, err = renderBlocks(b.Elements, false)
+	default:
+		// retain the content as-is, including the blank lines
+		content, err = renderVerbatimContent(b.Elements)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render delimited block")
+	}
+	result := renderAttributes(b.Attributes)
+	result = append(result, delimiter)
+	result = append(result, content...)
+	return append(result, delimiter), nil
+}
+
+func renderVerbatimContent(elements []interface{}) ([]string, error) {
+	result := []string{}
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.BlankLine:
+			result = append(result, "")
+		case types.StringElement:
+			result = append(result, e.Content)
+		case types.Paragraph:
+			lines, err := renderParagraph(e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, lines...)
+		default:
+			lines, err := renderBlock(e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, lines...)
+		}
+	}
+	return result, nil
+}
+
+func renderLiteralBlock(b types.LiteralBlock) ([]string, error) {
+	result := renderAttributes(b.Attributes)
+	switch b.Attributes.GetAsString(types.AttrLiteralBlockType) {
+	case types.LiteralBlockWithDelimiter:
+		result = append(result, "....")
+		result = append(result, b.Lines...)
+		return append(result, "...."), nil
+	case types.LiteralBlockWithAttribute:
+		result = append(result, "[literal]")
+		return append(result, b.Lines...), nil
+	default:
+		// the lines already start with spaces
+		return append(result, b.Lines...), nil
+	}
+}
+
+func renderImageBlock(img types.ImageBlock) ([]string, error) {
+	macro, err := renderImageMacro("image::", img.Location, img.Attributes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render image block")
+	}
+	return append(renderAttributes(img.Attributes, types.AttrImageAlt, types.AttrImageWidth, types.AttrImageHeight), macro), nil
+}
+
+func renderTable(t types.Table) ([]string, error) {
+	result := renderAttributes(t.Attributes)
+	result = append(result, "|===")
+	if len(t.Header.Cells) > 0 {
+		header, err := renderTableLine(t.Header)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+		result = append(result, header, "")
+	}
+	for _, l := range t.Lines {
+		line, err := renderTableLine(l)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+		result = append(result, line)
+	}
+	return append(result, "|==="), nil
+}
+
+func renderTableLine(l types.TableLine) (string, error) {
+	cells := make([]string, len(l.Cells))
+	for i, cell := range l.Cells {
+		content, err := renderInlineElements(cell)
+		if err != nil {
+			return "", err
+		}
+		cells[i] = "| " + strings.TrimSpace(content)
+	}
+	return strings.Join(cells, " "), nil
+}
+
+// the markers of the unordered list items, indexed by their bullet style
+var bulletMarkers = map[types.BulletStyle]string{
+	types.Dash:           "-",
+	types.OneAsterisk:    "*",
+	types.TwoAsterisks:   "**",
+	types.ThreeAsterisks: "***",
+	types.FourAsterisks:  "****",
+	types.FiveAsterisks:  "*****",
+}
+
+// the markers of the check styles of the unordered list items
+var checkMarkers = map[types.UnorderedListItemCheckStyle]string{
+	types.Checked:   "[x] ",
+	types.Unchecked: "[ ] ",
+}
+
+func renderUnorderedListItem(i types.UnorderedListItem) ([]string, error) {
+	marker, found := bulletMarkers[i.BulletStyle]
+	if !found {
+		return nil, errors.Errorf("unsupported bullet style: '%s'", i.BulletStyle)
+	}
+	return renderListItem(i.Attributes, marker+" "+checkMarkers[i.CheckStyle], i.Elements)
+}
+
+// the default numbering styles of the ordered list items, indexed by their level
+var defaultNumberingStyles = []types.NumberingStyle{types.Arabic, types.LowerAlpha, types.LowerRoman, types.UpperAlpha, types.UpperRoman}
+
+// the explicit markers of the ordered list items, indexed by their numbering style
+var numberingMarkers = map[types.NumberingStyle]string{
+	types.Arabic:     "1.",
+	types.LowerAlpha: "a.",
+	types.UpperAlpha: "A.",
+	types.LowerRoman: "i)",
+	types.UpperRoman: "I)",
+}
+
+func renderOrderedListItem(i types.OrderedListItem) ([]string, error) {
+	// use the implicit markers (`.`, `..`, etc.) when the numbering style is the default one for the level of the item
+	marker := strings.Repeat(".", i.Level)
+	if i.Level == 1 && i.NumberingStyle != types.Arabic {
+		m, found := numberingMarkers[i.NumberingStyle]
+		if !found {
+			return nil, errors.Errorf("unsupported numbering style: '%s'", i.NumberingStyle)
+		}
+		marker = m
+	} else if i.Level < 1 || i.Level > len(defaultNumberingStyles) || defaultNumberingStyles[i.Level-1] != i.NumberingStyle {
+		return nil, errors.Errorf("unsupported numbering style at level %d: '%s'", i.Level, i.NumberingStyle)
+	}
+	return renderListItem(i.Attributes, marker+" ", i.Elements)
+}
+
+func renderLabeledListItem(i types.LabeledListItem) ([]string, error) {
+	term, err := renderInlineElements(i.Term)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render labeled list item")
+	}
+	prefix := term + strings.Repeat(":", i.Level+1)
+	if len(i.Elements) == 0 {
+		return append(renderAttributes(i.Attributes), prefix), nil
+	}
+	return renderListItem(i.Attributes, prefix+" ", i.Elements)
+}
+
+// renderListItem renders the attributes of the list item, then the given prefix followed by the
+// first line of the item's content
+func renderListItem(attrs types.ElementAttributes, prefix string, elements []interface{}) ([]string, error) {
+	result := renderAttributes(attrs)
+	content := []string{}
+	for i, e := range elements {
+		var lines []string
+		var err error
+		if p, ok := e.(types.Paragraph); ok && i == 0 {
+			// the `checkstyle` attribute is rendered in the prefix
+			lines, err = renderParagraph(types.Paragraph{
+				Attributes: withoutAttributes(p.Attributes, types.AttrCheckStyle),
+				Lines:      p.Lines,
+			})
+		} else {
+			lines, err = renderBlock(e)
+			if i > 0 {
+				content = append(content, "+")
+			}
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render list item")
+		}
+		content = append(content, lines...)
+	}
+	if len(content) == 0 {
+		return append(result, strings.TrimSpace(prefix)), nil
+	}
+	content[0] = prefix + content[0]
+	return append(result, content...), nil
+}
+
+func renderContinuedListItemElement(c types.ContinuedListItemElement) ([]string, error) {
+	result := []string{}
+	for i := 0; i < -c.Offset; i++ {
+		result = append(result, "")
+	}
+	result = append(result, "+")
+	lines, err := renderBlock(c.Element)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render list item continuation")
+	}
+	return append(result, lines...), nil
+}
+
+// withoutAttributes returns a copy of the given attributes, without the ones with the given keys
+func withoutAttributes(attrs types.ElementAttributes, keys ...string) types.ElementAttributes {
+	result := types.ElementAttributes{}
+	result.AddAll(attrs)
+	for _, k := range keys {
+		delete(result, k)
+	}
+	return result
+}
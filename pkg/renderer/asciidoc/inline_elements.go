@@ -0,0 +1,251 @@
+package asciidoc
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderInlineElements renders the given inline elements. The quoted texts use the constrained markers
+// (eg: `*bold*`) unless they are surrounded by alphanumeric characters (eg: `**b**old`)
+func renderInlineElements(elements []interface{}) (string, error) {
+	rendered := make([]string, len(elements))
+	for i, e := range elements {
+		if _, ok := e.(types.QuotedText); ok {
+			continue // rendered in a second pass, once the surrounding elements are known
+		}
+		r, err := renderInlineElement(e)
+		if err != nil {
+			return "", err
+		}
+		rendered[i] = r
+	}
+	result := strings.Builder{}
+	for i, e := range elements {
+		if _, ok := e.(types.InlineLink); ok && !strings.HasSuffix(rendered[i], "]") && i+1 < len(elements) {
+			// a bare URL must be followed by a space, otherwise the following characters would be part of the URL
+			if first, _ := utf8.DecodeRuneInString(rendered[i+1]); rendered[i+1] != "" && !unicode.IsSpace(first) {
+				rendered[i] += "[]"
+			}
+		}
+		if t, ok := e.(types.QuotedText); ok {
+			var next string
+			if i+1 < len(elements) {
+				if _, ok := elements[i+1].(types.QuotedText); ok {
+					next = "*" // any non alphanumeric character
+				} else {
+					next = rendered[i+1]
+				}
+			}
+			r, err := renderQuotedText(t, result.String(), next)
+			if err != nil {
+				return "", err
+			}
+			rendered[i] = r
+		}
+		result.WriteString(rendered[i])
+	}
+	return result.String(), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(element interface{}) (string, error) {
+	switch e := element.(type) {
+	case nil:
+		return "", nil
+	case []interface{}:
+		// nested elements
+		return renderInlineElements(e)
+	case types.StringElement:
+		return e.Content, nil
+	case types.DocumentAttributeSubstitution:
+		return "{" + e.Name + "}", nil
+	case types.LineBreak:
+		return " +", nil
+	case types.Passthrough:
+		return renderPassthrough(e)
+	case types.InternalCrossReference:
+		if e.Label != "" {
+			return "<<" + e.ID + "," + e.Label + ">>", nil
+		}
+		return "<<" + e.ID + ">>", nil
+	case types.ExternalCrossReference:
+		location, err := renderInlineElements(e.Location.Elements)
+		if err != nil {
+			return "", err
+		}
+		label, err := renderInlineElements(e.Label)
+		if err != nil {
+			return "", err
+		}
+		return "xref:" + location + "[" + label + "]", nil
+	case types.InlineLink:
+		return renderInlineLink(e)
+	case types.InlineImage:
+		return renderImageMacro("image:", e.Location, e.Attributes)
+	case types.Footnote:
+		content, err := renderInlineElements(e.Elements)
+		if err != nil {
+			return "", err
+		}
+		return "footnote:" + e.Ref + "[" + content + "]", nil
+	case types.UserMacro:
+		return e.RawText, nil
+	case types.IndexTerm:
+		term, err := renderInlineElements(e.Term)
+		if err != nil {
+			return "", err
+		}
+		return "((" + term + "))", nil
+	case types.ConcealedIndexTerm:
+		terms := []string{}
+		for _, t := range []interface{}{e.Term1, e.Term2, e.Term3} {
+			if t, ok := t.(string); ok {
+				terms = append(terms, t)
+			}
+		}
+		return "(((" + strings.Join(terms, ",") + ")))", nil
+	case types.SingleLineComment:
+		return "//" + e.Content, nil
+	default:
+		return "", errors.Errorf("unsupported type of inline element: %T", element)
+	}
+}
+
+// the markers of the quoted texts, indexed by their kind
+var quotedTextMarkers = map[types.QuotedTextKind]string{
+	types.Bold:        "*",
+	types.Italic:      "_",
+	types.Monospace:   "`",
+	types.Subscript:   "~",
+	types.Superscript: "^",
+}
+
+// renderQuotedText renders the given quoted text, using the constrained marker (eg: `*`) if possible,
+// ie, if the text is not preceeded or followed by an alphanumeric character, and if it does not start
+// or end with a space. Otherwise, the unconstrained marker (eg: `**`) is used.
+func renderQuotedText(t types.QuotedText, previous, next string) (string, error) {
+	marker, found := quotedTextMarkers[t.Kind]
+	if !found {
+		return "", errors.Errorf("unsupported kind of quoted text: '%v'", t.Kind)
+	}
+	content, err := renderInlineElements(t.Elements)
+	if err != nil {
+		return "", err
+	}
+	if len(t.Elements) > 0 {
+		// a bare URL at the end of the content must be followed by the brackets, otherwise the closing marker would be
+		// part of the URL
+		if _, ok := t.Elements[len(t.Elements)-1].(types.InlineLink); ok && !strings.HasSuffix(content, "]") {
+			content += "[]"
+		}
+	}
+	if t.Kind == types.Subscript || t.Kind == types.Superscript {
+		return marker + content + marker, nil
+	}
+	last, _ := utf8.DecodeLastRuneInString(previous)
+	first, _ := utf8.DecodeRuneInString(next)
+	if content == "" || strings.TrimSpace(content) != content || isAlphanumeric(last) || isAlphanumeric(first) {
+		marker = marker + marker
+	}
+	return marker + content + marker, nil
+}
+
+func isAlphanumeric(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func renderPassthrough(p types.Passthrough) (string, error) {
+	content, err := renderInlineElements(p.Elements)
+	if err != nil {
+		return "", err
+	}
+	switch p.Kind {
+	case types.SinglePlusPassthrough:
+		return "+" + content + "+", nil
+	case types.TriplePlusPassthrough:
+		return "+++" + content + "+++", nil
+	case types.PassthroughMacro:
+		for _, e := range p.Elements {
+			if _, ok := e.(types.QuotedText); ok {
+				return "pass:q[" + content + "]", nil
+			}
+		}
+		return "pass:[" + content + "]", nil
+	default:
+		return "", errors.Errorf("unsupported kind of passthrough: '%v'", p.Kind)
+	}
+}
+
+// the schemes of the locations which can be written without the `link:` prefix
+var urlSchemeRegexp = regexp.MustCompile(`^(https?|ftp|irc)://|^mailto:`)
+
+func renderInlineLink(l types.InlineLink) (string, error) {
+	location, err := renderInlineElements(l.Location.Elements)
+	if err != nil {
+		return "", err
+	}
+	attributes := []string{}
+	if text, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		t, err := renderInlineElements(text)
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(t, ",") {
+			t = `"` + t + `"`
+		}
+		attributes = append(attributes, t)
+	}
+	attributes = append(attributes, renderInlineAttributes(l.Attributes, map[string]bool{types.AttrInlineLinkText: true})...)
+	if !urlSchemeRegexp.MatchString(location) {
+		location = "link:" + location
+	}
+	if len(attributes) == 0 && !strings.HasPrefix(location, "link:") && !strings.HasPrefix(location, "mailto:") {
+		return location, nil
+	}
+	return location + "[" + strings.Join(attributes, ",") + "]", nil
+}
+
+// renderInlineAttributes renders the attributes of an inline element (except the ones with the given keys),
+// in which the `id`, `title` and `role` attributes are also named attributes
+func renderInlineAttributes(attrs types.ElementAttributes, skipped map[string]bool) []string {
+	result := []string{}
+	for _, k := range []string{types.AttrID, types.AttrRole, types.AttrTitle} {
+		if v := attrs.GetAsString(k); v != "" && !skipped[k] {
+			result = append(result, k+"="+quoteAttributeValue(v))
+		}
+	}
+	return append(result, renderOtherAttributes(attrs, skipped)...)
+}
+
+// renderImageMacro renders an image macro with the given prefix (`image:` or `image::`), location and
+// attributes, where the `alt`, `width` and `height` attributes are positional
+func renderImageMacro(prefix string, location types.Location, attrs types.ElementAttributes) (string, error) {
+	loc, err := renderInlineElements(location.Elements)
+	if err != nil {
+		return "", err
+	}
+	attributes := []string{
+		attrs.GetAsString(types.AttrImageAlt),
+		attrs.GetAsString(types.AttrImageWidth),
+		attrs.GetAsString(types.AttrImageHeight),
+	}
+	// discard the trailing empty positional attributes
+	for len(attributes) > 0 && attributes[len(attributes)-1] == "" {
+		attributes = attributes[:len(attributes)-1]
+	}
+	if prefix == "image:" {
+		// other attributes of block images are rendered on their own lines
+		attributes = append(attributes, renderInlineAttributes(attrs, map[string]bool{
+			types.AttrImageAlt:    true,
+			types.AttrImageWidth:  true,
+			types.AttrImageHeight: true,
+		})...)
+	}
+	return prefix + loc + "[" + strings.Join(attributes, ",") + "]", nil
+}
@@ -1,13 +1,17 @@
 package renderer
 
 import (
+	"context"
+
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
 // Context is a custom implementation of the standard golang context.Context interface,
-// which carries the types.Document which is being processed
+// which carries the types.Document which is being processed.
+// The renderers stop with the error of the embedded context.Context when it is done.
 type Context struct {
+	context.Context
 	Config configuration.Configuration
 	// TableOfContents exists even if the document did not specify the `:toc:` attribute.
 	// It will take into account the configured `:toclevels:` attribute value.
@@ -26,6 +30,7 @@ type Context struct {
 func NewContext(doc types.Document, config configuration.Configuration) Context {
 	_, hasHeader := doc.Header()
 	return Context{
+		Context:           context.Background(),
 		Config:            config,
 		counters:          make(map[string]int),
 		Attributes:        doc.Attributes,
@@ -0,0 +1,600 @@
+package epub3
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha1" //nolint: gosec
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MediaType the media type of an EPUB publication, written in the `mimetype` entry of the archive
+	MediaType = "application/epub+zip"
+	// the directory in which all the publication resources are written
+	contentDir = "OEBPS"
+	// the lang used when the document has no `lang` attribute
+	defaultLang = "en"
+)
+
+// Render renders the given document as an EPUB3 publication (ie, a zip archive) and writes the result in the given `output`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	renderedTitle, err := renderDocumentTitle(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	// the document header is rendered in a title page of its own, so the
+	// level 0 sections (ie, the `parts` of a book) must not be skipped when rendering the chapters
+	ctx.Config.IncludeHeaderFooter = true
+	// needs to be set before looking-up the location of each section
+	toc, err := html5.NewTableOfContents(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	lastUpdated := ctx.Config.LastUpdated
+	if lastUpdated.IsZero() {
+		lastUpdated = time.Now()
+	}
+	p := publication{
+		Title:      string(renderedTitle),
+		Lang:       doc.Attributes.GetAsStringWithDefault("lang", defaultLang),
+		Doctype:    doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
+		RevNumber:  doc.Attributes.GetAsStringWithDefault("revnumber", ""),
+		RevDate:    doc.Attributes.GetAsStringWithDefault("revdate", ""),
+		RevRemark:  doc.Attributes.GetAsStringWithDefault("revremark", ""),
+		Modified:   lastUpdated.UTC().Format("2006-01-02T15:04:05Z"),
+		Identifier: doc.Attributes.GetAsStringWithDefault("uuid", ""),
+	}
+	for _, author := range doc.Attributes.GetAuthors() {
+		p.Authors = append(p.Authors, author.FullName)
+	}
+	if p.Identifier == "" {
+		p.Identifier = newIdentifier(p.Title, p.RevNumber)
+	}
+	// render the content documents
+	p.Documents, err = renderContentDocuments(ctx, doc, p)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	resolveReferences(p.Documents)
+	p.Images = collectImages(ctx, doc)
+	removeMissingImages(p.Documents, p.Images)
+	nav, err := renderNavigationDocument(p, toc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	pkg, err := renderPackageDocument(p)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	if err := writeArchive(output, p, nav, pkg, lastUpdated); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	// generate the metadata to be returned to the caller
+	return types.Metadata{
+		Title:           p.Title,
+		LastUpdated:     p.Modified,
+		TableOfContents: toc,
+	}, nil
+}
+
+// publication the data used to generate the EPUB package
+type publication struct {
+	Identifier string
+	Title      string
+	Lang       string
+	Doctype    string
+	Authors    []string
+	RevNumber  string
+	RevDate    string
+	RevRemark  string
+	Modified   string
+	Documents  []contentDocument
+	Images     []image
+}
+
+// contentDocument an XHTML content document in the publication
+type contentDocument struct {
+	ID       string // the ID of the item in the manifest
+	Filename string
+	Title    string
+	Type     string // the `epub:type` of the document (eg: `chapter`, `titlepage`, etc.)
+	Content  []byte
+}
+
+// image an image resource in the publication
+type image struct {
+	ID        string // the ID of the item in the manifest
+	Href      string // the location of the image, relative to the package document
+	MediaType string
+	Content   []byte
+}
+
+func renderDocumentTitle(ctx renderer.Context, doc types.Document) ([]byte, error) {
+	if header, exists := doc.Header(); exists {
+		title, err := html5.RenderPlainText(ctx, header.Title)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render document title")
+		}
+		return title, nil
+	}
+	return nil, nil
+}
+
+// newIdentifier returns a stable `urn:uuid` identifier for the publication,
+// computed from its title and revision number (in the form of a name-based UUID)
+func newIdentifier(title, revnumber string) string {
+	h := sha1.Sum([]byte(title + "\x00" + revnumber)) //nolint: gosec
+	h[6] = (h[6] & 0x0f) | 0x50                       // version 5
+	h[8] = (h[8] & 0x3f) | 0x80                       // RFC 4122 variant
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10], h[10:16])
+}
+
+// chapter the elements of a content document, before it is rendered
+type chapter struct {
+	title    interface{} // the title to render, or a string
+	kind     string
+	elements []interface{}
+}
+
+// splitChapters splits the document elements in chapters:
+// - the preamble is a chapter on its own,
+// - each level 0 section (ie, a `part` in a book) is a chapter which excludes its child sections,
+// - each level 1 section is a chapter.
+// Other doctypes than `book` are not split.
+func splitChapters(doc types.Document) []chapter {
+	elements := doc.Elements
+	if header, exists := doc.Header(); exists {
+		elements = make([]interface{}, 0, len(header.Elements)+len(doc.Elements)-1)
+		elements = append(elements, header.Elements...)
+		elements = append(elements, doc.Elements[1:]...)
+	}
+	if doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article") != "book" {
+		return []chapter{
+			{
+				kind:     "bodymatter",
+				elements: elements,
+			},
+		}
+	}
+	result := []chapter{}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.TableOfContentsPlaceHolder:
+			// the table of contents is in the navigation document
+			continue
+		case types.Preamble:
+			result = append(result, chapter{
+				title:    "Preface",
+				kind:     "preface",
+				elements: []interface{}{e},
+			})
+		case types.Section:
+			switch e.Level {
+			case 0:
+				part := e
+				part.Elements = []interface{}{}
+				children := []chapter{}
+				for _, child := range e.Elements {
+					if s, ok := child.(types.Section); ok {
+						children = append(children, chapter{
+							title:    s.Title,
+							kind:     "chapter",
+							elements: []interface{}{s},
+						})
+						continue
+					}
+					part.Elements = append(part.Elements, child)
+				}
+				result = append(result, chapter{
+					title:    e.Title,
+					kind:     "part",
+					elements: []interface{}{part},
+				})
+				result = append(result, children...)
+			default:
+				result = append(result, chapter{
+					title:    e.Title,
+					kind:     "chapter",
+					elements: []interface{}{e},
+				})
+			}
+		default:
+			// elements which are not in a section are appended to the last chapter,
+			// or become a chapter on their own when they are at the beginning of the document
+			if len(result) == 0 {
+				result = append(result, chapter{
+					kind: "bodymatter",
+				})
+			}
+			result[len(result)-1].elements = append(result[len(result)-1].elements, e)
+		}
+	}
+	return result
+}
+
+func renderContentDocuments(ctx renderer.Context, doc types.Document, p publication) ([]contentDocument, error) {
+	result := []contentDocument{}
+	if _, exists := doc.Header(); exists && !doc.Attributes.Has(types.AttrNoHeader) {
+		content := &bytes.Buffer{}
+		err := titlePageTmpl.Execute(content, p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render title page")
+		}
+		result = append(result, contentDocument{
+			ID:       "titlepage",
+			Filename: "titlepage.xhtml",
+			Title:    p.Title,
+			Type:     "titlepage",
+			Content:  content.Bytes(),
+		})
+	}
+	for i, c := range splitChapters(doc) {
+		content, err := html5.RenderElements(ctx, c.elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render chapter %d", i+1)
+		}
+		title := p.Title
+		switch t := c.title.(type) {
+		case string:
+			title = t
+		case []interface{}:
+			renderedTitle, err := html5.RenderPlainText(ctx, t)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render chapter %d", i+1)
+			}
+			title = string(renderedTitle)
+		}
+		id := fmt.Sprintf("chapter-%02d", i+1)
+		result = append(result, contentDocument{
+			ID:       id,
+			Filename: id + ".xhtml",
+			Title:    title,
+			Type:     c.kind,
+			Content:  content,
+		})
+	}
+	if len(doc.Footnotes) > 0 {
+		content, err := html5.RenderFootnotes(ctx, doc.Footnotes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnotes")
+		}
+		result = append(result, contentDocument{
+			ID:       "footnotes",
+			Filename: "footnotes.xhtml",
+			Title:    "Footnotes",
+			Type:     "endnotes",
+			Content:  content,
+		})
+	}
+	for i, d := range result {
+		content := &bytes.Buffer{}
+		err := contentDocumentTmpl.Execute(content, struct {
+			Lang    string
+			Title   string
+			Doctype string
+			Type    string
+			Content string
+		}{
+			Lang:    p.Lang,
+			Title:   d.Title,
+			Doctype: p.Doctype,
+			Type:    d.Type,
+			Content: string(toXHTML(d.Content)),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render content document '%s'", d.Filename)
+		}
+		// let the postprocessors rewrite each content document, since the publication is a zip archive
+		rendered := content.Bytes()
+		for _, p := range ctx.Config.Postprocessors() {
+			if rendered, err = p(rendered); err != nil {
+				return nil, errors.Wrapf(err, "unable to postprocess content document '%s'", d.Filename)
+			}
+		}
+		result[i].Content = rendered
+	}
+	return result, nil
+}
+
+var idRegexp = regexp.MustCompile(`\sid="([^"]+)"`)
+var localHrefRegexp = regexp.MustCompile(`\shref="#([^"]+)"`)
+
+// resolveReferences rewrites the local `href="#id"` links of the content documents
+// when the target element is in another document of the publication
+func resolveReferences(documents []contentDocument) {
+	locations := map[string]string{}
+	for _, d := range documents {
+		for _, m := range idRegexp.FindAllSubmatch(d.Content, -1) {
+			if _, exists := locations[string(m[1])]; !exists {
+				locations[string(m[1])] = d.Filename
+			}
+		}
+	}
+	for i, d := range documents {
+		documents[i].Content = localHrefRegexp.ReplaceAllFunc(d.Content, func(href []byte) []byte {
+			id := string(localHrefRegexp.FindSubmatch(href)[1])
+			if filename, exists := locations[id]; exists && filename != d.Filename {
+				return []byte(fmt.Sprintf(` href="%s#%s"`, filename, id))
+			}
+			return href
+		})
+	}
+}
+
+var voidElementRegexp = regexp.MustCompile(`<(area|base|br|col|embed|hr|img|input|link|meta|param|source|track|wbr)(\s[^<>]*?)?\s*/?>`)
+
+// toXHTML converts the given HTML content into XHTML, ie, closes the void elements such as `<br>` or `<img>`
+func toXHTML(content []byte) []byte {
+	return voidElementRegexp.ReplaceAll(content, []byte("<$1$2/>"))
+}
+
+// navSection a section in the navigation document
+type navSection struct {
+	Href     string
+	Title    string
+	Children string
+}
+
+func renderNavigationDocument(p publication, toc types.TableOfContents) ([]byte, error) {
+	locations := map[string]string{}
+	for _, d := range p.Documents {
+		for _, m := range idRegexp.FindAllSubmatch(d.Content, -1) {
+			if _, exists := locations[string(m[1])]; !exists {
+				locations[string(m[1])] = d.Filename
+			}
+		}
+	}
+	sections, err := renderNavigationSections(toc.Sections, locations)
+	if err != nil {
+		return nil, err
+	}
+	if sections == "" {
+		// the navigation document must contain at least one entry
+		sections = "<ol>"
+		for _, d := range p.Documents {
+			sections += fmt.Sprintf("\n<li><a href=\"%s\">%s</a></li>", d.Filename, html5.EscapeString(d.Title))
+		}
+		sections += "\n</ol>"
+	}
+	result := &bytes.Buffer{}
+	err = navTmpl.Execute(result, struct {
+		Lang     string
+		Title    string
+		Sections string
+	}{
+		Lang:     p.Lang,
+		Title:    p.Title,
+		Sections: string(toXHTML([]byte(sections))),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render navigation document")
+	}
+	return result.Bytes(), nil
+}
+
+func renderNavigationSections(sections []types.ToCSection, locations map[string]string) (string, error) {
+	if len(sections) == 0 {
+		return "", nil
+	}
+	data := make([]navSection, 0, len(sections))
+	for _, s := range sections {
+		children, err := renderNavigationSections(s.Children, locations)
+		if err != nil {
+			return "", err
+		}
+		data = append(data, navSection{
+			Href:     locations[s.ID] + "#" + s.ID,
+			Title:    s.Title,
+			Children: children,
+		})
+	}
+	result := &bytes.Buffer{}
+	if err := navSectionsTmpl.Execute(result, data); err != nil {
+		return "", errors.Wrapf(err, "unable to render navigation document")
+	}
+	return result.String(), nil
+}
+
+// manifestItem an item in the package manifest
+type manifestItem struct {
+	ID         string
+	Href       string
+	MediaType  string
+	Properties string
+}
+
+func renderPackageDocument(p publication) ([]byte, error) {
+	items := []manifestItem{
+		{
+			ID:         "nav",
+			Href:       "nav.xhtml",
+			MediaType:  "application/xhtml+xml",
+			Properties: "nav",
+		},
+	}
+	spine := []string{}
+	for _, d := range p.Documents {
+		items = append(items, manifestItem{
+			ID:        d.ID,
+			Href:      d.Filename,
+			MediaType: "application/xhtml+xml",
+		})
+		spine = append(spine, d.ID)
+	}
+	for _, i := range p.Images {
+		items = append(items, manifestItem{
+			ID:        i.ID,
+			Href:      i.Href,
+			MediaType: i.MediaType,
+		})
+	}
+	result := &bytes.Buffer{}
+	err := packageTmpl.Execute(result, struct {
+		publication
+		Items []manifestItem
+		Spine []string
+	}{
+		publication: p,
+		Items:       items,
+		Spine:       spine,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render package document")
+	}
+	return result.Bytes(), nil
+}
+
+func writeArchive(output io.Writer, p publication, nav, pkg []byte, modified time.Time) error {
+	w := zip.NewWriter(output)
+	// the `mimetype` file must be the first entry in the archive, and it must not be compressed
+	// nor have an `extra` field (hence the MS-DOS date and time instead of the `Modified` field)
+	mimetype := []byte(MediaType)
+	f, err := w.CreateHeader(&zip.FileHeader{
+		Name:         "mimetype",
+		Method:       zip.Store,
+		ModifiedDate: uint16((modified.Year()-1980)<<9 | int(modified.Month())<<5 | modified.Day()),
+		ModifiedTime: uint16(modified.Hour()<<11 | modified.Minute()<<5 | modified.Second()>>1),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to write 'mimetype' entry")
+	}
+	if _, err := f.Write(mimetype); err != nil {
+		return errors.Wrap(err, "unable to write 'mimetype' entry")
+	}
+	container := &bytes.Buffer{}
+	err = containerTmpl.Execute(container, struct {
+		PackagePath string
+	}{
+		PackagePath: path.Join(contentDir, "content.opf"),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to render container")
+	}
+	entries := []struct {
+		name    string
+		content []byte
+	}{
+		{name: "META-INF/container.xml", content: container.Bytes()},
+		{name: path.Join(contentDir, "content.opf"), content: pkg},
+		{name: path.Join(contentDir, "nav.xhtml"), content: nav},
+	}
+	for _, d := range p.Documents {
+		entries = append(entries, struct {
+			name    string
+			content []byte
+		}{name: path.Join(contentDir, d.Filename), content: d.Content})
+	}
+	for _, i := range p.Images {
+		entries = append(entries, struct {
+			name    string
+			content []byte
+		}{name: path.Join(contentDir, i.Href), content: i.Content})
+	}
+	for _, e := range entries {
+		f, err := w.CreateHeader(&zip.FileHeader{
+			Name:     e.name,
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to write '%s' entry", e.name)
+		}
+		if _, err := f.Write(e.content); err != nil {
+			return errors.Wrapf(err, "unable to write '%s' entry", e.name)
+		}
+	}
+	return w.Close()
+}
+
+// collectImages loads the images referenced in the document.
+// Images are read with the include resolver of the configuration, relatively to the document being converted, and
+// remote images (or images outside of its directory) are not included in the publication.
+func collectImages(ctx renderer.Context, doc types.Document) []image {
+	result := []image{}
+	elements := make([]interface{}, 0, len(doc.Elements)+len(doc.Footnotes))
+	elements = append(elements, doc.Elements...)
+	for _, n := range doc.Footnotes {
+		elements = append(elements, n.Elements)
+	}
+	for _, location := range imageLocations(elements) {
+		if strings.Contains(location, "://") || filepath.IsAbs(location) || strings.HasPrefix(path.Clean(location), "..") {
+			ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "image '%s' is not included in the EPUB publication", location)
+			continue
+		}
+		mediaType, supported := imageMediaTypes[strings.ToLower(path.Ext(location))]
+		if !supported {
+			ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "image '%s' is not included in the EPUB publication: unsupported media type", location)
+			continue
+		}
+		content, err := readImage(ctx, location)
+		if err != nil {
+			ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "image '%s' is not included in the EPUB publication: %v", location, err)
+			continue
+		}
+		result = append(result, image{
+			ID:        fmt.Sprintf("image-%02d", len(result)+1),
+			Href:      location, // must match the `src` attribute of the image in the content documents
+			MediaType: mediaType,
+			Content:   content,
+		})
+	}
+	return result
+}
+
+func readImage(ctx renderer.Context, location string) ([]byte, error) {
+	f, _, err := ctx.Config.IncludeResolver().Resolve(ctx, filepath.FromSlash(location), types.ElementAttributes{}, ctx.Config.Filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+var imgRegexp = regexp.MustCompile(`<img\s[^<>]*/>`)
+var srcRegexp = regexp.MustCompile(`\ssrc="([^"]*)"`)
+var altRegexp = regexp.MustCompile(`\salt="([^"]*)"`)
+
+// removeMissingImages replaces the images which are not included in the publication with their alternate text
+// in the content documents, since all the resources of the publication must be in the archive
+func removeMissingImages(documents []contentDocument, images []image) {
+	included := make(map[string]bool, len(images))
+	for _, i := range images {
+		included[i.Href] = true
+	}
+	for i, d := range documents {
+		documents[i].Content = imgRegexp.ReplaceAllFunc(d.Content, func(img []byte) []byte {
+			src := srcRegexp.FindSubmatch(img)
+			if src == nil || included[html.UnescapeString(string(src[1]))] || bytes.HasPrefix(src[1], []byte("data:")) {
+				return img
+			}
+			alt := []byte{}
+			if m := altRegexp.FindSubmatch(img); m != nil {
+				alt = m[1] // already escaped
+			}
+			return []byte(fmt.Sprintf(`<span class="alt">%s</span>`, alt))
+		})
+	}
+}
+
+var imageMediaTypes = map[string]string{
+	".gif":  "image/gif",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".svg":  "image/svg+xml",
+	".webp": "image/webp",
+}
@@ -0,0 +1,317 @@
+package epub3_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("epub3 publications", func() {
+
+	Context("book", func() {
+
+		var publication *zip.Reader
+
+		BeforeEach(func() {
+			var err error
+			publication, err = testsupport.RenderEPUB3Document("../../../test/epub/handbook.adoc")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should be a valid publication", func() {
+			verifyPublication(publication)
+		})
+
+		It("should store the mimetype as the first entry, without compression", func() {
+			Expect(publication.File).NotTo(BeEmpty())
+			mimetype := publication.File[0]
+			Expect(mimetype.Name).To(Equal("mimetype"))
+			Expect(mimetype.Method).To(Equal(zip.Store))
+			Expect(mimetype.CompressedSize64).To(Equal(uint64(len("application/epub+zip"))))
+			Expect(mimetype.UncompressedSize64).To(Equal(uint64(len("application/epub+zip"))))
+			// the content of the `mimetype` entry starts right after its local header (30 bytes + the name), at offset 38
+			offset, err := mimetype.DataOffset()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(offset).To(Equal(int64(38)))
+		})
+
+		It("should contain metadata", func() {
+			pkg := readPackage(publication)
+			Expect(pkg.Metadata.Titles).To(Equal([]string{"The Handbook"}))
+			Expect(pkg.Metadata.Creators).To(Equal([]string{"John Doe", "Jane Doe"}))
+			Expect(pkg.Metadata.Languages).To(Equal([]string{"en"}))
+			Expect(pkg.Metadata.Identifier).To(HavePrefix("urn:uuid:"))
+			Expect(pkg.Metadata.Metas).To(ContainElement(meta{Name: "revnumber", Content: "1.0"}))
+		})
+
+		It("should contain chapters and image", func() {
+			pkg := readPackage(publication)
+			Expect(pkg.Spine).To(Equal([]itemref{
+				{IDRef: "titlepage"},
+				{IDRef: "chapter-01"},
+				{IDRef: "chapter-02"},
+				{IDRef: "chapter-03"},
+				{IDRef: "footnotes"},
+			}))
+			Expect(pkg.Manifest).To(ContainElement(item{
+				ID:        "image-01",
+				Href:      "images/cover.png",
+				MediaType: "image/png",
+			}))
+			chapter := readEntry(publication, "OEBPS/chapter-02.xhtml")
+			Expect(chapter).To(ContainSubstring(`<img src="images/cover.png" alt="Cover"/>`))
+			Expect(chapter).To(ContainSubstring(`<a href="chapter-03.xhtml#_working_together">`))
+			Expect(chapter).To(ContainSubstring(`href="footnotes.xhtml#_footnotedef_1"`))
+			Expect(readEntry(publication, "OEBPS/chapter-03.xhtml")).To(ContainSubstring("<br/>"))
+		})
+
+		It("should contain navigation document", func() {
+			nav := readEntry(publication, "OEBPS/nav.xhtml")
+			Expect(nav).To(ContainSubstring(`<nav epub:type="toc" id="toc">`))
+			Expect(nav).To(ContainSubstring(`<li><a href="chapter-02.xhtml#_getting_started">Getting Started</a>`))
+			Expect(nav).To(ContainSubstring(`<li><a href="chapter-02.xhtml#_setup">Setup</a></li>`))
+			Expect(nav).To(ContainSubstring(`<li><a href="chapter-03.xhtml#_working_together">Working Together</a></li>`))
+		})
+	})
+
+	Context("article", func() {
+
+		It("should be a valid publication with a single chapter", func() {
+			source := `= An Article
+:uuid: urn:uuid:6b2b4b0e-1b0d-4c45-8a40-0d7b7dd6e1c5
+:lang: fr
+
+== Section A
+
+content with an image:unknown.png[] that cannot be found`
+			publication, err := testsupport.RenderEPUB3(source)
+			Expect(err).NotTo(HaveOccurred())
+			verifyPublication(publication)
+			pkg := readPackage(publication)
+			Expect(pkg.Metadata.Identifier).To(Equal("urn:uuid:6b2b4b0e-1b0d-4c45-8a40-0d7b7dd6e1c5"))
+			Expect(pkg.Metadata.Languages).To(Equal([]string{"fr"}))
+			Expect(pkg.Spine).To(Equal([]itemref{
+				{IDRef: "titlepage"},
+				{IDRef: "chapter-01"},
+			}))
+			// missing images are not included in the publication, and they are replaced with their alternate text
+			chapter := readEntry(publication, "OEBPS/chapter-01.xhtml")
+			Expect(chapter).NotTo(ContainSubstring(`<img`))
+			Expect(chapter).To(ContainSubstring(`content with an <span class="image"><span class="alt">unknown</span></span> that cannot be found`))
+		})
+
+		It("should escape the document and section titles", func() {
+			source := `= Tom & Jerry <3
+
+== Cats & Dogs
+
+content`
+			publication, err := testsupport.RenderEPUB3(source)
+			Expect(err).NotTo(HaveOccurred())
+			verifyPublication(publication)
+			Expect(readEntry(publication, "OEBPS/titlepage.xhtml")).To(ContainSubstring(`<h1>Tom &amp; Jerry &lt;3</h1>`))
+			Expect(readEntry(publication, "OEBPS/nav.xhtml")).To(ContainSubstring(`<a href="chapter-01.xhtml#_cats_dogs">Cats &amp; Dogs</a>`))
+		})
+
+		It("should read images with the include resolver", func() {
+			source := `= An Article
+
+image::images/cover.png[Cover]`
+			publication, err := testsupport.RenderEPUB3(source,
+				configuration.WithFilename("docs/article.adoc"),
+				configuration.WithFS(fstest.MapFS{
+					"docs/images/cover.png": {Data: []byte("cover")},
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			verifyPublication(publication)
+			Expect(readPackage(publication).Manifest).To(ContainElement(item{
+				ID:        "image-01",
+				Href:      "images/cover.png",
+				MediaType: "image/png",
+			}))
+			Expect(readEntry(publication, "OEBPS/images/cover.png")).To(Equal("cover"))
+		})
+
+		It("should not read images outside of the base directory in safe mode", func() {
+			dir, err := ioutil.TempDir("", "libasciidoc-epub3")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			Expect(os.MkdirAll(filepath.Join(dir, "docs", "images"), 0755)).To(Succeed())
+			Expect(os.Symlink(filepath.Join(dir, "secret.png"), filepath.Join(dir, "docs", "images", "cover.png"))).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "secret.png"), []byte("secret"), 0644)).To(Succeed())
+			source := `= An Article
+
+image::images/cover.png[Cover]`
+			publication, err := testsupport.RenderEPUB3(source,
+				configuration.WithFilename(filepath.Join(dir, "docs", "article.adoc")),
+				configuration.WithSafeMode(configuration.Safe))
+			Expect(err).NotTo(HaveOccurred())
+			verifyPublication(publication)
+			Expect(readPackage(publication).Manifest).NotTo(ContainElement(WithTransform(func(i item) string {
+				return i.Href
+			}, Equal("images/cover.png"))))
+		})
+
+		It("should be a valid publication without header", func() {
+			publication, err := testsupport.RenderEPUB3("hello, world!")
+			Expect(err).NotTo(HaveOccurred())
+			verifyPublication(publication)
+		})
+	})
+})
+
+type opf struct {
+	Metadata struct {
+		Identifier string   `xml:"identifier"`
+		Titles     []string `xml:"title"`
+		Creators   []string `xml:"creator"`
+		Languages  []string `xml:"language"`
+		Metas      []meta   `xml:"meta"`
+	} `xml:"metadata"`
+	Manifest []item    `xml:"manifest>item"`
+	Spine    []itemref `xml:"spine>itemref"`
+}
+
+type meta struct {
+	Name     string `xml:"name,attr"`
+	Content  string `xml:"content,attr"`
+	Property string `xml:"property,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type item struct {
+	ID         string `xml:"id,attr"`
+	Href       string `xml:"href,attr"`
+	MediaType  string `xml:"media-type,attr"`
+	Properties string `xml:"properties,attr"`
+}
+
+type itemref struct {
+	IDRef string `xml:"idref,attr"`
+}
+
+func readEntry(publication *zip.Reader, name string) string {
+	for _, f := range publication.File {
+		if f.Name == name {
+			r, err := f.Open()
+			Expect(err).NotTo(HaveOccurred())
+			defer r.Close()
+			content, err := ioutil.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			return string(content)
+		}
+	}
+	Fail("missing entry in archive: " + name)
+	return ""
+}
+
+func readPackage(publication *zip.Reader) opf {
+	container := struct {
+		Rootfiles []struct {
+			FullPath  string `xml:"full-path,attr"`
+			MediaType string `xml:"media-type,attr"`
+		} `xml:"rootfiles>rootfile"`
+	}{}
+	Expect(xml.Unmarshal([]byte(readEntry(publication, "META-INF/container.xml")), &container)).To(Succeed())
+	Expect(container.Rootfiles).To(HaveLen(1))
+	Expect(container.Rootfiles[0].MediaType).To(Equal("application/oebps-package+xml"))
+	pkg := opf{}
+	Expect(xml.Unmarshal([]byte(readEntry(publication, container.Rootfiles[0].FullPath)), &pkg)).To(Succeed())
+	return pkg
+}
+
+var idRegexp = regexp.MustCompile(`\sid="([^"]+)"`)
+var referenceRegexp = regexp.MustCompile(`\s(?:href|src)="([^"]+)"`)
+
+// verifyPublication verifies the structural rules of the given publication (as checked by `epubcheck`)
+func verifyPublication(publication *zip.Reader) {
+	// mimetype must be the first entry, uncompressed
+	Expect(publication.File).NotTo(BeEmpty())
+	Expect(publication.File[0].Name).To(Equal("mimetype"))
+	Expect(publication.File[0].Method).To(Equal(zip.Store))
+	Expect(publication.File[0].Extra).To(BeEmpty())
+	Expect(readEntry(publication, "mimetype")).To(Equal("application/epub+zip"))
+	pkg := readPackage(publication)
+	// required metadata
+	Expect(pkg.Metadata.Identifier).NotTo(BeEmpty())
+	Expect(pkg.Metadata.Titles).NotTo(BeEmpty())
+	Expect(pkg.Metadata.Languages).NotTo(BeEmpty())
+	Expect(pkg.Metadata.Metas).To(ContainElement(WithTransform(func(m meta) string {
+		return m.Property
+	}, Equal("dcterms:modified"))))
+	// manifest items exist in the archive, and there's a single navigation document
+	entries := map[string]string{}
+	ids := map[string]bool{}
+	navs := 0
+	for _, i := range pkg.Manifest {
+		Expect(ids).NotTo(HaveKey(i.ID))
+		ids[i.ID] = true
+		entries[path.Join("OEBPS", i.Href)] = i.MediaType
+		readEntry(publication, path.Join("OEBPS", i.Href))
+		if i.Properties == "nav" {
+			navs++
+		}
+	}
+	Expect(navs).To(Equal(1))
+	// spine items refer to manifest items
+	Expect(pkg.Spine).NotTo(BeEmpty())
+	for _, i := range pkg.Spine {
+		Expect(ids).To(HaveKey(i.IDRef))
+	}
+	// content documents are well-formed, and their local references can be resolved
+	anchors := map[string]bool{}
+	for name, mediaType := range entries {
+		if mediaType != "application/xhtml+xml" {
+			continue
+		}
+		content := readEntry(publication, name)
+		decoder := xml.NewDecoder(strings.NewReader(content))
+		decoder.Strict = true
+		decoder.Entity = xml.HTMLEntity
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred(), "content document '%s' is not well-formed:\n%s", name, content)
+		}
+		for _, m := range idRegexp.FindAllStringSubmatch(content, -1) {
+			anchors[path.Base(name)+"#"+m[1]] = true
+		}
+	}
+	for name, mediaType := range entries {
+		if mediaType != "application/xhtml+xml" {
+			continue
+		}
+		for _, m := range referenceRegexp.FindAllStringSubmatch(readEntry(publication, name), -1) {
+			ref := m[1]
+			if strings.Contains(ref, "://") || strings.HasPrefix(ref, "mailto:") {
+				continue
+			}
+			if strings.HasPrefix(ref, "#") {
+				ref = path.Base(name) + ref
+			}
+			target := ref
+			if i := strings.Index(ref, "#"); i >= 0 {
+				target = ref[:i]
+				Expect(anchors).To(HaveKey(ref), "unresolved reference in '%s'", name)
+			}
+			Expect(entries).To(HaveKey(path.Join("OEBPS", target)), "unresolved reference in '%s'", name)
+		}
+	}
+	Expect(bytes.Contains([]byte(readEntry(publication, "OEBPS/nav.xhtml")), []byte(`epub:type="toc"`))).To(BeTrue())
+}
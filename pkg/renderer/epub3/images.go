@@ -0,0 +1,78 @@
+package epub3
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// imageLocations returns the (resolved) location of all block and inline images
+// in the given elements, without duplicates and in the order in which they appear
+func imageLocations(elements []interface{}) []string {
+	c := &imageCollector{
+		locations: []string{},
+		visited:   map[string]bool{},
+	}
+	c.visit(elements)
+	return c.locations
+}
+
+type imageCollector struct {
+	locations []string
+	visited   map[string]bool
+}
+
+func (c *imageCollector) add(location types.Location) {
+	l := location.String()
+	if l == "" || c.visited[l] {
+		return
+	}
+	c.visited[l] = true
+	c.locations = append(c.locations, l)
+}
+
+func (c *imageCollector) visit(element interface{}) {
+	switch e := element.(type) {
+	case []interface{}:
+		for _, elmt := range e {
+			c.visit(elmt)
+		}
+	case [][]interface{}:
+		for _, elmts := range e {
+			c.visit(elmts)
+		}
+	case types.ImageBlock:
+		c.add(e.Location)
+	case types.InlineImage:
+		c.add(e.Location)
+	case types.Section:
+		c.visit(e.Title)
+		c.visit(e.Elements)
+	case types.Preamble:
+		c.visit(e.Elements)
+	case types.Paragraph:
+		c.visit(e.Lines)
+	case types.DelimitedBlock:
+		c.visit(e.Elements)
+	case types.QuotedText:
+		c.visit(e.Elements)
+	case types.OrderedList:
+		for _, item := range e.Items {
+			c.visit(item.Elements)
+		}
+	case types.UnorderedList:
+		for _, item := range e.Items {
+			c.visit(item.Elements)
+		}
+	case types.LabeledList:
+		for _, item := range e.Items {
+			c.visit(item.Term)
+			c.visit(item.Elements)
+		}
+	case types.Table:
+		c.visit(e.Header.Cells)
+		for _, line := range e.Lines {
+			c.visit(line.Cells)
+		}
+	case types.ContinuedListItemElement:
+		c.visit(e.Element)
+	}
+}
@@ -0,0 +1,120 @@
+package epub3
+
+import (
+	"html"
+	texttemplate "text/template"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var containerTmpl texttemplate.Template
+var packageTmpl texttemplate.Template
+var navTmpl texttemplate.Template
+var navSectionsTmpl texttemplate.Template
+var titlePageTmpl texttemplate.Template
+var contentDocumentTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	containerTmpl = newTextTemplate("container", `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+<rootfiles>
+<rootfile full-path="{{ .PackagePath }}" media-type="application/oebps-package+xml"/>
+</rootfiles>
+</container>
+`)
+
+	packageTmpl = newTextTemplate("package", `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="pub-id" xml:lang="{{ escape .Lang }}">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:identifier id="pub-id">{{ escape .Identifier }}</dc:identifier>
+<dc:title>{{ escape .Title }}</dc:title>
+<dc:language>{{ escape .Lang }}</dc:language>{{ range .Authors }}
+<dc:creator>{{ escape . }}</dc:creator>{{ end }}{{ if .RevNumber }}
+<meta name="revnumber" content="{{ escape .RevNumber }}"/>{{ end }}{{ if .RevDate }}
+<meta name="revdate" content="{{ escape .RevDate }}"/>{{ end }}{{ if .RevRemark }}
+<meta name="revremark" content="{{ escape .RevRemark }}"/>{{ end }}
+<meta property="dcterms:modified">{{ .Modified }}</meta>
+</metadata>
+<manifest>{{ range .Items }}
+<item id="{{ .ID }}" href="{{ escape .Href }}" media-type="{{ .MediaType }}"{{ if .Properties }} properties="{{ .Properties }}"{{ end }}/>{{ end }}
+</manifest>
+<spine>{{ range .Spine }}
+<itemref idref="{{ . }}"/>{{ end }}
+</spine>
+</package>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+
+	navTmpl = newTextTemplate("nav", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" lang="{{ escape .Lang }}" xml:lang="{{ escape .Lang }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+</head>
+<body>
+<nav epub:type="toc" id="toc">
+<h1>Table of Contents</h1>
+{{ .Sections }}
+</nav>
+</body>
+</html>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+
+	navSectionsTmpl = newTextTemplate("nav sections", `<ol>{{ range . }}
+<li><a href="{{ escape .Href }}">{{ escape .Title }}</a>{{ if .Children }}
+{{ .Children }}{{ end }}</li>{{ end }}
+</ol>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+
+	titlePageTmpl = newTextTemplate("title page", `<div id="header">
+<h1>{{ escape .Title }}</h1>{{ if .Authors }}
+<div class="details">{{ range .Authors }}
+<span class="author">{{ escape . }}</span><br/>{{ end }}{{ if .RevNumber }}
+<span id="revnumber">version {{ escape .RevNumber }}{{ if .RevDate }},{{ end }}</span>{{ end }}{{ if .RevDate }}
+<span id="revdate">{{ escape .RevDate }}</span>{{ end }}{{ if .RevRemark }}
+<br/><span id="revremark">{{ escape .RevRemark }}</span>{{ end }}
+</div>{{ end }}
+</div>`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+
+	contentDocumentTmpl = newTextTemplate("content document", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" lang="{{ escape .Lang }}" xml:lang="{{ escape .Lang }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+</head>
+<body class="{{ .Doctype }}">
+<section epub:type="{{ .Type }}">
+{{ .Content }}
+</section>
+</body>
+</html>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	t, err := t.Parse(src)
+	if err != nil {
+		log.Fatalf("failed to initialize '%s' template: %s", name, err.Error())
+	}
+	return *t
+}
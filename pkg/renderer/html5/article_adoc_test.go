@@ -3,6 +3,7 @@ package html5_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"os"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
@@ -30,4 +31,18 @@ var _ = Describe("article.adoc", func() {
 		_, err = html5.Render(ctx, doc, buff)
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("should not render with cancelled context", func() {
+		f, err := os.Open("article.adoc")
+		Expect(err).ToNot(HaveOccurred())
+		config := configuration.NewConfiguration()
+		doc, err := parser.ParseDocument(bufio.NewReader(f), config)
+		Expect(err).ToNot(HaveOccurred())
+		ctx := renderer.NewContext(doc, config)
+		c, cancel := context.WithCancel(context.Background())
+		cancel()
+		ctx.Context = c
+		_, err = html5.Render(ctx, doc, bytes.NewBuffer(nil))
+		Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+	})
 })
@@ -2,7 +2,9 @@ package html5
 
 import (
 	"bytes"
+	"path"
 	"path/filepath"
+	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -23,6 +25,28 @@ func init() {
 func renderInternalCrossReference(ctx renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
 	log.Debugf("rendering cross reference with ID: %s", xref.ID)
 	result := bytes.NewBuffer(nil)
+	// a reference to another document, eg: `<<other.adoc#section,label>>`
+	if p, fragment, ok := xref.DocumentReference(); ok {
+		href := strings.TrimSuffix(p, path.Ext(p)) + ".html"
+		if fragment != "" {
+			href = href + "#" + fragment
+		}
+		label := xref.Label
+		if label == "" {
+			label = href
+		}
+		err := externalCrossReferenceTmpl.Execute(result, struct {
+			Href  string
+			Label string
+		}{
+			Href:  href,
+			Label: label,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render internal cross reference")
+		}
+		return result.Bytes(), nil
+	}
 	var label string
 	if xref.Label != "" {
 		label = xref.Label
@@ -74,7 +98,12 @@ func renderExternalCrossReference(ctx renderer.Context, xref types.ExternalCross
 
 func getCrossReferenceLocation(xref types.ExternalCrossReference) string {
 	loc := xref.Location.String()
-	ext := filepath.Ext(xref.Location.String())
+	// retain the fragment, if any (eg: `other.adoc#section`)
+	fragment := ""
+	if i := strings.Index(loc, "#"); i != -1 {
+		loc, fragment = loc[:i], loc[i:]
+	}
+	ext := filepath.Ext(loc)
 	log.Debugf("ext of '%s': '%s'", loc, ext)
-	return loc[:len(loc)-len(ext)] + ".html"
+	return loc[:len(loc)-len(ext)] + ".html" + fragment
 }
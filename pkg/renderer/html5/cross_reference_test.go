@@ -77,6 +77,30 @@ with some content linked to <<thewrongtitle>>!`
 some content linked to xref:{foo}.adoc[another_doc()]!`
 			expected := `<div class="paragraph">
 <p>some content linked to <a href="foo-doc.html">another_doc()</a>!</p>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external cross reference to section in other doc", func() {
+			source := `some content linked to xref:guides/another-doc.adoc#install[another doc]!`
+			expected := `<div class="paragraph">
+<p>some content linked to <a href="guides/another-doc.html#install">another doc</a>!</p>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to other doc with shorthand syntax", func() {
+			source := `see <<another-doc.adoc#,another doc>> and <<another-doc.adoc#install>>`
+			expected := `<div class="paragraph">
+<p>see <a href="another-doc.html">another doc</a> and <a href="another-doc.html#install">another-doc.html#install</a></p>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to other docs with all extensions and with or without fragment", func() {
+			source := `see <<a.adoc>>, <<b.asciidoc#install>>, <<c.asc,C>>, <<d.ad#,D>> and <<e.txt>>`
+			expected := `<div class="paragraph">
+<p>see <a href="a.html">a.html</a>, <a href="b.html#install">b.html#install</a>, <a href="c.html">C</a>, <a href="d.html">D</a> and <a href="#e.txt">[e.txt]</a></p>
 </div>`
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
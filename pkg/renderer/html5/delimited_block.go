@@ -307,9 +307,9 @@ func renderExampleBlock(ctx renderer.Context, b types.DelimitedBlock) ([]byte, e
 				Elements  []interface{}
 			}{
 				ID:        renderElementID(b.Attributes),
-				Class:     renderClass(k),
+				Class:     renderClass(ctx, k),
 				IconClass: renderIconClass(ctx, k),
-				IconTitle: renderIconTitle(k),
+				IconTitle: renderIconTitle(ctx, k),
 				Title:     renderElementTitle(b.Attributes),
 				Elements:  discardTrailingBlankLines(b.Elements),
 			},
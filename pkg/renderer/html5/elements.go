@@ -11,6 +11,17 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// RenderElements renders the given elements in HTML, without the HEAD and BODY containers.
+// This allows for reusing the HTML5 element renderers in other backends.
+func RenderElements(ctx renderer.Context, elements []interface{}) ([]byte, error) {
+	return renderElements(ctx, elements)
+}
+
+// RenderPlainText renders the given element as plain text (eg: for a title in a document metadata)
+func RenderPlainText(ctx renderer.Context, element interface{}) ([]byte, error) {
+	return renderPlainText(ctx, element)
+}
+
 func renderElements(ctx renderer.Context, elements []interface{}) ([]byte, error) {
 	log.Debugf("rendering %d elements(s)...", len(elements))
 	buff := bytes.NewBuffer(nil)
@@ -77,6 +88,9 @@ func renderListElements(ctx renderer.Context, elements []interface{}) ([]byte, e
 // nolint: gocyclo
 func renderElement(ctx renderer.Context, element interface{}) ([]byte, error) {
 	// log.Debugf("rendering element of type `%T`", element)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	switch e := element.(type) {
 	case []interface{}:
 		return renderElements(ctx, e)
@@ -180,7 +194,7 @@ func includeNewline(ctx renderer.Context, index int, content interface{}) string
 			return "\n"
 		}
 	default:
-		log.Warnf("content of type '%T' is not an array or a slice", content)
+		ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "content of type '%T' is not an array or a slice", content)
 	}
 	return ""
 }
@@ -0,0 +1,154 @@
+package html5_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("extensions", func() {
+
+	Context("preprocessors", func() {
+
+		It("rewrite the source lines in the order of registration", func() {
+			source := `hello, world`
+			expected := `<div class="paragraph">
+<p>hello, gophers!</p>
+</div>`
+			Expect(RenderHTML(source,
+				configuration.WithPreprocessor(func(lines []string) ([]string, error) {
+					for i, l := range lines {
+						lines[i] = strings.Replace(l, "world", "gophers", -1)
+					}
+					return lines, nil
+				}),
+				configuration.WithPreprocessor(func(lines []string) ([]string, error) {
+					for i, l := range lines {
+						lines[i] = l + "!"
+					}
+					return lines, nil
+				}),
+			)).To(Equal(expected))
+		})
+
+		It("rewrite long source lines", func() {
+			line := strings.Repeat("a", 100000)
+			expected := `<div class="paragraph">
+<p>` + line + `!</p>
+</div>`
+			Expect(RenderHTML(line,
+				configuration.WithPreprocessor(func(lines []string) ([]string, error) {
+					for i, l := range lines {
+						lines[i] = l + "!"
+					}
+					return lines, nil
+				}),
+			)).To(Equal(expected))
+		})
+
+		It("receive the source lines without the final newline", func() {
+			received := []string{}
+			_, err := RenderHTML("first line\r\n\nsecond line\n",
+				configuration.WithPreprocessor(func(lines []string) ([]string, error) {
+					received = append(received, lines...)
+					return lines, nil
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(received).To(Equal([]string{"first line", "", "second line"}))
+		})
+
+		It("fail to preprocess", func() {
+			source := `hello, world`
+			_, err := RenderHTML(source,
+				configuration.WithPreprocessor(func(lines []string) ([]string, error) {
+					return nil, fmt.Errorf("mock error")
+				}),
+			)
+			Expect(err).To(MatchError("unable to preprocess the document: mock error"))
+		})
+	})
+
+	Context("tree processors", func() {
+
+		It("insert a generated section", func() {
+			source := `== Section A
+
+content`
+			expected := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_generated">Generated</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(RenderHTML(source,
+				configuration.WithTreeProcessor(func(doc *types.Document) error {
+					doc.Elements = append(doc.Elements, types.Section{
+						Level: 1,
+						Attributes: types.ElementAttributes{
+							types.AttrID: "_generated",
+						},
+						Title: []interface{}{
+							types.StringElement{Content: "Generated"},
+						},
+						Elements: []interface{}{},
+					})
+					return nil
+				}),
+			)).To(Equal(expected))
+		})
+
+		It("fail to process the tree", func() {
+			source := `content`
+			_, err := RenderHTML(source,
+				configuration.WithTreeProcessor(func(doc *types.Document) error {
+					return fmt.Errorf("mock error")
+				}),
+			)
+			Expect(err).To(MatchError("unable to process the document: mock error"))
+		})
+	})
+
+	Context("postprocessors", func() {
+
+		It("rewrite the output in the order of registration", func() {
+			source := `content`
+			expected := `<div class="paragraph">
+<p>CONTENT</p>
+</div>
+<!-- generated -->`
+			Expect(RenderHTML(source,
+				configuration.WithPostprocessor(func(output []byte) ([]byte, error) {
+					return bytes.Replace(output, []byte("content"), []byte("CONTENT"), -1), nil
+				}),
+				configuration.WithPostprocessor(func(output []byte) ([]byte, error) {
+					return append(output, []byte("\n<!-- generated -->")...), nil
+				}),
+			)).To(Equal(expected))
+		})
+
+		It("fail to postprocess", func() {
+			source := `content`
+			_, err := RenderHTML(source,
+				configuration.WithPostprocessor(func(output []byte) ([]byte, error) {
+					return nil, fmt.Errorf("mock error")
+				}),
+			)
+			Expect(err).To(MatchError("unable to postprocess the document: mock error"))
+		})
+	})
+})
@@ -117,6 +117,11 @@ func renderFootnoteReferencePlainText(note types.FootnoteReference) ([]byte, err
 	return result.Bytes(), nil
 }
 
+// RenderFootnotes renders the given footnotes in HTML (eg: at the end of a document or a chapter)
+func RenderFootnotes(ctx renderer.Context, notes []types.Footnote) ([]byte, error) {
+	return renderFootnotes(ctx, notes)
+}
+
 func renderFootnotes(ctx renderer.Context, notes []types.Footnote) ([]byte, error) {
 	// skip if there's no foot note in the doc
 	if len(notes) == 0 {
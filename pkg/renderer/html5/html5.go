@@ -79,9 +79,10 @@ func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.M
 		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
 	}
 
+	result := bytes.NewBuffer(nil)
 	if ctx.Config.IncludeHeaderFooter {
 		log.Debugf("Rendering full document...")
-		err = articleTmpl.Execute(output, struct {
+		err = articleTmpl.Execute(result, struct {
 			Generator     string
 			Doctype       string
 			Title         string
@@ -110,10 +111,10 @@ func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.M
 			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
 		}
 	} else {
-		_, err = output.Write(renderedContent)
-		if err != nil {
-			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
-		}
+		result.Write(renderedContent)
+	}
+	if _, err = output.Write(result.Bytes()); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
 	}
 	// generate the metadata to be returned to the caller
 	metadata := types.Metadata{
@@ -22,6 +22,11 @@ func verbatim() renderLinesOption {
 	}
 }
 
+// RenderInlineElements renders the given inline elements in HTML (eg: a section title)
+func RenderInlineElements(ctx renderer.Context, elements []interface{}) ([]byte, error) {
+	return renderInlineElements(ctx, elements)
+}
+
 func renderInlineElements(ctx renderer.Context, elements []interface{}, options ...renderLinesOption) ([]byte, error) {
 	if len(elements) == 0 {
 		return []byte{}, nil
@@ -0,0 +1,283 @@
+package html5_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("macro processors", func() {
+
+	// jira:PROJ-123[] -> link to the issue
+	jira := configuration.WithInlineMacroProcessor("jira", configuration.InlineMacroProcessorFunc(
+		func(target string, attributes types.ElementAttributes) ([]interface{}, error) {
+			return []interface{}{
+				types.InlineLink{
+					Location: types.Location{
+						Elements: []interface{}{
+							types.StringElement{Content: "https://jira.example.com/browse/" + target},
+						},
+					},
+					Attributes: types.ElementAttributes{
+						types.AttrInlineLinkText: []interface{}{
+							types.StringElement{Content: target},
+						},
+					},
+				},
+			}, nil
+		}))
+
+	// gh:issue[123] -> link to the issue, with an optional `repo` attribute
+	gh := configuration.WithInlineMacroProcessor("gh", configuration.InlineMacroProcessorFunc(
+		func(target string, attributes types.ElementAttributes) ([]interface{}, error) {
+			if target != "issue" {
+				return nil, fmt.Errorf("unsupported target: '%s'", target)
+			}
+			for k, v := range attributes {
+				if v == nil { // the issue number is a positional attribute
+					repo := attributes.GetAsStringWithDefault("repo", "bytesparadise/libasciidoc")
+					return []interface{}{
+						types.InlineLink{
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: "https://github.com/" + repo + "/issues/" + k},
+								},
+							},
+							Attributes: types.ElementAttributes{
+								types.AttrInlineLinkText: []interface{}{
+									types.StringElement{Content: "#" + k},
+								},
+							},
+						},
+					}, nil
+				}
+			}
+			return nil, fmt.Errorf("missing issue number")
+		}))
+
+	Context("inline macro processors", func() {
+
+		It("inline macros in a paragraph", func() {
+			source := `see jira:PROJ-123[] and gh:issue[123] or gh:issue[42,repo=foo/bar].`
+			expected := `<div class="paragraph">
+<p>see <a href="https://jira.example.com/browse/PROJ-123">PROJ-123</a> and <a href="https://github.com/bytesparadise/libasciidoc/issues/123">#123</a> or <a href="https://github.com/foo/bar/issues/42">#42</a>.</p>
+</div>`
+			Expect(RenderHTML(source, jira, gh)).To(Equal(expected))
+		})
+
+		It("inline macro in a list item", func() {
+			source := `* fixes gh:issue[1]`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>fixes <a href="https://github.com/bytesparadise/libasciidoc/issues/1">#1</a></p>
+</li>
+</ul>
+</div>`
+			Expect(RenderHTML(source, jira, gh)).To(Equal(expected))
+		})
+
+		It("fail to process inline macro", func() {
+			source := `see gh:pull[1]`
+			expected := `<div class="paragraph">
+<p>see gh:pull[1]</p>
+</div>`
+			diagnostics := []types.Diagnostic{}
+			Expect(RenderHTML(source, gh, configuration.WithDiagnosticHandler(func(d types.Diagnostic) {
+				diagnostics = append(diagnostics, d)
+			}))).To(Equal(expected))
+			Expect(diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.DiagnosticError,
+					Category: types.ParseCategory,
+					Message:  "unable to process the 'gh' macro: unsupported target: 'pull'",
+				},
+			}))
+		})
+
+		It("inline macro with document attributes in target and attributes", func() {
+			source := `:project: PROJ
+:text: the issue
+
+see jira:{project}-1[title={text}]`
+			expected := `<div class="paragraph">
+<p>see <a href="https://jira.example.com/browse/PROJ-1">PROJ-1</a> (the issue)</p>
+</div>`
+			Expect(RenderHTML(source, configuration.WithInlineMacroProcessor("jira", configuration.InlineMacroProcessorFunc(
+				func(target string, attributes types.ElementAttributes) ([]interface{}, error) {
+					return []interface{}{
+						types.InlineLink{
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: "https://jira.example.com/browse/" + target},
+								},
+							},
+							Attributes: types.ElementAttributes{
+								types.AttrInlineLinkText: []interface{}{
+									types.StringElement{Content: target},
+								},
+							},
+						},
+						types.StringElement{Content: " (" + attributes.GetAsString("title") + ")"},
+					}, nil
+				})))).To(Equal(expected))
+		})
+
+		It("inline macro without processor", func() {
+			source := `see gh:issue[1]`
+			expected := `<div class="paragraph">
+<p>see gh:issue[1]</p>
+</div>`
+			Expect(RenderHTML(source, jira)).To(Equal(expected))
+		})
+	})
+
+	Context("block macro processors", func() {
+
+		It("block macro returning blocks", func() {
+			source := `toc-of::chapters[depth=2]`
+			expected := `<div class="paragraph">
+<p>chapters (depth: 2, style: none)</p>
+</div>`
+			Expect(RenderHTML(source, configuration.WithBlockMacroProcessor("toc-of", configuration.BlockMacroProcessorFunc(
+				func(target string, attributes types.ElementAttributes) ([]interface{}, error) {
+					return []interface{}{
+						types.Paragraph{
+							Attributes: types.ElementAttributes{},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: fmt.Sprintf("%s (depth: %s, style: %s)", target, attributes.GetAsStringWithDefault("depth", "1"), attributes.GetAsStringWithDefault("style", "none")),
+									},
+								},
+							},
+						},
+					}, nil
+				})))).To(Equal(expected))
+		})
+	})
+
+	Context("block processors", func() {
+
+		chart := configuration.WithBlockProcessor("chart", configuration.BlockProcessorFunc(
+			func(attributes types.ElementAttributes, lines []string) ([]interface{}, error) {
+				items := make([]types.UnorderedListItem, len(lines))
+				for i, l := range lines {
+					items[i] = types.UnorderedListItem{
+						Level:       1,
+						BulletStyle: types.OneAsterisk,
+						CheckStyle:  types.NoCheck,
+						Attributes:  types.ElementAttributes{},
+						Elements: []interface{}{
+							types.Paragraph{
+								Attributes: types.ElementAttributes{},
+								Lines: [][]interface{}{
+									{
+										types.StringElement{Content: l},
+									},
+								},
+							},
+						},
+					}
+				}
+				return []interface{}{
+					types.UnorderedList{
+						Attributes: types.ElementAttributes{
+							types.AttrTitle: attributes.GetAsStringWithDefault("caption", "Chart"),
+						},
+						Items: items,
+					},
+				}, nil
+			}))
+
+		It("literal block with processor", func() {
+			source := `[chart,caption=Sales]
+....
+a,1
+b,2
+....`
+			expected := `<div class="ulist">
+<div class="title">Sales</div>
+<ul>
+<li>
+<p>a,1</p>
+</li>
+<li>
+<p>b,2</p>
+</li>
+</ul>
+</div>`
+			Expect(RenderHTML(source, chart)).To(Equal(expected))
+		})
+
+		It("listing block with processor", func() {
+			source := `[chart]
+----
+a,1
+----`
+			expected := `<div class="ulist">
+<div class="title">Chart</div>
+<ul>
+<li>
+<p>a,1</p>
+</li>
+</ul>
+</div>`
+			Expect(RenderHTML(source, chart)).To(Equal(expected))
+		})
+
+		It("fail to process block", func() {
+			source := `[chart]
+....
+a,1
+....`
+			expected := `<div class="literalblock">
+<div class="content">
+<pre>a,1</pre>
+</div>
+</div>`
+			diagnostics := []types.Diagnostic{}
+			Expect(RenderHTML(source, configuration.WithBlockProcessor("chart", configuration.BlockProcessorFunc(
+				func(attributes types.ElementAttributes, lines []string) ([]interface{}, error) {
+					return nil, fmt.Errorf("invalid data")
+				})), configuration.WithDiagnosticHandler(func(d types.Diagnostic) {
+				diagnostics = append(diagnostics, d)
+			}))).To(Equal(expected))
+			Expect(diagnostics).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.DiagnosticError,
+					Category: types.ParseCategory,
+					Message:  "unable to process the 'chart' block: invalid data",
+				},
+			}))
+		})
+
+		It("block processor selected by style", func() {
+			source := `[chart,stacked,horizontal]
+....
+a,1
+....`
+			expected := `<div class="ulist">
+<div class="title">Chart</div>
+<ul>
+<li>
+<p>a,1</p>
+</li>
+</ul>
+</div>`
+			failing := configuration.BlockProcessorFunc(
+				func(attributes types.ElementAttributes, lines []string) ([]interface{}, error) {
+					return nil, fmt.Errorf("unexpected processor")
+				})
+			// the processors registered for the other positional attributes must be ignored
+			Expect(RenderHTML(source, chart,
+				configuration.WithBlockProcessor("stacked", failing),
+				configuration.WithBlockProcessor("horizontal", failing))).To(Equal(expected))
+		})
+	})
+})
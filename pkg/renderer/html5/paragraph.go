@@ -170,8 +170,8 @@ func renderAdmonitionParagraph(ctx renderer.Context, p types.Paragraph) ([]byte,
 		}{
 			ID:        renderElementID(p.Attributes),
 			Title:     renderElementTitle(p.Attributes),
-			Class:     renderClass(k),
-			IconTitle: renderIconTitle(k),
+			Class:     renderClass(ctx, k),
+			IconTitle: renderIconTitle(ctx, k),
 			IconClass: renderIconClass(ctx, k),
 			Lines:     p.Lines,
 		},
@@ -286,12 +286,12 @@ func renderCheckStyle(style interface{}) string {
 
 func renderIconClass(ctx renderer.Context, kind types.AdmonitionKind) string {
 	if icons, _ := ctx.Attributes.GetAsString("icons"); icons == "font" {
-		return renderClass(kind)
+		return renderClass(ctx, kind)
 	}
 	return ""
 }
 
-func renderClass(kind types.AdmonitionKind) string {
+func renderClass(ctx renderer.Context, kind types.AdmonitionKind) string {
 	switch kind {
 	case types.Tip:
 		return "tip"
@@ -304,12 +304,12 @@ func renderClass(kind types.AdmonitionKind) string {
 	case types.Caution:
 		return "caution"
 	default:
-		log.Errorf("unexpected kind of admonition: %v", kind)
+		ctx.Config.Report(types.DiagnosticError, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "unexpected kind of admonition: %v", kind)
 		return ""
 	}
 }
 
-func renderIconTitle(kind types.AdmonitionKind) string {
+func renderIconTitle(ctx renderer.Context, kind types.AdmonitionKind) string {
 	switch kind {
 	case types.Tip:
 		return "Tip"
@@ -322,7 +322,7 @@ func renderIconTitle(kind types.AdmonitionKind) string {
 	case types.Caution:
 		return "Caution"
 	default:
-		log.Errorf("unexpected kind of admonition: %v", kind)
+		ctx.Config.Report(types.DiagnosticError, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "unexpected kind of admonition: %v", kind)
 		return ""
 	}
 }
@@ -0,0 +1,237 @@
+package latex
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderParagraph(ctx renderer.Context, p types.Paragraph) ([]byte, error) {
+	log.Debugf("rendering paragraph with %d line(s)", len(p.Lines))
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		return renderAdmonition(p.Attributes, k, content), nil
+	}
+	switch {
+	case p.Attributes[types.AttrKind] == types.Source:
+		return renderSource(ctx, p.Attributes, plainText(p.Lines)), nil
+	case p.Attributes[types.AttrKind] == types.Verse:
+		return renderVerse(p.Attributes, plainText(p.Lines)), nil
+	case p.Attributes[types.AttrKind] == types.Quote:
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		return renderQuote(p.Attributes, content), nil
+	case p.Attributes.Has("stem") || p.Attributes.Has("latexmath"):
+		return renderStemBlock(p), nil
+	}
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	return []byte(renderBlockPrefix(p.Attributes) + string(content)), nil
+}
+
+// renderStemBlock renders the content of a `[stem]` paragraph in a display math environment.
+// Since the grammar does not support passthrough blocks yet, the content of a `++++` block is
+// parsed as a triple-plus passthrough and the remaining `+` delimiters need to be removed.
+func renderStemBlock(p types.Paragraph) []byte {
+	content := strings.Trim(plainText(p.Lines), "+\n")
+	return []byte(renderAnchor(p.Attributes) + `\[` + "\n" + content + "\n" + `\]`)
+}
+
+// admonitionLabels the labels of the admonitions, indexed by kind
+var admonitionLabels = map[types.AdmonitionKind]string{
+	types.Tip:       "Tip",
+	types.Note:      "Note",
+	types.Important: "Important",
+	types.Warning:   "Warning",
+	types.Caution:   "Caution",
+}
+
+func renderAdmonition(attrs types.ElementAttributes, kind types.AdmonitionKind, content []byte) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(attrs))
+	result.WriteString(`\begin{quote}` + "\n")
+	result.WriteString(`\textbf{` + admonitionLabels[kind] + `:} `)
+	result.Write(content)
+	result.WriteString("\n" + `\end{quote}`)
+	return result.Bytes()
+}
+
+// renderAttribution returns the author and title of a quote or a verse, or an empty string
+func renderAttribution(attrs types.ElementAttributes) string {
+	author := attrs.GetAsString(types.AttrQuoteAuthor)
+	title := attrs.GetAsString(types.AttrQuoteTitle)
+	if author == "" && title == "" {
+		return ""
+	}
+	attribution := []string{}
+	if author != "" {
+		attribution = append(attribution, EscapeString(author))
+	}
+	if title != "" {
+		attribution = append(attribution, `\emph{`+EscapeString(title)+`}`)
+	}
+	return "\n" + `\par\hfill--- ` + strings.Join(attribution, ", ")
+}
+
+func renderQuote(attrs types.ElementAttributes, content []byte) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(attrs))
+	result.WriteString(`\begin{quote}` + "\n")
+	result.Write(content)
+	result.WriteString(renderAttribution(attrs))
+	result.WriteString("\n" + `\end{quote}`)
+	return result.Bytes()
+}
+
+func renderVerse(attrs types.ElementAttributes, content string) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(attrs))
+	result.WriteString(`\begin{verse}` + "\n")
+	// each line of the verse ends with a line break, and stanzas are separated by a blank line
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		result.WriteString(EscapeString(line))
+		if i < len(lines)-1 && line != "" && lines[i+1] != "" {
+			result.WriteString(`\\`)
+		}
+		if i < len(lines)-1 {
+			result.WriteString("\n")
+		}
+	}
+	result.WriteString(renderAttribution(attrs))
+	result.WriteString("\n" + `\end{verse}`)
+	return result.Bytes()
+}
+
+func renderDelimitedBlock(ctx renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	switch b.Kind {
+	case types.Fenced, types.Listing:
+		return renderVerbatim(b.Attributes, verbatimContent(b.Elements)), nil
+	case types.Source:
+		return renderSource(ctx, b.Attributes, verbatimContent(b.Elements)), nil
+	case types.Example:
+		content, err := renderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render delimited block")
+		}
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			return renderAdmonition(b.Attributes, k, content), nil
+		}
+		attrs := b.Attributes
+		if attrs.Has(types.AttrTitle) {
+			attrs = types.ElementAttributes{}
+			attrs.AddAll(b.Attributes)
+			attrs[types.AttrTitle] = "Example " + strconv.Itoa(ctx.GetAndIncrementExampleBlockCounter()) + ". " + b.Attributes.GetAsString(types.AttrTitle)
+		}
+		return renderQuote(attrs, content), nil
+	case types.Quote, types.Sidebar:
+		content, err := renderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render delimited block")
+		}
+		return renderQuote(b.Attributes, content), nil
+	case types.Verse:
+		return renderVerse(b.Attributes, verbatimContent(b.Elements)), nil
+	case types.Comment:
+		return nil, nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+func renderVerbatim(attrs types.ElementAttributes, content string) []byte {
+	return []byte(renderBlockPrefix(attrs) + `\begin{verbatim}` + "\n" + content + "\n" + `\end{verbatim}`)
+}
+
+// listingsLanguages the languages supported by the `listings` package, indexed by their
+// (lowercase) name in Asciidoc
+var listingsLanguages = map[string]string{
+	"bash":       "bash",
+	"c":          "C",
+	"c++":        "C++",
+	"cpp":        "C++",
+	"csharp":     "[Sharp]C",
+	"html":       "HTML",
+	"java":       "Java",
+	"javascript": "Java", // closest match
+	"latex":      "[LaTeX]TeX",
+	"lua":        "Lua",
+	"make":       "make",
+	"perl":       "Perl",
+	"php":        "PHP",
+	"python":     "Python",
+	"ruby":       "Ruby",
+	"sh":         "sh",
+	"sql":        "SQL",
+	"tex":        "TeX",
+	"xml":        "XML",
+}
+
+// renderSource renders the given source code in a `listings` environment, or in a `minted` environment
+// if the `source-highlighter` document attribute is `minted`
+func renderSource(ctx renderer.Context, attrs types.ElementAttributes, content string) []byte {
+	language := strings.ToLower(attrs.GetAsString(types.AttrLanguage))
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(attrs))
+	if highlighter, _ := ctx.Attributes.GetAsString(types.AttrSyntaxHighlighter); highlighter == "minted" && language != "" {
+		result.WriteString(`\begin{minted}`)
+		if attrs.Has(types.AttrLineNums) {
+			result.WriteString(`[linenos]`)
+		}
+		result.WriteString(`{` + language + `}` + "\n")
+		result.WriteString(content)
+		result.WriteString("\n" + `\end{minted}`)
+		return result.Bytes()
+	}
+	options := []string{}
+	if l, found := listingsLanguages[language]; found {
+		options = append(options, "language="+l)
+	} else if language != "" {
+		ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "language '%s' is not supported by the 'listings' package", language)
+	}
+	if attrs.Has(types.AttrLineNums) {
+		options = append(options, "numbers=left")
+	}
+	result.WriteString(`\begin{lstlisting}`)
+	if len(options) > 0 {
+		result.WriteString("[" + strings.Join(options, ",") + "]")
+	}
+	result.WriteString("\n")
+	result.WriteString(content)
+	result.WriteString("\n" + `\end{lstlisting}`)
+	return result.Bytes()
+}
+
+func renderLiteralBlock(b types.LiteralBlock) ([]byte, error) {
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		// remove as many spaces as needed on each line
+		spaceCount := math.MaxInt32
+		for _, line := range b.Lines {
+			if c := len(line) - len(strings.TrimLeft(line, " ")); c < spaceCount {
+				spaceCount = c
+			}
+		}
+		spaces := strings.Repeat(" ", spaceCount)
+		lines = make([]string, len(b.Lines))
+		for i, line := range b.Lines {
+			lines[i] = strings.TrimPrefix(line, spaces)
+		}
+	}
+	return renderVerbatim(b.Attributes, strings.Join(lines, "\n")), nil
+}
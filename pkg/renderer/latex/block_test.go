@@ -0,0 +1,163 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex blocks", func() {
+
+	Context("paragraphs", func() {
+
+		It("paragraph with title, id and line break", func() {
+			source := `[#para]
+.A title
+first line +
+second line`
+			expected := `\phantomsection\label{para}
+\noindent\textbf{A title}\par
+first line\\
+second line`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("admonition paragraph", func() {
+			source := `WARNING: mind the $ sign`
+			expected := `\begin{quote}
+\textbf{Warning:} mind the \$ sign
+\end{quote}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("stem paragraph", func() {
+			source := `[stem]
+++++
+x = \frac{1}{2}
+++++`
+			expected := `\[
+x = \frac{1}{2}
+\]`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+	})
+
+	Context("source blocks", func() {
+
+		It("source block with listings", func() {
+			source := `.Example
+[source,python,linenums]
+----
+print("$1 & {2}")
+
+print("done")
+----`
+			expected := `\noindent\textbf{Example}\par
+\begin{lstlisting}[language=Python,numbers=left]
+print("$1 & {2}")
+
+print("done")
+\end{lstlisting}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("source block with an unsupported language", func() {
+			source := `[source,go]
+----
+func main() {}
+----`
+			expected := `\begin{lstlisting}
+func main() {}
+\end{lstlisting}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("source block with minted", func() {
+			source := `:source-highlighter: minted
+
+[source,go]
+----
+func main() {}
+----`
+			expected := `\begin{minted}{go}
+func main() {}
+\end{minted}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("listing and literal blocks", func() {
+			source := `----
+a_b {c}
+----
+
+....
+$ ls
+....`
+			expected := `\begin{verbatim}
+a_b {c}
+\end{verbatim}
+
+\begin{verbatim}
+$ ls
+\end{verbatim}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+	})
+
+	Context("other delimited blocks", func() {
+
+		It("quote block", func() {
+			source := `[quote, Albert Einstein, Relativity]
+____
+Imagination is more important than knowledge.
+____`
+			expected := `\begin{quote}
+Imagination is more important than knowledge.
+\par\hfill--- Albert Einstein, \emph{Relativity}
+\end{quote}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("verse block", func() {
+			source := `[verse, John Doe]
+____
+first line
+second line
+
+second stanza
+____`
+			expected := `\begin{verse}
+first line\\
+second line
+
+second stanza
+\par\hfill--- John Doe
+\end{verse}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("admonition block", func() {
+			source := `[NOTE]
+====
+some *content*
+====`
+			expected := `\begin{quote}
+\textbf{Note:} some \textbf{content}
+\end{quote}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+
+		It("example block with title", func() {
+			source := `.A title
+====
+some content
+====`
+			expected := `\noindent\textbf{Example 1. A title}\par
+\begin{quote}
+some content
+\end{quote}`
+			Expect(RenderLaTeX(source)).To(Equal(expected))
+		})
+	})
+})
@@ -0,0 +1,185 @@
+package latex
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderElements renders the given blocks, separated by a blank line (ie, as distinct paragraphs in LaTeX)
+func renderElements(ctx renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	hasContent := false
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, err // no need to wrap the error here
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if hasContent {
+			buff.WriteString("\n\n")
+		}
+		buff.Write(renderedElement)
+		hasContent = true
+	}
+	return buff.Bytes(), nil
+}
+
+// renderInlineElements renders the given inline elements, without any separator
+func renderInlineElements(ctx renderer.Context, elements []interface{}) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, err // no need to wrap the error here
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderElement(ctx renderer.Context, element interface{}) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.TableOfContentsPlaceHolder:
+		return []byte(`\tableofcontents`), nil
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.BlankLine:
+		return nil, nil
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		return renderPassthrough(e)
+	case types.ImageBlock:
+		return renderImageBlock(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(e)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.StringElement:
+		return []byte(EscapeString(e.Content)), nil
+	case types.FootnoteReference:
+		return renderFootnoteReference(ctx, e)
+	case types.LineBreak:
+		return []byte(`\\`), nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e)
+	case types.IndexTerm:
+		return renderIndexTerm(ctx, e)
+	case types.ConcealedIndexTerm:
+		return renderConcealedIndexTerm(e), nil
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// plainText returns the raw content of the given element, without any escaping or formatting
+// (eg: for the content of verbatim environments)
+func plainText(element interface{}) string {
+	switch e := element.(type) {
+	case []interface{}:
+		result := strings.Builder{}
+		for _, elmt := range e {
+			result.WriteString(plainText(elmt))
+		}
+		return result.String()
+	case [][]interface{}:
+		lines := make([]string, len(e))
+		for i, line := range e {
+			lines[i] = plainText(line)
+		}
+		return strings.Join(lines, "\n")
+	case types.StringElement:
+		return e.Content
+	case types.QuotedText:
+		return plainText(e.Elements)
+	case types.Passthrough:
+		return plainText(e.Elements)
+	case types.Paragraph:
+		return plainText(e.Lines)
+	case types.InlineLink:
+		return e.Location.String()
+	case types.UserMacro:
+		return e.RawText
+	default:
+		return ""
+	}
+}
+
+// verbatimContent returns the raw content of the given elements of a delimited block,
+// with a blank line between each paragraph
+func verbatimContent(elements []interface{}) string {
+	// discard the trailing blank lines
+	for len(elements) > 0 {
+		if _, ok := elements[len(elements)-1].(types.BlankLine); !ok {
+			break
+		}
+		elements = elements[:len(elements)-1]
+	}
+	lines := make([]string, len(elements))
+	for i, e := range elements {
+		lines[i] = plainText(e)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderAnchor returns a `\label` for the element with the given attributes, if it has an ID.
+// The `\phantomsection` command makes the link target the element (instead of the enclosing section)
+func renderAnchor(attrs types.ElementAttributes) string {
+	if id := attrs.GetAsString(types.AttrID); id != "" {
+		return `\phantomsection\label{` + escapeLabel(id) + `}`
+	}
+	return ""
+}
+
+// renderBlockTitle returns the title of the block with the given attributes, followed by
+// a new paragraph, or an empty string if the block has no title
+func renderBlockTitle(attrs types.ElementAttributes) string {
+	if title := attrs.GetAsString(types.AttrTitle); title != "" {
+		return `\noindent\textbf{` + EscapeString(title) + `}\par` + "\n"
+	}
+	return ""
+}
+
+// renderBlockPrefix returns the anchor and the title of the block with the given attributes
+func renderBlockPrefix(attrs types.ElementAttributes) string {
+	result := renderAnchor(attrs)
+	if result != "" {
+		result += "\n"
+	}
+	return result + renderBlockTitle(attrs)
+}
@@ -0,0 +1,52 @@
+package latex
+
+import (
+	"strings"
+)
+
+var specialCharsReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	`|`, `\textbar{}`,
+)
+
+// EscapeString escapes the LaTeX special characters in the given string
+// (ie, `\`, `{`, `}`, `$`, `&`, `#`, `%`, `_`, `~`, `^`, along with `<`, `>` and `|` which
+// are not rendered as such in the default font encoding)
+func EscapeString(s string) string {
+	return specialCharsReplacer.Replace(s)
+}
+
+var urlReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`{`, `\{`,
+	`}`, `\}`,
+)
+
+// escapeURL escapes the characters which would break the argument of an `\href`, `\url` or `\includegraphics` command
+func escapeURL(s string) string {
+	return urlReplacer.Replace(s)
+}
+
+// escapeLabel removes the characters which are not allowed in a `\label` or `\ref` key
+func escapeLabel(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\\', '{', '}', '#', '%', '$', '&', '~', '^', ',':
+			return -1
+		}
+		return r
+	}, s)
+}
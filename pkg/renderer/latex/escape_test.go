@@ -0,0 +1,21 @@
+package latex_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/latex"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("latex special characters",
+	func(actual, expected string) {
+		Expect(latex.EscapeString(actual)).To(Equal(expected))
+	},
+	Entry("no special character", "hello, world!", "hello, world!"),
+	Entry("backslash", `a\b`, `a\textbackslash{}b`),
+	Entry("braces", `{a}`, `\{a\}`),
+	Entry("dollar, ampersand, hash, percent and underscore", `$&#%_`, `\$\&\#\%\_`),
+	Entry("tilde and caret", `~^`, `\textasciitilde{}\textasciicircum{}`),
+	Entry("angle brackets and pipe", `<|>`, `\textless{}\textbar{}\textgreater{}`),
+	Entry("backslash before brace", `\{`, `\textbackslash{}\{`),
+)
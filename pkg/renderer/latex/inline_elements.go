@@ -0,0 +1,218 @@
+package latex
+
+import (
+	"bytes"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// quotedTextCommands the LaTeX commands for each kind of quoted text
+var quotedTextCommands = map[types.QuotedTextKind]string{
+	types.Bold:        `\textbf`,
+	types.Italic:      `\emph`,
+	types.Monospace:   `\texttt`,
+	types.Subscript:   `\textsubscript`,
+	types.Superscript: `\textsuperscript`,
+}
+
+func renderQuotedText(ctx renderer.Context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render quoted text")
+	}
+	command, found := quotedTextCommands[t.Kind]
+	if !found {
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+	return []byte(command + "{" + string(content) + "}"), nil
+}
+
+func renderPassthrough(p types.Passthrough) ([]byte, error) {
+	switch p.Kind {
+	case types.SinglePlusPassthrough:
+		// only the special characters are substituted
+		return []byte(EscapeString(plainText(p.Elements))), nil
+	default:
+		return []byte(plainText(p.Elements)), nil
+	}
+}
+
+func renderInternalCrossReference(ctx renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
+	id := escapeLabel(xref.ID)
+	if xref.Label != "" {
+		return []byte(`\hyperref[` + id + `]{` + EscapeString(xref.Label) + `}`), nil
+	}
+	if target, found := ctx.ElementReferences[xref.ID]; found {
+		if t, ok := target.([]interface{}); ok {
+			label, err := renderInlineElements(ctx, t)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render internal cross reference")
+			}
+			return []byte(`\hyperref[` + id + `]{` + string(label) + `}`), nil
+		}
+	}
+	return []byte(`\ref{` + id + `}`), nil
+}
+
+func renderExternalCrossReference(ctx renderer.Context, xref types.ExternalCrossReference) ([]byte, error) {
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render external cross reference")
+	}
+	// the target document is expected to be converted in PDF, too
+	loc := xref.Location.String()
+	loc = loc[:len(loc)-len(filepath.Ext(loc))] + ".pdf"
+	if len(label) == 0 {
+		label = []byte(EscapeString(loc))
+	}
+	return []byte(`\href{` + escapeURL(loc) + `}{` + string(label) + `}`), nil
+}
+
+func renderLink(ctx renderer.Context, l types.InlineLink) ([]byte, error) {
+	location := escapeURL(l.Location.String())
+	if text, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok && len(text) > 0 {
+		content, err := renderInlineElements(ctx, text)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render link")
+		}
+		return []byte(`\href{` + location + `}{` + string(content) + `}`), nil
+	}
+	return []byte(`\url{` + location + `}`), nil
+}
+
+func renderFootnoteReference(ctx renderer.Context, note types.FootnoteReference) ([]byte, error) {
+	if note.ID == types.InvalidFootnoteReference {
+		ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "unresolved footnote reference: '%s'", note.Ref)
+		return []byte(`\textsuperscript{[` + EscapeString(note.Ref) + `]}`), nil
+	}
+	if note.Duplicate {
+		// the footnote was already written at its first occurrence
+		return []byte(`\footnotemark[` + strconv.Itoa(note.ID) + `]`), nil
+	}
+	for _, f := range ctx.Footnotes {
+		if f.ID == note.ID {
+			content, err := renderInlineElements(ctx, f.Elements)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render footnote")
+			}
+			return []byte(`\footnote{` + string(content) + `}`), nil
+		}
+	}
+	return nil, errors.Errorf("unable to render footnote: no footnote with ID '%d'", note.ID)
+}
+
+// renderImageBlock renders the given image block in a `figure` environment if it has a title,
+// or in a `center` environment otherwise
+func renderImageBlock(ctx renderer.Context, img types.ImageBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	graphics := renderGraphics(img.Location, img.Attributes, `\linewidth`)
+	title := img.Attributes.GetAsString(types.AttrImageTitle)
+	if title == "" {
+		result.WriteString(renderAnchor(img.Attributes))
+		result.WriteString(`\begin{center}` + "\n" + graphics + "\n" + `\end{center}`)
+		return result.Bytes(), nil
+	}
+	result.WriteString(`\begin{figure}[htbp]` + "\n" + `\centering` + "\n" + graphics + "\n")
+	result.WriteString(`\caption{` + EscapeString(title) + `}`)
+	if id := img.Attributes.GetAsString(types.AttrID); id != "" {
+		result.WriteString(`\label{` + escapeLabel(id) + `}`)
+	}
+	result.WriteString("\n" + `\end{figure}`)
+	return result.Bytes(), nil
+}
+
+func renderInlineImage(img types.InlineImage) ([]byte, error) {
+	return []byte(renderGraphics(img.Location, img.Attributes, `\linewidth`)), nil
+}
+
+// renderGraphics returns the `\includegraphics` command for the given image, with its optional width and height.
+// Dimensions in pixels are converted in `bp` (which is the size of a pixel with `pdflatex`) and percentages are
+// relative to the given `length` (eg: the width of the line)
+func renderGraphics(location types.Location, attrs types.ElementAttributes, length string) string {
+	options := []string{}
+	if width := renderDimension(attrs.GetAsString(types.AttrImageWidth), length); width != "" {
+		options = append(options, "width="+width)
+	}
+	if height := renderDimension(attrs.GetAsString(types.AttrImageHeight), `\textheight`); height != "" {
+		options = append(options, "height="+height)
+	}
+	if len(options) == 2 {
+		options = append(options, "keepaspectratio")
+	}
+	result := `\includegraphics`
+	if len(options) > 0 {
+		result += "[" + strings.Join(options, ",") + "]"
+	}
+	// the path is not typeset, so it is escaped as a URL (eg: `_` is kept as-is, but `%` and `#` are escaped)
+	return result + "{" + escapeURL(location.String()) + "}"
+}
+
+func renderDimension(value, length string) string {
+	if strings.HasSuffix(value, "%") {
+		if v, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64); err == nil {
+			return strconv.FormatFloat(v/100, 'f', -1, 64) + length
+		}
+		return ""
+	}
+	if v, err := strconv.ParseFloat(strings.TrimSuffix(value, "px"), 64); err == nil {
+		return strconv.FormatFloat(v, 'f', -1, 64) + "bp"
+	}
+	return ""
+}
+
+// renderUserMacro renders the STEM macros (`stem`, `latexmath`) as inline math, or the raw text of the other macros
+func renderUserMacro(ctx renderer.Context, m types.UserMacro) ([]byte, error) {
+	switch m.Name {
+	case "stem", "latexmath":
+		// the content is passed through, without any substitution
+		return []byte(`\(` + macroContent(m) + `\)`), nil
+	case "asciimath":
+		ctx.Config.Report(types.DiagnosticWarning, types.RenderCategory, types.Position{Filename: ctx.Config.Filename}, "AsciiMath is not supported by the LaTeX backend: '%s'", m.RawText)
+		return []byte(`\texttt{` + EscapeString(macroContent(m)) + `}`), nil
+	default:
+		log.Debugf("no template for user macro '%s' in the LaTeX backend", m.Name)
+		return []byte(EscapeString(m.RawText)), nil
+	}
+}
+
+// macroContent returns the raw content of the given macro, ie, the text between the brackets
+func macroContent(m types.UserMacro) string {
+	start := strings.Index(m.RawText, "[")
+	end := strings.LastIndex(m.RawText, "]")
+	if start < 0 || end < start {
+		return m.Value
+	}
+	return m.RawText[start+1 : end]
+}
+
+func renderIndexTerm(ctx renderer.Context, t types.IndexTerm) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Term)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render index term")
+	}
+	return []byte(string(content) + `\index{` + escapeIndexEntry(plainText(t.Term)) + `}`), nil
+}
+
+func renderConcealedIndexTerm(t types.ConcealedIndexTerm) []byte {
+	terms := []string{}
+	for _, term := range []interface{}{t.Term1, t.Term2, t.Term3} {
+		if term, ok := term.(string); ok && term != "" {
+			terms = append(terms, escapeIndexEntry(term))
+		}
+	}
+	return []byte(`\index{` + strings.Join(terms, "!") + `}`)
+}
+
+// escapeIndexEntry escapes the special characters of the given index entry, including
+// the ones used by `makeindex` (ie, `"`, `!`, `@` and `|`)
+func escapeIndexEntry(s string) string {
+	s = strings.NewReplacer(`"`, `""`, `!`, `"!`, `@`, `"@`).Replace(EscapeString(s))
+	return strings.Replace(s, `\textbar{}`, `"|`, -1)
+}
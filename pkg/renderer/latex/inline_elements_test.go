@@ -0,0 +1,79 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex inline elements", func() {
+
+	It("quoted text", func() {
+		source := "*bold* _italic_ `mono_space` H~2~O E=mc^2^ *_nested_*"
+		expected := `\textbf{bold} \emph{italic} \texttt{mono\_space} H\textsubscript{2}O E=mc\textsuperscript{2} \textbf{\emph{nested}}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("passthroughs", func() {
+		source := `+a_b+ and +++\LaTeX{}+++ and pass:[\emph{c}]`
+		expected := `a\_b and \LaTeX{} and \emph{c}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("stem macros", func() {
+		source := `stem:[\sqrt{x^2}] and latexmath:[a_1, a_2]`
+		expected := `\(\sqrt{x^2}\) and \(a_1, a_2\)`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("cross references", func() {
+		source := `== Section A
+
+see <<_section_a>>, <<_section_a,this section>> or <<unknown>> or xref:other.adoc[the other doc]`
+		expected := `\section{Section A}\label{_section_a}
+
+see \hyperref[_section_a]{Section A}, \hyperref[_section_a]{this section} or \ref{unknown} or \href{other.pdf}{the other doc}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("footnotes", func() {
+		source := `first footnote:[a _note_] and footnote:ref[another note] and again footnote:ref[]`
+		expected := `first \footnote{a \emph{note}} and \footnote{another note} and again \footnotemark[2]`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("links", func() {
+		source := `https://example.com/a%20b#x[the *site*] and https://example.com`
+		expected := `\href{https://example.com/a\%20b\#x}{the \textbf{site}} and \url{https://example.com}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("images", func() {
+		source := `.A figure
+[#fig]
+image::images/foo.png[Foo,100,50]
+
+an image:bar.png[Bar,50%] inline`
+		expected := `\begin{figure}[htbp]
+\centering
+\includegraphics[width=100bp,height=50bp,keepaspectratio]{images/foo.png}
+\caption{A figure}\label{fig}
+\end{figure}
+
+an \includegraphics[width=0.5\linewidth]{bar.png} inline`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("images with special characters in path", func() {
+		source := `image:my_image%1#{v}.png[]`
+		expected := `\includegraphics{my_image\%1\#\{v\}.png}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("index terms", func() {
+		source := `a ((term)) and (((hidden, sub)))`
+		expected := `a term\index{term} and \index{hidden!sub}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+})
@@ -0,0 +1,182 @@
+package latex
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	// templates use the `[[` and `]]` delimiters, since `{{` and `}}` are frequent in LaTeX
+	documentTmpl = newTextTemplate("document", `\documentclass[[ .DocumentClass ]]
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{textcomp}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{graphicx}[[ if .Lang ]]
+\usepackage{babel}[[ end ]][[ if .Minted ]]
+\usepackage{minted}[[ else ]]
+\usepackage{listings}
+\lstset{basicstyle=\ttfamily\small,breaklines=true,columns=fullflexible}[[ end ]]
+\usepackage{makeidx}
+\makeindex
+\usepackage{hyperref}[[ if .Title ]]
+\title{[[ .Title ]]}[[ end ]][[ if .Authors ]]
+\author{[[ .Authors ]]}[[ end ]]
+\date{[[ if .Date ]][[ .Date ]][[ end ]]}
+\begin{document}[[ if .Title ]]
+\maketitle[[ end ]]
+[[ .Content ]]
+\end{document}
+`)
+}
+
+func newTextTemplate(name, src string) texttemplate.Template {
+	t, err := texttemplate.New(name).Delims("[[", "]]").Parse(src)
+	if err != nil {
+		log.Fatalf("failed to initialize '%s' template: %s", name, err.Error())
+	}
+	return *t
+}
+
+// Render renders the given document in LaTeX and writes the result in the given `writer`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	renderedTitle, err := renderDocumentTitle(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	// the table of contents is generated by LaTeX, but is returned in the metadata
+	toc, err := html5.NewTableOfContents(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	elements := doc.Elements
+	if header, exists := doc.Header(); exists {
+		// the header is rendered with the `\maketitle` command
+		elements = make([]interface{}, 0, len(header.Elements)+len(doc.Elements)-1)
+		elements = append(elements, header.Elements...)
+		elements = append(elements, doc.Elements[1:]...)
+	}
+	renderedContent, err := renderElements(ctx, elements)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	if ctx.Config.IncludeHeaderFooter {
+		log.Debugf("Rendering full document...")
+		lang := babelLanguages[doc.Attributes.GetAsStringWithDefault("lang", "")]
+		renderedTitle := renderedTitle
+		if doc.Attributes.Has(types.AttrNoHeader) {
+			renderedTitle = nil
+		}
+		err = documentTmpl.Execute(output, struct {
+			DocumentClass string
+			Lang          string
+			Minted        bool
+			Title         string
+			Authors       string
+			Date          string
+			Content       string
+		}{
+			DocumentClass: documentClass(doc, lang),
+			Lang:          lang,
+			Minted:        doc.Attributes.GetAsStringWithDefault(types.AttrSyntaxHighlighter, "") == "minted",
+			Title:         string(renderedTitle),
+			Authors:       renderAuthors(doc.Attributes.GetAuthors()),
+			Date:          EscapeString(doc.Attributes.GetAsStringWithDefault("revdate", "")),
+			Content:       string(renderedContent),
+		})
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+	} else {
+		_, err = output.Write(renderedContent)
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+	}
+	// generate the metadata to be returned to the caller
+	metadata := types.Metadata{
+		Title:           string(renderedTitle),
+		LastUpdated:     ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat),
+		TableOfContents: toc,
+	}
+	return metadata, err
+}
+
+// documentClass returns the class of the document (along with the language option),
+// eg: `[french]{book}`
+func documentClass(doc types.Document, lang string) string {
+	class := "{article}"
+	if doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article") == "book" {
+		class = "{book}"
+	}
+	if lang != "" {
+		return "[" + lang + "]" + class
+	}
+	return class
+}
+
+func isBook(ctx renderer.Context) bool {
+	doctype, _ := ctx.Attributes.GetAsString(types.AttrDocType)
+	return doctype == "book"
+}
+
+// babelLanguages the LaTeX class option for the most common values of the `lang` attribute
+var babelLanguages = map[string]string{
+	"de": "ngerman",
+	"en": "english",
+	"es": "spanish",
+	"fr": "french",
+	"it": "italian",
+	"nl": "dutch",
+	"pt": "portuguese",
+}
+
+func renderDocumentTitle(ctx renderer.Context, doc types.Document) ([]byte, error) {
+	if header, exists := doc.Header(); exists {
+		title, err := renderInlineElements(ctx, header.Title)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render document title")
+		}
+		return title, nil
+	}
+	return nil, nil
+}
+
+func renderAuthors(authors []types.DocumentAuthor) string {
+	result := make([]string, len(authors))
+	for i, author := range authors {
+		result[i] = EscapeString(author.FullName)
+		if author.Email != "" {
+			result[i] += `\\ \href{mailto:` + escapeURL(author.Email) + `}{` + EscapeString(author.Email) + `}`
+		}
+	}
+	return strings.Join(result, ` \and `)
+}
+
+func renderLines(ctx renderer.Context, lines [][]interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for i, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		result.Write(renderedLine)
+		if i < len(lines)-1 {
+			result.WriteString("\n")
+		}
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,79 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex documents", func() {
+
+	It("article with header", func() {
+		source := `= The *Handbook* & more
+John Doe <john@example.com>; Jane Doe
+v1.0, 2019-12-01: First edition
+:lang: fr
+
+some content`
+		expected := `\documentclass[french]{article}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{textcomp}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{graphicx}
+\usepackage{babel}
+\usepackage{listings}
+\lstset{basicstyle=\ttfamily\small,breaklines=true,columns=fullflexible}
+\usepackage{makeidx}
+\makeindex
+\usepackage{hyperref}
+\title{The \textbf{Handbook} \& more}
+\author{John Doe\\ \href{mailto:john@example.com}{john@example.com} \and Jane Doe}
+\date{2019-12-01}
+\begin{document}
+\maketitle
+some content
+\end{document}
+`
+		Expect(RenderLaTeX(source, configuration.WithHeaderFooter(true))).To(Equal(expected))
+	})
+
+	It("book without header and with minted", func() {
+		source := `:doctype: book
+:source-highlighter: minted
+
+some content`
+		expected := `\documentclass{book}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{textcomp}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{graphicx}
+\usepackage{minted}
+\usepackage{makeidx}
+\makeindex
+\usepackage{hyperref}
+\date{}
+\begin{document}
+some content
+\end{document}
+`
+		Expect(RenderLaTeX(source, configuration.WithHeaderFooter(true))).To(Equal(expected))
+	})
+
+	It("table of contents", func() {
+		source := `= A title
+:toc:
+
+== Section A`
+		expected := `\tableofcontents
+
+\section{Section A}\label{_section_a}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+})
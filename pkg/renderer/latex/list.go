@@ -0,0 +1,84 @@
+package latex
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderOrderedList(ctx renderer.Context, l types.OrderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(l.Attributes))
+	result.WriteString(`\begin{enumerate}`)
+	if start, err := strconv.Atoi(l.Attributes.GetAsString(types.AttrStart)); err == nil && start > 1 && len(l.Items) > 0 {
+		// the counter of the list depends on its nesting level (`enumi`, `enumii`, etc.)
+		result.WriteString("\n" + `\setcounter{enum` + romanNumerals[(l.Items[0].Level-1)%len(romanNumerals)] + `}{` + strconv.Itoa(start-1) + `}`)
+	}
+	for _, item := range l.Items {
+		content, err := renderElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render ordered list")
+		}
+		result.WriteString("\n" + `\item `)
+		result.Write(content)
+	}
+	result.WriteString("\n" + `\end{enumerate}`)
+	return result.Bytes(), nil
+}
+
+var romanNumerals = []string{"i", "ii", "iii", "iv"}
+
+func renderUnorderedList(ctx renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(l.Attributes))
+	result.WriteString(`\begin{itemize}`)
+	for _, item := range l.Items {
+		content, err := renderElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render unordered list")
+		}
+		switch item.CheckStyle {
+		case types.Checked:
+			result.WriteString("\n" + `\item[$\boxtimes$] `)
+		case types.Unchecked:
+			result.WriteString("\n" + `\item[$\square$] `)
+		default:
+			result.WriteString("\n" + `\item `)
+		}
+		result.Write(content)
+	}
+	result.WriteString("\n" + `\end{itemize}`)
+	return result.Bytes(), nil
+}
+
+func renderLabeledList(ctx renderer.Context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockPrefix(l.Attributes))
+	result.WriteString(`\begin{description}`)
+	for _, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render labeled list")
+		}
+		content, err := renderElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render labeled list")
+		}
+		// the term is wrapped in braces, in case it contains some `]` characters
+		result.WriteString("\n" + `\item[{`)
+		result.Write(term)
+		result.WriteString(`}]`)
+		if len(content) > 0 {
+			result.WriteString(" ")
+			result.Write(content)
+		} else {
+			result.WriteString(` \mbox{}`)
+		}
+	}
+	result.WriteString("\n" + `\end{description}`)
+	return result.Bytes(), nil
+}
@@ -0,0 +1,50 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex lists", func() {
+
+	It("nested unordered list with checkboxes", func() {
+		source := `* [x] done
+* [ ] todo
+** nested & item`
+		expected := `\begin{itemize}
+\item[$\boxtimes$] done
+\item[$\square$] todo
+
+\begin{itemize}
+\item nested \& item
+\end{itemize}
+\end{itemize}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("ordered list with start", func() {
+		source := `[start=3]
+. three
+. four`
+		expected := `\begin{enumerate}
+\setcounter{enumi}{2}
+\item three
+\item four
+\end{enumerate}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("labeled list", func() {
+		source := `.Terms
+item[1]:: the *first* item
+item 2::`
+		expected := `\noindent\textbf{Terms}\par
+\begin{description}
+\item[{item[1]}] the \textbf{first} item
+\item[{item 2}] \mbox{}
+\end{description}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+})
@@ -0,0 +1,50 @@
+package latex
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// the sectioning commands of the `article` class, indexed by the section level
+var articleSectionCommands = []string{`\part`, `\section`, `\subsection`, `\subsubsection`, `\paragraph`, `\subparagraph`}
+
+// the sectioning commands of the `book` class, indexed by the section level
+var bookSectionCommands = []string{`\part`, `\chapter`, `\section`, `\subsection`, `\subsubsection`, `\paragraph`}
+
+func renderSection(ctx renderer.Context, s types.Section) ([]byte, error) {
+	log.Debugf("rendering section level %d", s.Level)
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section")
+	}
+	commands := articleSectionCommands
+	if isBook(ctx) {
+		commands = bookSectionCommands
+	}
+	command := commands[len(commands)-1]
+	if s.Level < len(commands) {
+		command = commands[s.Level]
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(command)
+	result.WriteString("{")
+	result.Write(title)
+	result.WriteString("}")
+	if id := s.Attributes.GetAsString(types.AttrID); id != "" {
+		result.WriteString(`\label{` + escapeLabel(id) + `}`)
+	}
+	content, err := renderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section")
+	}
+	if len(content) > 0 {
+		result.WriteString("\n\n")
+		result.Write(content)
+	}
+	return result.Bytes(), nil
+}
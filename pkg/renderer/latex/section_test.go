@@ -0,0 +1,57 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex sections", func() {
+
+	It("article sections", func() {
+		source := `== Section A
+
+content
+
+=== Section B
+
+==== Section C
+
+===== Section D`
+		expected := `\section{Section A}\label{_section_a}
+
+content
+
+\subsection{Section B}\label{_section_b}
+
+\subsubsection{Section C}\label{_section_c}
+
+\paragraph{Section D}\label{_section_d}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("book parts and chapters", func() {
+		source := `= A Book
+:doctype: book
+
+= Part I
+
+== Chapter A
+
+=== Section B`
+		expected := `\part{Part I}\label{_part_i}
+
+\chapter{Chapter A}\label{_chapter_a}
+
+\section{Section B}\label{_section_b}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("section with custom id and special characters", func() {
+		source := `[#custom_id]
+== 100% _Go_ & {blank}friends`
+		expected := `\section{100\% \emph{Go} \& friends}\label{custom_id}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+})
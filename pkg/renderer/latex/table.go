@@ -0,0 +1,67 @@
+package latex
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderTable(ctx renderer.Context, t types.Table) ([]byte, error) {
+	columns := len(t.Header.Cells)
+	if columns == 0 && len(t.Lines) > 0 {
+		columns = len(t.Lines[0].Cells)
+	}
+	if columns == 0 {
+		return nil, nil
+	}
+	result := bytes.NewBuffer(nil)
+	title := t.Attributes.GetAsString(types.AttrTitle)
+	if title != "" {
+		// a table with a title is rendered as a float, with a caption
+		result.WriteString(`\begin{table}[htbp]` + "\n" + `\centering` + "\n")
+		result.WriteString(`\caption{` + EscapeString(title) + `}`)
+		if id := t.Attributes.GetAsString(types.AttrID); id != "" {
+			result.WriteString(`\label{` + escapeLabel(id) + `}`)
+		}
+		result.WriteString("\n")
+	} else if anchor := renderAnchor(t.Attributes); anchor != "" {
+		result.WriteString(anchor + "\n")
+	}
+	result.WriteString(`\begin{tabular}{|` + strings.Repeat("l|", columns) + `}` + "\n" + `\hline`)
+	if len(t.Header.Cells) > 0 {
+		if err := renderTableLine(ctx, result, t.Header, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, l := range t.Lines {
+		if err := renderTableLine(ctx, result, l, false); err != nil {
+			return nil, err
+		}
+	}
+	result.WriteString("\n" + `\end{tabular}`)
+	if title != "" {
+		result.WriteString("\n" + `\end{table}`)
+	}
+	return result.Bytes(), nil
+}
+
+func renderTableLine(ctx renderer.Context, result *bytes.Buffer, l types.TableLine, header bool) error {
+	cells := make([]string, len(l.Cells))
+	for i, cell := range l.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return errors.Wrap(err, "unable to render table")
+		}
+		if header {
+			cells[i] = `\textbf{` + strings.TrimSpace(string(content)) + `}`
+		} else {
+			cells[i] = strings.TrimSpace(string(content))
+		}
+	}
+	result.WriteString("\n" + strings.Join(cells, " & ") + ` \\ \hline`)
+	return nil
+}
@@ -0,0 +1,44 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex tables", func() {
+
+	It("table with header and title", func() {
+		source := `[#data]
+.Data
+|===
+|Name |Value
+
+|a_1 |*$5*
+|b |10%
+|===`
+		expected := `\begin{table}[htbp]
+\centering
+\caption{Data}\label{data}
+\begin{tabular}{|l|l|}
+\hline
+\textbf{Name} & \textbf{Value} \\ \hline
+a\_1 & \textbf{\$5} \\ \hline
+b & 10\% \\ \hline
+\end{tabular}
+\end{table}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("table without header", func() {
+		source := `|===
+|a |b |c
+|===`
+		expected := `\begin{tabular}{|l|l|l|}
+\hline
+a & b & c \\ \hline
+\end{tabular}`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+})
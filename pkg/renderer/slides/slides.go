@@ -0,0 +1,497 @@
+package slides
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttrTheme the document attribute to set the reveal.js theme
+	AttrTheme = "revealjs_theme"
+	// AttrTransition the document attribute to set the reveal.js transition between slides
+	AttrTransition = "revealjs_transition"
+	// AttrRevealJSDir the document attribute to set the location of the reveal.js distribution
+	AttrRevealJSDir = "revealjsdir"
+	// the role of the blocks to render as speaker notes
+	notesRole = "notes"
+	// the option of the blocks (or lists) to display incrementally
+	stepOption = "%step"
+	// the language of the deck when the `lang` attribute is not set
+	defaultLang = "en"
+)
+
+var deckTmpl texttemplate.Template
+var titleSlideTmpl texttemplate.Template
+var slideTmpl texttemplate.Template
+var verticalSlidesTmpl texttemplate.Template
+
+func init() {
+	deckTmpl = newTextTemplate("deck", `<!DOCTYPE html>
+<html lang="{{ escape .Lang }}">
+<head>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0, maximum-scale=1.0, user-scalable=no">{{ if .Generator }}
+<meta name="generator" content="{{ .Generator }}">{{ end }}{{ if .Authors }}
+<meta name="author" content="{{ escape .Authors }}">{{ end }}
+<title>{{ escape .Title }}</title>
+<link rel="stylesheet" href="{{ escape .RevealJSDir }}/dist/reset.css">
+<link rel="stylesheet" href="{{ escape .RevealJSDir }}/dist/reveal.css">
+<link rel="stylesheet" href="{{ escape .RevealJSDir }}/dist/theme/{{ escape .Theme }}.css" id="theme">{{ if .CSS }}
+<link rel="stylesheet" href="{{ escape .CSS }}">{{ end }}
+</head>
+<body>
+<div class="reveal">
+<div class="slides">
+{{ .Slides }}
+</div>
+</div>
+<script src="{{ escape .RevealJSDir }}/dist/reveal.js"></script>
+<script src="{{ escape .RevealJSDir }}/plugin/notes/notes.js"></script>
+<script>
+Reveal.initialize({
+  hash: true,
+  transition: '{{ js .Transition }}',
+  plugins: [ RevealNotes ]
+});
+</script>
+</body>
+</html>`,
+		texttemplate.FuncMap{
+			"escape": html5.EscapeString,
+		})
+
+	titleSlideTmpl = newTextTemplate("title slide", `<section class="title" data-state="title">
+<h1>{{ .Title }}</h1>{{ if .Authors }}
+<p class="author">{{ .Authors }}</p>{{ end }}{{ if .RevDate }}
+<p class="revdate">{{ escape .RevDate }}</p>{{ end }}{{ if .Content }}
+{{ .Content }}{{ end }}
+</section>`,
+		texttemplate.FuncMap{
+			"escape": html5.EscapeString,
+		})
+
+	slideTmpl = newTextTemplate("slide", `<section{{ if .ID }} id="{{ escape .ID }}"{{ end }}{{ range .DataAttributes }} {{ .Key }}="{{ escape .Value }}"{{ end }}>
+<h2>{{ .Title }}</h2>{{ if .Content }}
+{{ .Content }}{{ end }}{{ if .Notes }}
+<aside class="notes">
+{{ .Notes }}
+</aside>{{ end }}
+</section>`,
+		texttemplate.FuncMap{
+			"escape": html5.EscapeString,
+		})
+
+	verticalSlidesTmpl = newTextTemplate("vertical slides", `<section>
+{{ . }}
+</section>`)
+}
+
+func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	t, err := t.Parse(src)
+	if err != nil {
+		log.Fatalf("failed to initialize '%s' template: %s", name, err.Error())
+	}
+	return *t
+}
+
+// Render renders the given document as a reveal.js slide deck and writes the result in the given `writer`:
+// the level 1 sections are the slides, and their level 2 sections are the vertical sub-slides.
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	header, hasHeader := doc.Header()
+	var renderedTitle []byte
+	var err error
+	if hasHeader {
+		renderedTitle, err = html5.RenderPlainText(ctx, header.Title)
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render slide deck")
+		}
+	}
+	toc, err := html5.NewTableOfContents(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render slide deck")
+	}
+	renderedSlides, err := renderSlides(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render slide deck")
+	}
+	if ctx.Config.IncludeHeaderFooter {
+		err = deckTmpl.Execute(output, struct {
+			Lang        string
+			Generator   string
+			Title       string
+			Authors     string
+			CSS         string
+			RevealJSDir string
+			Theme       string
+			Transition  string
+			Slides      string
+		}{
+			Lang:        doc.Attributes.GetAsStringWithDefault("lang", defaultLang),
+			Generator:   "libasciidoc",
+			Title:       string(renderedTitle),
+			Authors:     renderAuthors(doc.Attributes.GetAuthors()),
+			CSS:         ctx.Config.CSS,
+			RevealJSDir: doc.Attributes.GetAsStringWithDefault(AttrRevealJSDir, "reveal.js"),
+			Theme:       doc.Attributes.GetAsStringWithDefault(AttrTheme, "black"),
+			Transition:  doc.Attributes.GetAsStringWithDefault(AttrTransition, "slide"),
+			Slides:      string(renderedSlides),
+		})
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render slide deck")
+		}
+	} else {
+		_, err = output.Write(renderedSlides)
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render slide deck")
+		}
+	}
+	return types.Metadata{
+		Title:           string(renderedTitle),
+		LastUpdated:     ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat),
+		TableOfContents: toc,
+	}, nil
+}
+
+func renderAuthors(authors []types.DocumentAuthor) string {
+	authorStrs := make([]string, len(authors))
+	for i, author := range authors {
+		authorStrs[i] = author.FullName
+	}
+	return strings.Join(authorStrs, "; ")
+}
+
+// renderSlides renders the title slide (with the document header and preamble)
+// followed by a slide per level 1 section
+func renderSlides(ctx renderer.Context, doc types.Document) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	elements := doc.Elements
+	if header, exists := doc.Header(); exists {
+		elements = make([]interface{}, 0, len(header.Elements)+len(doc.Elements)-1)
+		elements = append(elements, header.Elements...)
+		elements = append(elements, doc.Elements[1:]...)
+		// the preamble (or any content before the first section) is displayed in the title slide
+		content := []interface{}{}
+		for len(elements) > 0 {
+			if _, ok := elements[0].(types.Section); ok {
+				break
+			}
+			if p, ok := elements[0].(types.Preamble); ok {
+				content = append(content, p.Elements...)
+			} else {
+				content = append(content, elements[0])
+			}
+			elements = elements[1:]
+		}
+		if !doc.Attributes.Has(types.AttrNoHeader) {
+			renderedTitle, err := html5.RenderInlineElements(ctx, header.Title)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render title slide")
+			}
+			renderedContent, _, err := renderSlideContent(ctx, content)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render title slide")
+			}
+			authors := make([]string, 0, len(doc.Attributes.GetAuthors()))
+			for _, author := range doc.Attributes.GetAuthors() {
+				authors = append(authors, html5.EscapeString(author.FullName))
+			}
+			err = titleSlideTmpl.Execute(result, struct {
+				Title   string
+				Authors string
+				RevDate string
+				Content string
+			}{
+				Title:   string(renderedTitle),
+				Authors: strings.Join(authors, ", "),
+				RevDate: doc.Attributes.GetAsStringWithDefault("revdate", ""),
+				Content: string(renderedContent),
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render title slide")
+			}
+		}
+	}
+	for _, element := range elements {
+		var renderedSlide []byte
+		var err error
+		switch e := element.(type) {
+		case types.Section:
+			renderedSlide, err = renderSectionSlides(ctx, e)
+		case types.TableOfContentsPlaceHolder, types.BlankLine:
+			continue
+		default:
+			// content outside of a section is rendered in a slide without title
+			renderedSlide, err = renderSlide(ctx, types.Section{
+				Attributes: types.ElementAttributes{},
+				Title:      []interface{}{},
+				Elements:   []interface{}{e},
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n")
+		}
+		result.Write(renderedSlide)
+	}
+	if len(doc.Footnotes) > 0 {
+		// footnotes are displayed in a slide of their own, at the end of the deck
+		renderedFootnotes, err := html5.RenderFootnotes(ctx, doc.Footnotes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render footnotes")
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString("<section id=\"_footnotes\">\n")
+		result.WriteString(strings.TrimSpace(string(renderedFootnotes)))
+		result.WriteString("\n</section>")
+	}
+	return result.Bytes(), nil
+}
+
+// renderSectionSlides renders the given section as a single slide, or as a vertical stack of slides
+// if it contains level 2 sections
+func renderSectionSlides(ctx renderer.Context, s types.Section) ([]byte, error) {
+	content := []interface{}{}
+	subsections := []types.Section{}
+	if s.Level <= 1 {
+		for _, e := range s.Elements {
+			if sub, ok := e.(types.Section); ok && sub.Level == s.Level+1 {
+				subsections = append(subsections, sub)
+				continue
+			}
+			content = append(content, e)
+		}
+	} else {
+		content = s.Elements
+	}
+	if len(subsections) == 0 {
+		return renderSlide(ctx, s)
+	}
+	main := s
+	main.Elements = content
+	result := bytes.NewBuffer(nil)
+	renderedSlide, err := renderSlide(ctx, main)
+	if err != nil {
+		return nil, err
+	}
+	result.Write(renderedSlide)
+	for _, sub := range subsections {
+		renderedSlide, err := renderSlide(ctx, sub)
+		if err != nil {
+			return nil, err
+		}
+		result.WriteString("\n")
+		result.Write(renderedSlide)
+	}
+	stack := bytes.NewBuffer(nil)
+	if err := verticalSlidesTmpl.Execute(stack, result.String()); err != nil {
+		return nil, errors.Wrap(err, "unable to render vertical slides")
+	}
+	return stack.Bytes(), nil
+}
+
+// the section attributes which are converted into reveal.js `data-*` attributes
+var slideDataAttributes = []string{"transition", "transition-speed", "background-color", "background-image", "background-size", "auto-animate", "state"}
+
+// dataAttribute a `data-*` attribute of a slide
+type dataAttribute struct {
+	Key   string
+	Value string
+}
+
+func renderSlide(ctx renderer.Context, s types.Section) ([]byte, error) {
+	renderedTitle, err := html5.RenderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render slide")
+	}
+	renderedContent, renderedNotes, err := renderSlideContent(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render slide")
+	}
+	data := []dataAttribute{}
+	for _, k := range slideDataAttributes {
+		if s.Attributes.Has(k) {
+			data = append(data, dataAttribute{
+				Key:   "data-" + k,
+				Value: s.Attributes.GetAsString(k),
+			})
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	err = slideTmpl.Execute(result, struct {
+		ID             string
+		Title          string
+		DataAttributes []dataAttribute
+		Content        string
+		Notes          string
+	}{
+		ID:             s.Attributes.GetAsString(types.AttrID),
+		Title:          string(renderedTitle),
+		DataAttributes: data,
+		Content:        string(renderedContent),
+		Notes:          string(renderedNotes),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render slide")
+	}
+	return result.Bytes(), nil
+}
+
+// renderSlideContent renders the content of a slide, and returns the speaker notes (ie, the blocks with the `notes` role) on the side
+func renderSlideContent(ctx renderer.Context, elements []interface{}) ([]byte, []byte, error) {
+	content := bytes.NewBuffer(nil)
+	notes := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		attrs := attributes(element)
+		isNotes := attrs.GetAsString(types.AttrRole) == notesRole
+		if isNotes {
+			element = withoutAttribute(element, types.AttrRole)
+		}
+		renderedElement, err := html5.RenderElements(ctx, []interface{}{element})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if attrs.Has(stepOption) {
+			renderedElement = fragment(element, renderedElement)
+		}
+		buf := content
+		if isNotes {
+			buf = notes
+		}
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.Write(renderedElement)
+	}
+	return content.Bytes(), notes.Bytes(), nil
+}
+
+// fragment makes the given rendered element appear incrementally: the top-level items
+// of a list are fragments, and other elements are wrapped in a fragment
+func fragment(element interface{}, rendered []byte) []byte {
+	switch element.(type) {
+	case types.OrderedList, types.UnorderedList:
+		return addClassToTopLevelItems(rendered, "fragment")
+	default:
+		return []byte("<div class=\"fragment\">\n" + string(rendered) + "\n</div>")
+	}
+}
+
+var liRegexp = regexp.MustCompile(`^<li(\s[^<>]*)?>`)
+var classAttrRegexp = regexp.MustCompile(`\sclass="([^"]*)"`)
+
+// addClassToTopLevelItems adds the given class on the `<li>` elements (with or without attributes) of the given list,
+// but not on the items of the nested lists
+func addClassToTopLevelItems(list []byte, class string) []byte {
+	result := bytes.NewBuffer(nil)
+	depth := 0
+	for i := 0; i < len(list); i++ {
+		switch {
+		case bytes.HasPrefix(list[i:], []byte("<li")) && liRegexp.Match(list[i:]):
+			m := liRegexp.FindSubmatch(list[i:])
+			if depth == 0 {
+				attrs := m[1]
+				if classAttrRegexp.Match(attrs) {
+					attrs = classAttrRegexp.ReplaceAll(attrs, []byte(` class="$1 `+class+`"`))
+				} else {
+					attrs = append(attrs, []byte(` class="`+class+`"`)...)
+				}
+				result.WriteString("<li" + string(attrs) + ">")
+			} else {
+				result.Write(m[0])
+			}
+			depth++
+			i += len(m[0]) - 1
+		case bytes.HasPrefix(list[i:], []byte("</li>")):
+			depth--
+			result.WriteString("</li>")
+			i += len("</li>") - 1
+		default:
+			result.WriteByte(list[i])
+		}
+	}
+	return result.Bytes()
+}
+
+// attributes returns the attributes of the given block element, or an empty map
+func attributes(element interface{}) types.ElementAttributes {
+	var attrs types.ElementAttributes
+	switch e := element.(type) {
+	case types.Paragraph:
+		attrs = e.Attributes
+	case types.DelimitedBlock:
+		attrs = e.Attributes
+	case types.OrderedList:
+		attrs = e.Attributes
+	case types.UnorderedList:
+		attrs = e.Attributes
+	case types.LabeledList:
+		attrs = e.Attributes
+	case types.ImageBlock:
+		attrs = e.Attributes
+	case types.Table:
+		attrs = e.Attributes
+	case types.LiteralBlock:
+		attrs = e.Attributes
+	}
+	if attrs == nil {
+		return types.ElementAttributes{}
+	}
+	return attrs
+}
+
+// withoutAttribute returns a copy of the given element, without the attribute with the given key
+func withoutAttribute(element interface{}, key string) interface{} {
+	attrs := types.ElementAttributes{}
+	attrs.AddAll(attributes(element))
+	delete(attrs, key)
+	switch e := element.(type) {
+	case types.Paragraph:
+		e.Attributes = attrs
+		return e
+	case types.DelimitedBlock:
+		e.Attributes = attrs
+		return e
+	case types.OrderedList:
+		e.Attributes = attrs
+		return e
+	case types.UnorderedList:
+		e.Attributes = attrs
+		return e
+	case types.LabeledList:
+		e.Attributes = attrs
+		return e
+	case types.ImageBlock:
+		e.Attributes = attrs
+		return e
+	case types.Table:
+		e.Attributes = attrs
+		return e
+	case types.LiteralBlock:
+		e.Attributes = attrs
+		return e
+	default:
+		return element
+	}
+}
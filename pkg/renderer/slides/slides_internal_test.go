@@ -0,0 +1,53 @@
+package slides
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fragments", func() {
+
+	It("should add class on top-level items with attributes", func() {
+		list := `<ul class="checklist">
+<li class="checked">
+<p>one</p>
+<ul>
+<li class="unchecked">nested</li>
+<li>nested</li>
+</ul>
+</li>
+<li id="two">
+<p>two</p>
+</li>
+<li>
+<p>three</p>
+</li>
+</ul>`
+		expected := `<ul class="checklist">
+<li class="checked fragment">
+<p>one</p>
+<ul>
+<li class="unchecked">nested</li>
+<li>nested</li>
+</ul>
+</li>
+<li id="two" class="fragment">
+<p>two</p>
+</li>
+<li class="fragment">
+<p>three</p>
+</li>
+</ul>`
+		Expect(string(addClassToTopLevelItems([]byte(list), "fragment"))).To(Equal(expected))
+	})
+
+	It("should not add class on other elements", func() {
+		list := `<ul>
+<li><a href="#"><link>one</a></li>
+</ul>`
+		expected := `<ul>
+<li class="fragment"><a href="#"><link>one</a></li>
+</ul>`
+		Expect(string(addClassToTopLevelItems([]byte(list), "fragment"))).To(Equal(expected))
+	})
+})
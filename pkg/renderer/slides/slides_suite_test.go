@@ -0,0 +1,15 @@
+package slides_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestSlides(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Slides Suite")
+}
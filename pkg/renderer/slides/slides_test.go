@@ -0,0 +1,225 @@
+package slides_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("slides", func() {
+
+	Context("title slide", func() {
+
+		It("title slide with authors, revision date and preamble", func() {
+			source := `= My Talk
+John Doe
+v1.0, 2020-01-01
+
+Welcome!`
+			expected := `<section class="title" data-state="title">
+<h1>My Talk</h1>
+<p class="author">John Doe</p>
+<p class="revdate">2020-01-01</p>
+<div class="paragraph">
+<p>Welcome!</p>
+</div>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+
+		It("no title slide with noheader attribute", func() {
+			source := `= My Talk
+:noheader:
+
+== First`
+			expected := `<section id="_first">
+<h2>First</h2>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+	})
+
+	Context("sections", func() {
+
+		It("level 1 sections as slides", func() {
+			source := `== First
+
+content
+
+== Second`
+			expected := `<section id="_first">
+<h2>First</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+</section>
+<section id="_second">
+<h2>Second</h2>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+
+		It("level 2 sections as vertical slides", func() {
+			source := `== First
+
+content
+
+=== Sub 1
+
+=== Sub 2`
+			expected := `<section>
+<section id="_first">
+<h2>First</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+</section>
+<section id="_sub_1">
+<h2>Sub 1</h2>
+</section>
+<section id="_sub_2">
+<h2>Sub 2</h2>
+</section>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+
+		It("slide with escaped ID", func() {
+			source := `[#a<b>&c]
+== First`
+			expected := `<section id="a&lt;b&gt;&amp;c">
+<h2>First</h2>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+
+		It("slide with transition and background color", func() {
+			source := `[transition=zoom,background-color=#ff0000]
+== First`
+			expected := `<section id="_first" data-transition="zoom" data-background-color="#ff0000">
+<h2>First</h2>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+	})
+
+	Context("speaker notes", func() {
+
+		It("paragraph as speaker notes", func() {
+			source := `== First
+
+content
+
+[.notes]
+remember this`
+			expected := `<section id="_first">
+<h2>First</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+<aside class="notes">
+<div class="paragraph">
+<p>remember this</p>
+</div>
+</aside>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+	})
+
+	Context("fragments", func() {
+
+		It("list with step option", func() {
+			source := `== First
+
+[%step]
+* one
+** nested
+* two`
+			expected := `<section id="_first">
+<h2>First</h2>
+<div class="ulist">
+<ul>
+<li class="fragment">
+<p>one</p>
+<div class="ulist">
+<ul>
+<li>
+<p>nested</p>
+</li>
+</ul>
+</div>
+</li>
+<li class="fragment">
+<p>two</p>
+</li>
+</ul>
+</div>
+</section>`
+			Expect(RenderSlides(source)).To(Equal(expected))
+		})
+	})
+
+	Context("deck", func() {
+
+		It("deck with theme and transition", func() {
+			source := `= My Talk
+:revealjs_theme: moon
+:revealjs_transition: fade
+:revealjsdir: lib/reveal.js`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0, maximum-scale=1.0, user-scalable=no">
+<meta name="generator" content="libasciidoc">
+<title>My Talk</title>
+<link rel="stylesheet" href="lib/reveal.js/dist/reset.css">
+<link rel="stylesheet" href="lib/reveal.js/dist/reveal.css">
+<link rel="stylesheet" href="lib/reveal.js/dist/theme/moon.css" id="theme">
+</head>
+<body>
+<div class="reveal">
+<div class="slides">
+<section class="title" data-state="title">
+<h1>My Talk</h1>
+</section>
+</div>
+</div>
+<script src="lib/reveal.js/dist/reveal.js"></script>
+<script src="lib/reveal.js/plugin/notes/notes.js"></script>
+<script>
+Reveal.initialize({
+  hash: true,
+  transition: 'fade',
+  plugins: [ RevealNotes ]
+});
+</script>
+</body>
+</html>`
+			Expect(RenderSlides(source, configuration.WithHeaderFooter(true))).To(Equal(expected))
+		})
+
+		It("deck with escaped authors and CSS, and language", func() {
+			source := `= My Talk
+:author: John "Johnny" Doe
+:lang: fr`
+			result, err := RenderSlides(source, configuration.WithHeaderFooter(true), configuration.WithCSS(`styles/"main"<1>.css`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HavePrefix(`<!DOCTYPE html>
+<html lang="fr">`))
+			Expect(result).To(ContainSubstring(`<meta name="author" content="John &#34;Johnny&#34; Doe">`))
+			Expect(result).To(ContainSubstring(`<link rel="stylesheet" href="styles/&#34;main&#34;&lt;1&gt;.css">`))
+		})
+
+		It("deck with escaped transition", func() {
+			source := `= My Talk
+:revealjs_transition: fade'; alert("boo"); '`
+			result, err := RenderSlides(source, configuration.WithHeaderFooter(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`  transition: 'fade\'; alert(\"boo\"); \'',`))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DiagnosticSeverity the severity of a diagnostic
+type DiagnosticSeverity string
+
+const (
+	// DiagnosticError the severity of a diagnostic for a problem which caused some content to be missing or wrong in the output
+	DiagnosticError DiagnosticSeverity = "error"
+	// DiagnosticWarning the severity of a diagnostic for a problem which may cause unexpected output
+	DiagnosticWarning DiagnosticSeverity = "warning"
+	// DiagnosticInfo the severity of an informational diagnostic
+	DiagnosticInfo DiagnosticSeverity = "info"
+)
+
+// DiagnosticCategory the category of a diagnostic, ie, the step of the conversion during which it was reported
+type DiagnosticCategory string
+
+const (
+	// ParseCategory the category of the diagnostics reported while parsing a document (eg: unknown attribute)
+	ParseCategory DiagnosticCategory = "parse"
+	// IncludeCategory the category of the diagnostics reported while including a file in a document
+	IncludeCategory DiagnosticCategory = "include"
+	// ValidationCategory the category of the diagnostics reported while validating a document
+	ValidationCategory DiagnosticCategory = "validation"
+	// RenderCategory the category of the diagnostics reported while rendering a document
+	RenderCategory DiagnosticCategory = "render"
+)
+
+// Position a position in a source file. Zero values mean that the filename, line or column is unknown
+type Position struct {
+	Filename string
+	Line     int
+	Column   int
+}
+
+// String returns the position in the `filename:line:column` format, omitting the unknown parts
+func (p Position) String() string {
+	result := p.Filename
+	if p.Line > 0 {
+		result = fmt.Sprintf("%s:%d", result, p.Line)
+		if p.Column > 0 {
+			result = fmt.Sprintf("%s:%d", result, p.Column)
+		}
+	}
+	return result
+}
+
+// Diagnostic a problem reported during the conversion of a document, which did not cause the conversion to fail
+type Diagnostic struct {
+	Severity DiagnosticSeverity
+	Category DiagnosticCategory
+	Message  string
+	Position Position
+	// Cause the underlying error, if any (eg: the error which occurred while reading a file to include)
+	Cause error
+}
+
+// String returns the message of the diagnostic, prefixed with its position and followed by its cause if they are known
+func (d Diagnostic) String() string {
+	result := d.Message
+	if d.Cause != nil {
+		result = result + ": " + d.Cause.Error()
+	}
+	if p := d.Position.String(); p != "" {
+		return p + ": " + result
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"errors"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("diagnostics", func() {
+
+	It("should format diagnostic with position and cause", func() {
+		d := types.Diagnostic{
+			Severity: types.DiagnosticError,
+			Message:  "failed to include 'chapter.adoc'",
+			Position: types.Position{Filename: "index.adoc", Line: 3, Column: 1},
+			Cause:    errors.New("file does not exist"),
+		}
+		Expect(d.String()).To(Equal("index.adoc:3:1: failed to include 'chapter.adoc': file does not exist"))
+	})
+
+	It("should format diagnostic without position", func() {
+		d := types.Diagnostic{
+			Severity: types.DiagnosticWarning,
+			Message:  "unable to find attribute 'foo'",
+		}
+		Expect(d.String()).To(Equal("unable to find attribute 'foo'"))
+	})
+})
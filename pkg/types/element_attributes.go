@@ -18,6 +18,10 @@ const (
 	AttrID string = "id"
 	// AttrCustomID the key to retrieve the flag that indicates if the element ID is custom or generated
 	AttrCustomID string = "customID"
+	// AttrOriginalID the key to retrieve the ID of a section before it was renamed because another section already had the same ID
+	AttrOriginalID string = "originalID"
+	// AttrStyle the key to retrieve the style of a block, ie, the first positional attribute of its attribute group (eg: `[chart,caption=Sales]`)
+	AttrStyle string = "style"
 	// AttrTitle the key to retrieve the title
 	AttrTitle string = "title"
 	// AttrAuthors the key to the authors declared after the section level 0 (at the beginning of the doc)
@@ -48,12 +52,18 @@ const (
 	AttrNumberingStyle string = "numberingStyle"
 	// AttrQandA the `qanda` attribute for Q&A labeled lists
 	AttrQandA string = "qanda"
-	// AttrLevelOffset the `leveloffset` attribute used in file inclusions
+	// AttrLevelOffset the `leveloffset` attribute used in file inclusions and as a document attribute
 	AttrLevelOffset string = "leveloffset"
 	// AttrLineRanges the `lines` attribute used in file inclusions
 	AttrLineRanges string = "lines"
 	// AttrTagRanges the `tag`/`tags` attribute used in file inclusions
 	AttrTagRanges string = "tags"
+	// AttrIndent the `indent` attribute used in file inclusions
+	AttrIndent string = "indent"
+	// AttrEncoding the `encoding` attribute used in file inclusions
+	AttrEncoding string = "encoding"
+	// AttrOptions the `opts` attribute used in file inclusions (eg: `opts=optional`)
+	AttrOptions string = "opts"
 	// AttrLastUpdated the "last updated" data in the document, i.e., the output/generation time
 	AttrLastUpdated string = "LastUpdated"
 	// AttrImageAlt the image `alt` attribute
@@ -111,12 +121,16 @@ func NewAdmonitionAttribute(k AdmonitionKind) (ElementAttributes, error) {
 func NewAttributeGroup(attributes []interface{}) (ElementAttributes, error) {
 	// log.Debugf("initializing a new AttributeGroup with %v", attributes)
 	result := make(ElementAttributes)
-	for _, a := range attributes {
+	for i, a := range attributes {
 		// log.Debugf("processing attribute element of type %T", a)
 		if a, ok := a.(ElementAttributes); ok {
 			for k, v := range a {
 				// log.Debugf("adding attribute %v='%v'", k, v)
 				result[k] = v
+				// the first attribute is the style of the block if it has no value (and if it is not an option, eg: `%step`)
+				if i == 0 && v == nil && !strings.HasPrefix(k, "%") {
+					result[AttrStyle] = k
+				}
 			}
 		} else {
 			return result, errors.Errorf("unable to process element of type '%[1]T': '%[1]s'", a)
@@ -220,6 +234,15 @@ func (a ElementAttributes) GetAsString(key string) string {
 	return ""
 }
 
+// GetAsStringWithDefault returns the value of the key as a string, or the given default value if the key did not exist
+// or if its value was nil (eg: for a positional attribute)
+func (a ElementAttributes) GetAsStringWithDefault(key, defaultValue string) string {
+	if v, ok := a[key]; ok && v != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return defaultValue
+}
+
 // GetAsBool returns the value of the key as a bool, or `false` if the key did not exist
 // or if its value was not a bool
 func (a ElementAttributes) GetAsBool(key string) bool {
@@ -251,8 +274,9 @@ func (a ElementAttributes) AddNonEmpty(key string, value interface{}) {
 	a[key] = value
 }
 
-// NewElementAttributes retrieves the ElementID, ElementTitle and ElementInlineLink from the given slice of attributes
-func NewElementAttributes(attributes []interface{}) ElementAttributes {
+// NewElementAttributes retrieves the ElementID, ElementTitle and ElementInlineLink from the given slice of attributes.
+// Returns an error if an attribute has an unexpected type
+func NewElementAttributes(attributes []interface{}) (ElementAttributes, error) {
 	attrs := ElementAttributes{}
 	for _, attr := range attributes {
 		// log.Debugf("processing attribute %[1]v (%[1]T)", attr)
@@ -261,7 +285,10 @@ func NewElementAttributes(attributes []interface{}) ElementAttributes {
 			// nested case, because of the grammar syntax,
 			// eg: `attributes:(ElementAttribute* LiteralAttribute ElementAttribute*)`
 			// which is used to ensure that a `LiteralAttribute` element is set amongst the attributes
-			r := NewElementAttributes(attr)
+			r, err := NewElementAttributes(attr)
+			if err != nil {
+				return nil, err
+			}
 			for k, v := range r {
 				attrs[k] = v
 			}
@@ -278,10 +305,10 @@ func NewElementAttributes(attributes []interface{}) ElementAttributes {
 		case nil:
 			// ignore
 		default:
-			log.Warnf("unexpected attributes of type: %T", attr)
+			return nil, errors.Errorf("unexpected attributes of type: %T", attr)
 		}
 	}
-	return attrs
+	return attrs, nil
 }
 
 // NewInlineAttributes returns a map of attributes
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"time"
+)
+
+// Phase a phase of the conversion of a document
+type Phase string
+
+const (
+	// PreprocessingPhase the phase during which the preprocessors are applied on the source of the document
+	PreprocessingPhase Phase = "preprocessing"
+	// ParsingPhase the phase during which the document and its included files are parsed
+	ParsingPhase Phase = "parsing"
+	// IncludePhase the phase during which a file is included in the document (ie, read and parsed). This phase is
+	// measured once per included file, during the `ParsingPhase` (and during the inclusion of the including file, if any)
+	IncludePhase Phase = "include"
+	// SubstitutionPhase the phase during which the macros are processed and the document attributes are substituted
+	SubstitutionPhase Phase = "substitution"
+	// RearrangingPhase the phase during which the list items are merged into lists and the sections are arranged
+	// in a hierarchical manner, along with the footnotes, the preamble and the table of contents
+	RearrangingPhase Phase = "rearranging"
+	// TreeProcessingPhase the phase during which the tree processors are applied on the document
+	TreeProcessingPhase Phase = "tree processing"
+	// ValidationPhase the phase during which the document is validated
+	ValidationPhase Phase = "validation"
+	// RenderingPhase the phase during which the document is rendered
+	RenderingPhase Phase = "rendering"
+)
+
+// Timing the duration and the memory allocations of a phase of the conversion of a document
+type Timing struct {
+	Phase Phase
+	// Path the path of the included file, for the `IncludePhase`
+	Path     string
+	Duration time.Duration
+	// Allocs the number of heap objects allocated during the phase. Since it is measured for the whole process,
+	// it also includes the objects allocated by the other goroutines (eg: during concurrent conversions)
+	Allocs uint64
+	// AllocBytes the number of bytes allocated for heap objects during the phase (see `Allocs`)
+	AllocBytes uint64
+}
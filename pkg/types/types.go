@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"net/url"
+	"path"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -130,6 +132,10 @@ type Metadata struct {
 	TableOfContents TableOfContents
 	Authors         []DocumentAuthor
 	Revision        DocumentRevision
+	// Diagnostics the problems reported while parsing, validating and rendering the document
+	Diagnostics []Diagnostic
+	// Timings the timings of the phases of the conversion, if a timing handler was set in the configuration
+	Timings []Timing
 }
 
 // TableOfContents the table of contents
@@ -371,6 +377,34 @@ func NewInlineUserMacro(name, value string, attrs ElementAttributes, raw string)
 	return UserMacro{Name: name, Kind: InlineMacro, Value: value, Attributes: attrs, RawText: raw}, nil
 }
 
+var attributeSubstitutionRegexp = regexp.MustCompile(`\{([\w-]+)\}`)
+
+// ResolveValue replaces the document attribute substitutions in the value and in the attributes of the macro
+// with their associated values (or keeps their raw text if no attribute matched)
+func (m UserMacro) ResolveValue(attrs DocumentAttributesWithOverrides) UserMacro {
+	resolve := func(s string) string {
+		return attributeSubstitutionRegexp.ReplaceAllStringFunc(s, func(substitution string) string {
+			if value, found := attrs.GetAsString(substitution[1 : len(substitution)-1]); found {
+				return value
+			}
+			return substitution
+		})
+	}
+	m.Value = resolve(m.Value)
+	if len(m.Attributes) > 0 {
+		resolved := make(ElementAttributes, len(m.Attributes))
+		for k, v := range m.Attributes {
+			if v, ok := v.(string); ok {
+				resolved[k] = resolve(v)
+				continue
+			}
+			resolved[k] = v
+		}
+		m.Attributes = resolved
+	}
+	return m
+}
+
 // ------------------------------------------
 // Preamble
 // ------------------------------------------
@@ -671,8 +705,12 @@ func NewOrderedList(item *OrderedListItem) *OrderedList {
 	return list
 }
 
-// moves the "upperroman", etc. attributes as values of the `AttrNumberingStyle` key
+// moves the "upperroman", etc. attributes as values of the `AttrNumberingStyle` key (which replaces the style)
 func rearrangeListAttributes(attributes ElementAttributes) ElementAttributes {
+	switch attributes.GetAsString(AttrStyle) {
+	case "upperalpha", "upperroman", "lowerroman", "loweralpha", "arabic":
+		delete(attributes, AttrStyle)
+	}
 	for k := range attributes {
 		switch k {
 		case "upperalpha":
@@ -1144,6 +1182,29 @@ func NewInternalCrossReference(id string, label interface{}) (InternalCrossRefer
 	}, nil
 }
 
+// DocumentReference returns the path and the fragment (if any) of the other document referred to by this cross reference
+// (eg: `other.adoc` and `section` for `<<other.adoc#section>>`), or `false` if it refers to an element of the current document
+func (x InternalCrossReference) DocumentReference() (string, string, bool) {
+	p, fragment := x.ID, ""
+	if i := strings.Index(p, "#"); i != -1 {
+		p, fragment = p[:i], p[i+1:]
+	}
+	if !IsAsciidocFile(p) {
+		return "", "", false
+	}
+	return p, fragment, true
+}
+
+// IsAsciidocFile returns true if the given path has the extension of an Asciidoc document (`.adoc`, `.asciidoc`, `.asc` or `.ad`)
+func IsAsciidocFile(p string) bool {
+	switch path.Ext(p) {
+	case ".adoc", ".asciidoc", ".asc", ".ad":
+		return true
+	default:
+		return false
+	}
+}
+
 // ExternalCrossReference the struct for Cross References
 type ExternalCrossReference struct {
 	Location Location
@@ -1197,3 +1197,20 @@ var _ = Describe("footnote replacements", func() {
 		})
 	})
 })
+
+var _ = DescribeTable("cross references to other documents",
+	func(id, expectedPath, expectedFragment string, expectedOK bool) {
+		p, fragment, ok := types.InternalCrossReference{ID: id}.DocumentReference()
+		Expect(ok).To(Equal(expectedOK))
+		Expect(p).To(Equal(expectedPath))
+		Expect(fragment).To(Equal(expectedFragment))
+	},
+	Entry("adoc with fragment", "other.adoc#section", "other.adoc", "section", true),
+	Entry("adoc with empty fragment", "other.adoc#", "other.adoc", "", true),
+	Entry("adoc without fragment", "other.adoc", "other.adoc", "", true),
+	Entry("asciidoc without fragment", "guides/other.asciidoc", "guides/other.asciidoc", "", true),
+	Entry("asc with fragment", "other.asc#section", "other.asc", "section", true),
+	Entry("ad without fragment", "other.ad", "other.ad", "", true),
+	Entry("element of the current document", "_section", "", "", false),
+	Entry("other file", "other.txt#section", "", "", false),
+)
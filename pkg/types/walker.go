@@ -0,0 +1,153 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+)
+
+// SkipChildren the error to return from the `Enter` callback of a `Visitor` to skip the children of the current element.
+// It is not returned by `Walk`
+var SkipChildren = errors.New("skip children")
+
+// Visitor the callbacks invoked while walking through the elements of a document.
+// Both callbacks are optional.
+type Visitor struct {
+	// Enter is called before visiting the children of the given element. If it returns `SkipChildren`,
+	// the children of the element are not visited (but `Leave` is still called). Any other error stops the walk.
+	Enter func(element interface{}, ancestors Ancestors) error
+	// Leave is called after visiting the children of the given element. An error stops the walk.
+	Leave func(element interface{}, ancestors Ancestors) error
+}
+
+// Ancestors the ancestors of the element being visited, from the root element to the parent of the element.
+// The slice is reused during the walk, so it must be copied if it needs to be retained.
+type Ancestors []interface{}
+
+// Parent returns the parent of the element being visited, or `false` if the element is the root element
+func (a Ancestors) Parent() (interface{}, bool) {
+	if len(a) == 0 {
+		return nil, false
+	}
+	return a[len(a)-1], true
+}
+
+// Walk traverses the given element (eg: a `Document`, a `Section`, etc.) and its children in depth-first order,
+// and calls the callbacks of the given visitor on each of them. Slices of elements (such as the lines of a paragraph
+// or the cells of a table) are not visited themselves, but their elements are.
+func Walk(element interface{}, v Visitor) error {
+	return walk(element, v, Ancestors{})
+}
+
+func walk(element interface{}, v Visitor, ancestors Ancestors) error {
+	if elements, ok := element.([]interface{}); ok {
+		for _, e := range elements {
+			if err := walk(e, v, ancestors); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if element == nil {
+		return nil
+	}
+	skip := false
+	if v.Enter != nil {
+		if err := v.Enter(element, ancestors); err == SkipChildren {
+			skip = true
+		} else if err != nil {
+			return err
+		}
+	}
+	if !skip {
+		ancestors = append(ancestors, element)
+		for _, child := range children(element) {
+			if err := walk(child, v, ancestors); err != nil {
+				return err
+			}
+		}
+		ancestors = ancestors[:len(ancestors)-1]
+	}
+	if v.Leave != nil {
+		return v.Leave(element, ancestors)
+	}
+	return nil
+}
+
+// children returns the child elements of the given element
+// nolint: gocyclo
+func children(element interface{}) []interface{} {
+	switch e := element.(type) {
+	case Document:
+		return e.Elements
+	case *Document:
+		return e.Elements
+	case DraftDocument:
+		return e.Blocks
+	case *DraftDocument:
+		return e.Blocks
+	case Preamble:
+		return e.Elements
+	case Section:
+		return []interface{}{e.Title, e.Elements}
+	case Paragraph:
+		result := make([]interface{}, len(e.Lines))
+		for i, l := range e.Lines {
+			result[i] = l
+		}
+		return result
+	case DelimitedBlock:
+		return e.Elements
+	case OrderedList:
+		result := make([]interface{}, len(e.Items))
+		for i, item := range e.Items {
+			result[i] = item
+		}
+		return result
+	case OrderedListItem:
+		return e.Elements
+	case UnorderedList:
+		result := make([]interface{}, len(e.Items))
+		for i, item := range e.Items {
+			result[i] = item
+		}
+		return result
+	case UnorderedListItem:
+		return e.Elements
+	case LabeledList:
+		result := make([]interface{}, len(e.Items))
+		for i, item := range e.Items {
+			result[i] = item
+		}
+		return result
+	case LabeledListItem:
+		return []interface{}{e.Term, e.Elements}
+	case ContinuedListItemElement:
+		return []interface{}{e.Element}
+	case Table:
+		result := make([]interface{}, 0, len(e.Lines)+1)
+		if len(e.Header.Cells) > 0 {
+			result = append(result, e.Header)
+		}
+		for _, l := range e.Lines {
+			result = append(result, l)
+		}
+		return result
+	case TableLine:
+		result := make([]interface{}, len(e.Cells))
+		for i, c := range e.Cells {
+			result[i] = c
+		}
+		return result
+	case QuotedText:
+		return e.Elements
+	case Passthrough:
+		return e.Elements
+	case Footnote:
+		return e.Elements
+	case IndexTerm:
+		return e.Term
+	case ExternalCrossReference:
+		return e.Label
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// FindAll returns all the elements in the given element (including the element itself) that match the given predicate
+func FindAll(element interface{}, match func(element interface{}) bool) []interface{} {
+	result := []interface{}{}
+	_ = Walk(element, Visitor{
+		Enter: func(e interface{}, _ Ancestors) error {
+			if match(e) {
+				result = append(result, e)
+			}
+			return nil
+		},
+	})
+	return result
+}
+
+// FindAllOfType returns all the elements which have the same type as the given sample (eg: `types.Paragraph{}`)
+func FindAllOfType(element interface{}, sample interface{}) []interface{} {
+	t := reflect.TypeOf(sample)
+	return FindAll(element, func(e interface{}) bool {
+		return reflect.TypeOf(e) == t
+	})
+}
+
+// FindAllWithRole returns all the elements which have the given role
+func FindAllWithRole(element interface{}, role string) []interface{} {
+	return FindAll(element, func(e interface{}) bool {
+		attrs, ok := AttributesOf(e)
+		return ok && attrs.GetAsString(AttrRole) == role
+	})
+}
+
+// FindByID returns the first element with the given ID, or `false` if no such element exists
+func FindByID(element interface{}, id string) (interface{}, bool) {
+	var result interface{}
+	err := Walk(element, Visitor{
+		Enter: func(e interface{}, _ Ancestors) error {
+			if attrs, ok := AttributesOf(e); ok && attrs.GetAsString(AttrID) == id {
+				result = e
+				return errElementFound // stop the walk
+			}
+			return nil
+		},
+	})
+	return result, err == errElementFound
+}
+
+var errElementFound = errors.New("element found")
+
+// FindLinks returns all the links
+func FindLinks(element interface{}) []InlineLink {
+	result := []InlineLink{}
+	for _, e := range FindAllOfType(element, InlineLink{}) {
+		result = append(result, e.(InlineLink))
+	}
+	return result
+}
+
+// FindImages returns all the images, ie, the `ImageBlock` and `InlineImage` elements
+func FindImages(element interface{}) []interface{} {
+	return FindAll(element, func(e interface{}) bool {
+		switch e.(type) {
+		case ImageBlock, InlineImage:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+// FindSections returns all the sections whose level is lower than or equal to the given level
+func FindSections(element interface{}, maxLevel int) []Section {
+	result := []Section{}
+	for _, e := range FindAllOfType(element, Section{}) {
+		if s := e.(Section); s.Level <= maxLevel {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// AttributesOf returns the attributes of the given element, or `false` if the element has no attributes
+// nolint: gocyclo
+func AttributesOf(element interface{}) (ElementAttributes, bool) {
+	switch e := element.(type) {
+	case Section:
+		return e.Attributes, true
+	case Paragraph:
+		return e.Attributes, true
+	case DelimitedBlock:
+		return e.Attributes, true
+	case LiteralBlock:
+		return e.Attributes, true
+	case Table:
+		return e.Attributes, true
+	case OrderedList:
+		return e.Attributes, true
+	case OrderedListItem:
+		return e.Attributes, true
+	case UnorderedList:
+		return e.Attributes, true
+	case UnorderedListItem:
+		return e.Attributes, true
+	case LabeledList:
+		return e.Attributes, true
+	case LabeledListItem:
+		return e.Attributes, true
+	case ImageBlock:
+		return e.Attributes, true
+	case InlineImage:
+		return e.Attributes, true
+	case InlineLink:
+		return e.Attributes, true
+	case UserMacro:
+		return e.Attributes, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,189 @@
+package types_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("walker", func() {
+
+	source := `= Title
+
+== Section A
+
+[.note]
+a paragraph with a https://example.com[link] and *image:foo.png[]*
+
+* item with https://example.org
+
+=== Section A.1
+
+image::bar.png[]
+
+[#last]
+== Section B
+
+|===
+| cell with https://example.net
+|===`
+
+	It("should enter and leave elements in depth-first order", func() {
+		doc, err := ParseDocument("== Section\n\n* *item*")
+		Expect(err).ToNot(HaveOccurred())
+		events := []string{}
+		err = types.Walk(doc, types.Visitor{
+			Enter: func(element interface{}, ancestors types.Ancestors) error {
+				events = append(events, fmt.Sprintf("enter %T (depth=%d)", element, len(ancestors)))
+				return nil
+			},
+			Leave: func(element interface{}, ancestors types.Ancestors) error {
+				events = append(events, fmt.Sprintf("leave %T", element))
+				return nil
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(Equal([]string{
+			"enter types.Document (depth=0)",
+			"enter types.Section (depth=1)",
+			"enter types.StringElement (depth=2)",
+			"leave types.StringElement",
+			"enter types.UnorderedList (depth=2)",
+			"enter types.UnorderedListItem (depth=3)",
+			"enter types.Paragraph (depth=4)",
+			"enter types.QuotedText (depth=5)",
+			"enter types.StringElement (depth=6)",
+			"leave types.StringElement",
+			"leave types.QuotedText",
+			"leave types.Paragraph",
+			"leave types.UnorderedListItem",
+			"leave types.UnorderedList",
+			"leave types.Section",
+			"leave types.Document",
+		}))
+	})
+
+	It("should skip children", func() {
+		doc, err := ParseDocument(source)
+		Expect(err).ToNot(HaveOccurred())
+		images := 0
+		err = types.Walk(doc, types.Visitor{
+			Enter: func(element interface{}, _ types.Ancestors) error {
+				switch e := element.(type) {
+				case types.Section:
+					if e.Level == 2 {
+						return types.SkipChildren
+					}
+				case types.ImageBlock, types.InlineImage:
+					images++
+				}
+				return nil
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(images).To(Equal(1)) // the image block in the level 2 section was skipped
+	})
+
+	It("should stop on error", func() {
+		doc, err := ParseDocument(source)
+		Expect(err).ToNot(HaveOccurred())
+		err = types.Walk(doc, types.Visitor{
+			Leave: func(element interface{}, _ types.Ancestors) error {
+				if _, ok := element.(types.InlineLink); ok {
+					return fmt.Errorf("mock error")
+				}
+				return nil
+			},
+		})
+		Expect(err).To(MatchError("mock error"))
+	})
+
+	It("should give access to the ancestors", func() {
+		doc, err := ParseDocument(source)
+		Expect(err).ToNot(HaveOccurred())
+		parents := []string{}
+		sections := []string{}
+		err = types.Walk(doc, types.Visitor{
+			Enter: func(element interface{}, ancestors types.Ancestors) error {
+				if l, ok := element.(types.InlineLink); ok {
+					p, _ := ancestors.Parent()
+					parents = append(parents, fmt.Sprintf("%s in %T", l.Location.String(), p))
+					for i := len(ancestors) - 1; i >= 0; i-- {
+						if s, ok := ancestors[i].(types.Section); ok {
+							sections = append(sections, s.Attributes.GetAsString(types.AttrID))
+							break
+						}
+					}
+				}
+				return nil
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parents).To(Equal([]string{
+			"https://example.com in types.Paragraph",
+			"https://example.org in types.Paragraph",
+			"https://example.net in types.TableLine",
+		}))
+		Expect(sections).To(Equal([]string{"_section_a", "_section_a", "last"}))
+	})
+
+	Context("queries", func() {
+
+		var doc types.Document
+
+		BeforeEach(func() {
+			var err error
+			doc, err = ParseDocument(source)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should find all elements of a given type", func() {
+			Expect(types.FindAllOfType(doc, types.Table{})).To(HaveLen(1))
+			Expect(types.FindAllOfType(doc, types.UnorderedListItem{})).To(HaveLen(1))
+			Expect(types.FindAllOfType(doc, types.Paragraph{})).To(HaveLen(2))
+		})
+
+		It("should find all elements with a given role", func() {
+			result := types.FindAllWithRole(doc, "note")
+			Expect(result).To(HaveLen(1))
+			Expect(result[0]).To(BeAssignableToTypeOf(types.Paragraph{}))
+		})
+
+		It("should find element by ID", func() {
+			result, found := types.FindByID(doc, "last")
+			Expect(found).To(BeTrue())
+			Expect(result.(types.Section).Level).To(Equal(1))
+			_, found = types.FindByID(doc, "unknown")
+			Expect(found).To(BeFalse())
+		})
+
+		It("should find all links", func() {
+			links := types.FindLinks(doc)
+			locations := []string{}
+			for _, l := range links {
+				locations = append(locations, l.Location.String())
+			}
+			Expect(locations).To(Equal([]string{"https://example.com", "https://example.org", "https://example.net"}))
+		})
+
+		It("should find all images", func() {
+			images := types.FindImages(doc)
+			Expect(images).To(HaveLen(2))
+			Expect(images[0].(types.InlineImage).Location.String()).To(Equal("foo.png"))
+			Expect(images[1].(types.ImageBlock).Location.String()).To(Equal("bar.png"))
+		})
+
+		It("should find all sections up to a given level", func() {
+			titles := []string{}
+			for _, s := range types.FindSections(doc, 1) {
+				titles = append(titles, s.Attributes.GetAsString(types.AttrID))
+			}
+			Expect(titles).To(Equal([]string{"_title", "_section_a", "last"}))
+			Expect(types.FindSections(doc, 2)).To(HaveLen(4))
+		})
+	})
+})
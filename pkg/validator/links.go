@@ -0,0 +1,457 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// MissingFileRule the ID of the rule which checks that the local files referenced by the links exist
+	MissingFileRule = "missing-file"
+	// MissingImageRule the ID of the rule which checks that the images exist
+	MissingImageRule = "missing-image"
+	// BrokenDocumentReferenceRule the ID of the rule which checks that the documents (and the elements within) referenced
+	// by the cross references exist (eg: `xref:other.adoc#anchor[]`)
+	BrokenDocumentReferenceRule = "broken-document-reference"
+	// BrokenRemoteLinkRule the ID of the rule which checks that the remote (HTTP and HTTPS) links respond successfully
+	BrokenRemoteLinkRule = "broken-remote-link"
+)
+
+// LinkRule a rule applied by the `LinkChecker`. Unlike the rules of the registry, the link rules are not applied
+// by `Validate`, since they need the location of the document
+type LinkRule struct {
+	ID          string
+	Description string
+}
+
+// the link rules, which are all enabled unless they are turned off in the configuration
+var linkRules = []LinkRule{
+	{ID: MissingFileRule, Description: "the local files referenced by the links exist"},
+	{ID: MissingImageRule, Description: "the images exist"},
+	{ID: BrokenDocumentReferenceRule, Description: "the documents and elements referenced by the cross references exist"},
+	{ID: BrokenRemoteLinkRule, Description: "the remote links respond successfully (with the HTTP client of the checker only)"},
+}
+
+// LinkRules returns the rules applied by the `LinkChecker`
+func LinkRules() []LinkRule {
+	result := make([]LinkRule, len(linkRules))
+	copy(result, linkRules)
+	return result
+}
+
+// LookupLinkRule returns the link rule with the given ID, or `false` if no such rule exists
+func LookupLinkRule(id string) (LinkRule, bool) {
+	for _, r := range linkRules {
+		if r.ID == id {
+			return r, true
+		}
+	}
+	return LinkRule{}, false
+}
+
+const (
+	// DefaultLinkCheckConcurrency the default number of remote links checked concurrently
+	DefaultLinkCheckConcurrency = 8
+	// DefaultLinkCheckTimeout the default timeout when checking a remote link
+	DefaultLinkCheckTimeout = 10 * time.Second
+)
+
+// LinkChecker checks that the targets of the links, cross references and images of documents exist.
+// The remote links are checked only if an HTTP client was configured. The results of the remote links
+// and of the referenced documents are cached, so they are checked only once, even across multiple documents.
+type LinkChecker struct {
+	client      *http.Client
+	concurrency int
+	timeout     time.Duration
+	mu          sync.Mutex
+	// the result of the remote links which were already checked (empty if the link is valid)
+	remoteLinks map[string]string
+	// the IDs of the documents which were already parsed (nil if the document could not be parsed)
+	documents map[string]map[string]bool
+}
+
+// LinkCheckerOption an option to configure the LinkChecker
+type LinkCheckerOption func(*LinkChecker)
+
+// NewLinkChecker returns a new LinkChecker configured with the given options
+func NewLinkChecker(options ...LinkCheckerOption) *LinkChecker {
+	c := &LinkChecker{
+		concurrency: DefaultLinkCheckConcurrency,
+		timeout:     DefaultLinkCheckTimeout,
+		remoteLinks: map[string]string{},
+		documents:   map[string]map[string]bool{},
+	}
+	for _, set := range options {
+		set(c)
+	}
+	return c
+}
+
+// WithHTTPClient enables the verification of the remote links, using the given client
+func WithHTTPClient(client *http.Client) LinkCheckerOption {
+	return func(c *LinkChecker) {
+		c.client = client
+	}
+}
+
+// WithLinkCheckConcurrency sets the number of remote links checked concurrently
+func WithLinkCheckConcurrency(concurrency int) LinkCheckerOption {
+	return func(c *LinkChecker) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// WithLinkCheckTimeout sets the timeout when checking a remote link
+func WithLinkCheckTimeout(timeout time.Duration) LinkCheckerOption {
+	return func(c *LinkChecker) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// CheckFile parses the file given in the configuration and checks its links, cross references and images.
+// The problems of the rules which are turned off in the configuration are skipped, and the severities
+// set in the configuration are applied. Returns an error if the document could not be parsed
+func (c *LinkChecker) CheckFile(config configuration.Configuration) ([]Problem, error) {
+	source, err := ioutil.ReadFile(config.Filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s", config.Filename)
+	}
+	doc, err := parser.ParseDocument(bytes.NewReader(source), config)
+	if err != nil {
+		return nil, err
+	}
+	opts := newOptions(WithConfiguration(config), WithSource(config.Filename, source))
+	problems := []Problem{}
+	for _, p := range c.check(doc, config.Filename) {
+		if enabled, found := opts.enabled[p.Rule]; found && !enabled {
+			continue
+		}
+		if severity, found := opts.severities[p.Rule]; found {
+			p.Severity = severity
+		}
+		problems = append(problems, opts.locate(p))
+	}
+	return problems, nil
+}
+
+// Check checks the links, cross references and images of the given document, whose relative targets are resolved
+// from the directory of the given filename
+func (c *LinkChecker) Check(doc types.Document, filename string) []Problem {
+	opts := newOptions()
+	problems := c.check(doc, filename)
+	for i, p := range problems {
+		problems[i] = opts.locate(p)
+	}
+	return problems
+}
+
+func (c *LinkChecker) check(doc types.Document, filename string) []Problem {
+	dir := filepath.Dir(filename)
+	problems := []Problem{}
+	remoteLinks := []string{}
+	locations := occurrences{}
+	for _, element := range types.FindAll(doc, isLinkOrImage) {
+		location := targetOf(element)
+		occurrence := locations.next(location)
+		if isRemote(location) {
+			// the remote links are checked concurrently, once all the links of the document were collected
+			remoteLinks = append(remoteLinks, location)
+			continue
+		}
+		var found []Problem
+		switch element.(type) {
+		case types.ImageBlock, types.InlineImage:
+			found = c.checkImage(dir, location)
+		case types.InlineLink:
+			if isLocalFile(location) && !fileExists(dir, location) {
+				found = []Problem{
+					{
+						Rule:     MissingFileRule,
+						Severity: Error,
+						Message:  fmt.Sprintf("link to a missing file: '%s'", location),
+					},
+				}
+			}
+		case types.ExternalCrossReference, types.InternalCrossReference:
+			found = c.checkDocumentReference(dir, location)
+		}
+		pattern := targetPattern(location)
+		if isImage(element) {
+			pattern = imagePattern(doc, location)
+		}
+		for _, p := range found {
+			problems = append(problems, located(p, pattern, occurrence))
+		}
+	}
+	return append(problems, c.checkRemoteLinks(remoteLinks)...)
+}
+
+// targetOf returns the location of the given link, image or cross reference
+func targetOf(element interface{}) string {
+	switch e := element.(type) {
+	case types.ImageBlock:
+		return e.Location.String()
+	case types.InlineImage:
+		return e.Location.String()
+	case types.InlineLink:
+		return e.Location.String()
+	case types.ExternalCrossReference:
+		return e.Location.String()
+	case types.InternalCrossReference:
+		return e.ID
+	default:
+		return ""
+	}
+}
+
+func isImage(element interface{}) bool {
+	switch element.(type) {
+	case types.ImageBlock, types.InlineImage:
+		return true
+	default:
+		return false
+	}
+}
+
+func isLinkOrImage(element interface{}) bool {
+	switch e := element.(type) {
+	case types.ImageBlock, types.InlineImage, types.InlineLink, types.ExternalCrossReference:
+		return true
+	case types.InternalCrossReference:
+		// only the cross references to other documents (eg: `<<other.adoc#anchor>>`)
+		_, _, ok := e.DocumentReference()
+		return ok
+	default:
+		return false
+	}
+}
+
+var schemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+
+func isRemote(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// isLocalFile returns true if the given location is a relative path (ie, not a URL, a fragment or an absolute path)
+func isLocalFile(location string) bool {
+	return location != "" && !strings.HasPrefix(location, "#") && !strings.HasPrefix(location, "/") && !schemeRegexp.MatchString(location)
+}
+
+// splitFragment splits the given location into the path and the fragment (without the query)
+func splitFragment(location string) (string, string) {
+	fragment := ""
+	if i := strings.Index(location, "#"); i != -1 {
+		location, fragment = location[:i], location[i+1:]
+	}
+	if i := strings.Index(location, "?"); i != -1 {
+		location = location[:i]
+	}
+	return location, fragment
+}
+
+func fileExists(dir, location string) bool {
+	p, _ := splitFragment(location)
+	_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(p)))
+	return err == nil
+}
+
+func (c *LinkChecker) checkImage(dir, location string) []Problem {
+	if isLocalFile(location) && !fileExists(dir, location) {
+		return []Problem{
+			{
+				Rule:     MissingImageRule,
+				Severity: Error,
+				Message:  fmt.Sprintf("missing image: '%s'", location),
+			},
+		}
+	}
+	return nil
+}
+
+// checkDocumentReference checks that the referenced document exists, as well as the element with the ID in the fragment (if any)
+func (c *LinkChecker) checkDocumentReference(dir, location string) []Problem {
+	if !isLocalFile(location) {
+		return nil
+	}
+	p, id := splitFragment(location)
+	path := filepath.Join(dir, filepath.FromSlash(p))
+	if _, err := os.Stat(path); err != nil {
+		return []Problem{
+			{
+				Rule:     BrokenDocumentReferenceRule,
+				Severity: Error,
+				Message:  fmt.Sprintf("cross reference to a missing document: '%s'", p),
+			},
+		}
+	}
+	if id == "" {
+		return nil
+	}
+	ids, err := c.documentIDs(path)
+	if err != nil {
+		return []Problem{
+			{
+				Rule:     BrokenDocumentReferenceRule,
+				Severity: Error,
+				Message:  fmt.Sprintf("unable to parse the referenced document '%s': %v", p, err),
+			},
+		}
+	}
+	if !ids[id] {
+		return []Problem{
+			{
+				Rule:     BrokenDocumentReferenceRule,
+				Severity: Error,
+				Message:  fmt.Sprintf("cross reference to an unknown element in '%s': '%s'", p, id),
+			},
+		}
+	}
+	return nil
+}
+
+// documentIDs returns the IDs of the elements of the document at the given path.
+// The document is parsed outside of the lock, and the result is cached
+func (c *LinkChecker) documentIDs(path string) (map[string]bool, error) {
+	c.mu.Lock()
+	ids, found := c.documents[path]
+	c.mu.Unlock()
+	if !found {
+		ids = parseDocumentIDs(path)
+		c.mu.Lock()
+		c.documents[path] = ids
+		c.mu.Unlock()
+	}
+	if ids == nil {
+		return nil, errors.New("invalid document")
+	}
+	return ids, nil
+}
+
+// parseDocumentIDs parses the document at the given path and returns the IDs of its elements,
+// or nil if the document could not be parsed
+func parseDocumentIDs(path string) map[string]bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	doc, err := parser.ParseDocument(f, configuration.NewConfiguration(configuration.WithFilename(path)))
+	if err != nil {
+		log.WithError(err).Debugf("unable to parse '%s'", path)
+		return nil
+	}
+	ids := map[string]bool{}
+	for id := range doc.ElementReferences {
+		ids[id] = true
+	}
+	for _, id := range elementIDs(&doc) {
+		ids[id] = true
+	}
+	return ids
+}
+
+// checkRemoteLinks checks the given remote links concurrently (unless they were already checked),
+// or does nothing if no HTTP client was configured
+func (c *LinkChecker) checkRemoteLinks(links []string) []Problem {
+	if c.client == nil || len(links) == 0 {
+		return nil
+	}
+	// check each link once
+	unique := []string{}
+	seen := map[string]bool{}
+	for _, l := range links {
+		if !seen[l] {
+			seen[l] = true
+			unique = append(unique, l)
+		}
+	}
+	results := make([]string, len(unique))
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < c.concurrency && w < len(unique); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = c.checkRemoteLink(unique[i])
+			}
+		}()
+	}
+	for i := range unique {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	problems := []Problem{}
+	for i, r := range results {
+		if r != "" {
+			problems = append(problems, located(Problem{
+				Rule:     BrokenRemoteLinkRule,
+				Severity: Warning,
+				Message:  r,
+			}, targetPattern(unique[i]), 0))
+		}
+	}
+	return problems
+}
+
+// checkRemoteLink returns a message if the given link does not respond successfully, or an empty string otherwise
+func (c *LinkChecker) checkRemoteLink(link string) string {
+	c.mu.Lock()
+	result, found := c.remoteLinks[link]
+	c.mu.Unlock()
+	if found {
+		return result
+	}
+	log.Debugf("checking remote link '%s'", link)
+	status, err := c.request(http.MethodHead, link)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		// some servers do not support the `HEAD` method
+		status, err = c.request(http.MethodGet, link)
+	}
+	switch {
+	case err != nil:
+		result = fmt.Sprintf("unable to reach remote link '%s': %v", link, err)
+	case status >= 400:
+		result = fmt.Sprintf("remote link '%s' responded with status %d", link, status)
+	}
+	c.mu.Lock()
+	c.remoteLinks[link] = result
+	c.mu.Unlock()
+	return result
+}
+
+func (c *LinkChecker) request(method, link string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
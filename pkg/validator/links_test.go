@@ -0,0 +1,303 @@
+package validator_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("link checker", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-links")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) string {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		Expect(os.MkdirAll(filepath.Dir(p), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+		return p
+	}
+
+	check := func(checker *validator.LinkChecker, filename string, settings ...configuration.Setting) []validator.Problem {
+		problems, err := checker.CheckFile(configuration.NewConfiguration(append(settings, configuration.WithFilename(filename))...))
+		Expect(err).ToNot(HaveOccurred())
+		return problems
+	}
+
+	Context("local files", func() {
+
+		It("should not report existing files and images", func() {
+			writeFile("files/setup.sh", "echo")
+			writeFile("images/logo.png", "PNG")
+			doc := writeFile("doc.adoc", `:imagesdir: images
+
+see link:files/setup.sh[the script] and link:files/setup.sh#L2[line 2]
+
+image::logo.png[]
+
+an image:logo.png[] and a https://example.com[remote link]`)
+			Expect(check(validator.NewLinkChecker(), doc)).To(BeEmpty())
+		})
+
+		It("should report missing files and images", func() {
+			doc := writeFile("doc.adoc", `:imagesdir: images
+
+see link:files/setup.sh[the script]
+
+image::logo.png[]
+
+an image:icon.png[]`)
+			Expect(check(validator.NewLinkChecker(), doc)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.MissingFileRule,
+					Severity: validator.Error,
+					Message:  "link to a missing file: 'files/setup.sh'",
+					Position: types.Position{Filename: doc, Line: 3, Column: 5},
+				},
+				{
+					Rule:     validator.MissingImageRule,
+					Severity: validator.Error,
+					Message:  "missing image: 'images/logo.png'",
+					Position: types.Position{Filename: doc, Line: 5, Column: 1},
+				},
+				{
+					Rule:     validator.MissingImageRule,
+					Severity: validator.Error,
+					Message:  "missing image: 'images/icon.png'",
+					Position: types.Position{Filename: doc, Line: 7, Column: 4},
+				},
+			}))
+		})
+	})
+
+	Context("rules", func() {
+
+		It("should list link rules apart from the validation rules", func() {
+			for _, id := range []string{
+				validator.MissingFileRule,
+				validator.MissingImageRule,
+				validator.BrokenDocumentReferenceRule,
+				validator.BrokenRemoteLinkRule,
+			} {
+				r, found := validator.LookupLinkRule(id)
+				Expect(found).To(BeTrue(), id)
+				Expect(r.ID).To(Equal(id))
+				// not applied by `Validate`
+				_, found = validator.LookupRule(id)
+				Expect(found).To(BeFalse(), id)
+			}
+			Expect(validator.LinkRules()).To(HaveLen(4))
+			_, found := validator.LookupLinkRule(validator.SourceLanguageRule)
+			Expect(found).To(BeFalse())
+		})
+
+		It("should apply the state of the rules in the configuration", func() {
+			doc := writeFile("doc.adoc", `see link:files/setup.sh[the script]
+
+image::logo.png[]`)
+			Expect(check(validator.NewLinkChecker(), doc,
+				configuration.WithValidationRule(validator.MissingFileRule, configuration.RuleWarning),
+				configuration.WithValidationRule(validator.MissingImageRule, configuration.RuleOff))).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.MissingFileRule,
+					Severity: validator.Warning,
+					Message:  "link to a missing file: 'files/setup.sh'",
+					Position: types.Position{Filename: doc, Line: 1, Column: 5},
+				},
+			}))
+		})
+	})
+
+	Context("other documents", func() {
+
+		It("should not report existing documents and elements", func() {
+			writeFile("guides/install.adoc", `== Linux
+
+[#macos]
+a paragraph`)
+			doc := writeFile("doc.adoc", `see xref:guides/install.adoc[], xref:guides/install.adoc#_linux[Linux] and <<guides/install.adoc#macos,macOS>>`)
+			Expect(check(validator.NewLinkChecker(), doc)).To(BeEmpty())
+		})
+
+		It("should report missing documents and elements", func() {
+			writeFile("guides/install.adoc", `== Linux`)
+			doc := writeFile("doc.adoc", `see xref:guides/upgrade.adoc[], xref:guides/install.adoc#_windows[Windows] and <<guides/install.adoc#macos,macOS>>`)
+			Expect(check(validator.NewLinkChecker(), doc)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.BrokenDocumentReferenceRule,
+					Severity: validator.Error,
+					Message:  "cross reference to a missing document: 'guides/upgrade.adoc'",
+					Position: types.Position{Filename: doc, Line: 1, Column: 5},
+				},
+				{
+					Rule:     validator.BrokenDocumentReferenceRule,
+					Severity: validator.Error,
+					Message:  "cross reference to an unknown element in 'guides/install.adoc': '_windows'",
+					Position: types.Position{Filename: doc, Line: 1, Column: 33},
+				},
+				{
+					Rule:     validator.BrokenDocumentReferenceRule,
+					Severity: validator.Error,
+					Message:  "cross reference to an unknown element in 'guides/install.adoc': 'macos'",
+					Position: types.Position{Filename: doc, Line: 1, Column: 80},
+				},
+			}))
+		})
+
+		It("should check the cross references to documents with all extensions and with or without fragment", func() {
+			writeFile("guides/install.asciidoc", `== Linux`)
+			writeFile("guides/upgrade.asc", `== Linux`)
+			doc := writeFile("doc.adoc", `see <<guides/install.asciidoc>>, <<guides/upgrade.asc#_linux>>, <<guides/setup.ad>> and <<guides/faq.adoc>>`)
+			Expect(check(validator.NewLinkChecker(), doc)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.BrokenDocumentReferenceRule,
+					Severity: validator.Error,
+					Message:  "cross reference to a missing document: 'guides/setup.ad'",
+					Position: types.Position{Filename: doc, Line: 1, Column: 65},
+				},
+				{
+					Rule:     validator.BrokenDocumentReferenceRule,
+					Severity: validator.Error,
+					Message:  "cross reference to a missing document: 'guides/faq.adoc'",
+					Position: types.Position{Filename: doc, Line: 1, Column: 89},
+				},
+			}))
+		})
+	})
+
+	Context("remote links", func() {
+
+		var server *httptest.Server
+		var mu sync.Mutex
+		var requests map[string]int
+		var inflight, maxInflight int
+
+		BeforeEach(func() {
+			requests = map[string]int{}
+			inflight, maxInflight = 0, 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests[r.Method+" "+r.URL.Path]++
+				inflight++
+				if inflight > maxInflight {
+					maxInflight = inflight
+				}
+				mu.Unlock()
+				defer func() {
+					mu.Lock()
+					inflight--
+					mu.Unlock()
+				}()
+				switch r.URL.Path {
+				case "/ok":
+					w.WriteHeader(http.StatusOK)
+				case "/get-only":
+					if r.Method == http.MethodHead {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+				case "/slow", "/slow/1.png", "/slow/2.png", "/slow/3.png", "/slow/4", "/slow/5.adoc":
+					time.Sleep(200 * time.Millisecond)
+					w.WriteHeader(http.StatusOK)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should not check remote links by default", func() {
+			doc := writeFile("doc.adoc", "a {server}/missing[link]")
+			Expect(check(validator.NewLinkChecker(), doc, configuration.WithAttribute("server", server.URL))).To(BeEmpty())
+			Expect(requests).To(BeEmpty())
+		})
+
+		It("should report broken remote links", func() {
+			doc := writeFile("doc.adoc", `{server}/ok[], {server}/get-only[], {server}/missing[] and image:{server}/missing.png[]`)
+			checker := validator.NewLinkChecker(
+				validator.WithHTTPClient(server.Client()),
+				validator.WithLinkCheckConcurrency(2))
+			Expect(check(checker, doc, configuration.WithAttribute("server", server.URL))).To(ConsistOf(
+				validator.Problem{
+					Rule:     validator.BrokenRemoteLinkRule,
+					Severity: validator.Warning,
+					Message:  "remote link '" + server.URL + "/missing' responded with status 404",
+					// the location of the link is not found in the source, since it is based on an attribute
+					Position: types.Position{Filename: doc},
+				},
+				validator.Problem{
+					Rule:     validator.BrokenRemoteLinkRule,
+					Severity: validator.Warning,
+					Message:  "remote link '" + server.URL + "/missing.png' responded with status 404",
+					Position: types.Position{Filename: doc},
+				},
+			))
+			Expect(requests).To(Equal(map[string]int{
+				"HEAD /ok":          1,
+				"HEAD /get-only":    1,
+				"GET /get-only":     1,
+				"HEAD /missing":     1,
+				"HEAD /missing.png": 1,
+			}))
+		})
+
+		It("should check remote images and links concurrently", func() {
+			doc := writeFile("doc.adoc", `image::{server}/slow/1.png[]
+
+image::{server}/slow/2.png[]
+
+image:{server}/slow/3.png[] and {server}/slow/4[] and xref:{server}/slow/5.adoc[]`)
+			checker := validator.NewLinkChecker(
+				validator.WithHTTPClient(server.Client()),
+				validator.WithLinkCheckConcurrency(2))
+			Expect(check(checker, doc, configuration.WithAttribute("server", server.URL))).To(BeEmpty())
+			Expect(requests).To(HaveLen(5))
+			Expect(maxInflight).To(Equal(2))
+		})
+
+		It("should check remote links only once", func() {
+			doc := writeFile("doc.adoc", `{server}/missing[] and {server}/missing[again]`)
+			checker := validator.NewLinkChecker(validator.WithHTTPClient(server.Client()))
+			Expect(check(checker, doc, configuration.WithAttribute("server", server.URL))).To(HaveLen(1))
+			// check again, with the cache
+			Expect(check(checker, doc, configuration.WithAttribute("server", server.URL))).To(HaveLen(1))
+			Expect(requests).To(Equal(map[string]int{
+				"HEAD /missing": 1,
+			}))
+		})
+
+		It("should report timeouts", func() {
+			doc := writeFile("doc.adoc", `{server}/slow[]`)
+			checker := validator.NewLinkChecker(
+				validator.WithHTTPClient(server.Client()),
+				validator.WithLinkCheckTimeout(20*time.Millisecond))
+			problems := check(checker, doc, configuration.WithAttribute("server", server.URL))
+			Expect(problems).To(HaveLen(1))
+			Expect(problems[0].Message).To(HavePrefix("unable to reach remote link '" + server.URL + "/slow'"))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// WithSource sets the filename and the source of the validated document, so that the problems are reported
+// with their position in the source
+func WithSource(filename string, source []byte) Option {
+	return func(opts *options) {
+		opts.positions = &positions{
+			filename: filename,
+			source:   string(source),
+		}
+	}
+}
+
+// positions locates the problems in the source of a document. Since the elements of the AST have no position,
+// the problems are located by searching the syntax of the element which caused them (eg: `<<id>>` for a broken
+// cross reference), and by counting its occurrences in the document order
+type positions struct {
+	filename string
+	source   string
+}
+
+// locate returns the position of the given occurrence (starting at 0) of the given pattern in the source,
+// or a position with the filename only if it was not found (eg: because the element is in an included file)
+func (p *positions) locate(pattern *regexp.Regexp, occurrence int) types.Position {
+	position := types.Position{Filename: p.filename}
+	if pattern == nil {
+		return position
+	}
+	matches := pattern.FindAllStringIndex(p.source, occurrence+1)
+	if len(matches) <= occurrence {
+		return position
+	}
+	index := matches[occurrence][0]
+	lineStart := strings.LastIndex(p.source[:index], "\n") + 1
+	position.Line = strings.Count(p.source[:index], "\n") + 1
+	position.Column = utf8.RuneCountInString(p.source[lineStart:index]) + 1
+	return position
+}
+
+// located sets the pattern and the occurrence with which the given problem is located in the source
+func located(p Problem, pattern *regexp.Regexp, occurrence int) Problem {
+	p.pattern = pattern
+	p.occurrence = occurrence
+	return p
+}
+
+// occurrences counts the occurrences of the elements with the same key (eg: the same ID), in the document order
+type occurrences map[string]int
+
+// next returns the number of previous occurrences of the given key, and counts a new one
+func (o occurrences) next(key string) int {
+	n := o[key]
+	o[key] = n + 1
+	return n
+}
+
+// crossReferencePattern matches the `<<id>>` and `xref:id[]` cross references to the given ID
+func crossReferencePattern(id string) *regexp.Regexp {
+	return regexp.MustCompile(`<<` + regexp.QuoteMeta(id) + `[,>]|xref:` + regexp.QuoteMeta(id) + `\[`)
+}
+
+// idPattern matches the `[[id]]`, `[#id]` and `id=id` declarations of the given ID
+func idPattern(id string) *regexp.Regexp {
+	return regexp.MustCompile(`\[\[` + regexp.QuoteMeta(id) + `[\],]|\[#` + regexp.QuoteMeta(id) + `[\].%,]|\bid="?` + regexp.QuoteMeta(id) + `"?[\],]`)
+}
+
+// sectionPattern matches the title line of the sections with the given title
+func sectionPattern(title string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^=+[ \t]+` + regexp.QuoteMeta(title) + `[ \t]*$`)
+}
+
+// imagePattern matches the image macros with the given location, which may be prefixed with
+// the `imagesdir` attribute of the document
+func imagePattern(doc types.Document, location string) *regexp.Regexp {
+	prefix := ""
+	if imagesdir, found := doc.Attributes.GetAsString("imagesdir"); found && strings.HasPrefix(location, imagesdir+"/") {
+		prefix = `(?:` + regexp.QuoteMeta(imagesdir+"/") + `)?`
+		location = strings.TrimPrefix(location, imagesdir+"/")
+	}
+	return regexp.MustCompile(`image::?` + prefix + regexp.QuoteMeta(location) + `\[`)
+}
+
+// targetPattern matches the given target of a link or a cross reference, including its `link:`, `xref:` or `<<` prefix (if any)
+func targetPattern(target string) *regexp.Regexp {
+	return regexp.MustCompile(`(?:link:|xref:|<<)?` + regexp.QuoteMeta(target))
+}
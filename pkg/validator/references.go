@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+const (
+	// BrokenCrossReferenceRule the ID of the rule which checks that the targets of the cross references exist
+	BrokenCrossReferenceRule = "broken-cross-reference"
+	// DuplicateIDRule the ID of the rule which checks that the element IDs are unique
+	DuplicateIDRule = "duplicate-id"
+	// SectionLevelRule the ID of the rule which checks that the sections do not skip levels
+	SectionLevelRule = "section-level"
+)
+
+// validateCrossReferences checks that the targets of the `<<id>>` cross references exist in the document.
+// The cross references to other documents (eg: `xref:other.adoc#id[]` or `<<other.asciidoc>>`) are ignored.
+func validateCrossReferences(doc *types.Document) []Problem {
+	ids := map[string]bool{}
+	for id := range doc.ElementReferences {
+		ids[id] = true
+	}
+	for _, id := range elementIDs(doc) {
+		ids[id] = true
+	}
+	problems := []Problem{}
+	xrefs := occurrences{}
+	for _, xref := range types.FindAllOfType(*doc, types.InternalCrossReference{}) {
+		id := xref.(types.InternalCrossReference).ID
+		occurrence := xrefs.next(id)
+		if _, _, ok := xref.(types.InternalCrossReference).DocumentReference(); ids[id] || ok {
+			continue
+		}
+		problems = append(problems, located(Problem{
+			Rule:     BrokenCrossReferenceRule,
+			Severity: Warning,
+			Message:  fmt.Sprintf("cross reference to an unknown element: '%s'", id),
+		}, crossReferencePattern(id), occurrence))
+	}
+	return problems
+}
+
+// validateIDs checks that the element IDs are unique. Since the sections with the same ID are renamed while the document
+// is parsed (eg: `_examples` and `_examples_2` for two sections titled "Examples"), the renamed sections are reported too.
+func validateIDs(doc *types.Document) []Problem {
+	problems := []Problem{}
+	titles := occurrences{}
+	types.Walk(*doc, types.Visitor{ // nolint: errcheck
+		Enter: func(element interface{}, _ types.Ancestors) error {
+			s, ok := element.(types.Section)
+			if !ok {
+				return nil
+			}
+			title := plainText(s.Title)
+			occurrence := titles.next(title)
+			if original := s.Attributes.GetAsString(types.AttrOriginalID); original != "" {
+				problems = append(problems, located(Problem{
+					Rule:     DuplicateIDRule,
+					Severity: Warning,
+					Message:  fmt.Sprintf("duplicate ID: '%s' (section '%s' was assigned the ID '%s')", original, title, s.Attributes.GetAsString(types.AttrID)),
+				}, sectionPattern(title), occurrence))
+			}
+			return nil
+		},
+	})
+	// other elements with the same ID as a section, or as another element
+	seen := occurrences{}
+	reported := map[string]bool{}
+	for _, id := range elementIDs(doc) {
+		if occurrence := seen.next(id); occurrence > 0 && !reported[id] {
+			reported[id] = true
+			problems = append(problems, located(Problem{
+				Rule:     DuplicateIDRule,
+				Severity: Warning,
+				Message:  fmt.Sprintf("duplicate ID: '%s'", id),
+			}, idPattern(id), occurrence))
+		}
+	}
+	return problems
+}
+
+// elementIDs returns the IDs of all the elements of the document, in the document order
+func elementIDs(doc *types.Document) []string {
+	ids := []string{}
+	types.Walk(*doc, types.Visitor{ // nolint: errcheck
+		Enter: func(element interface{}, _ types.Ancestors) error {
+			if attrs, ok := types.AttributesOf(element); ok {
+				if id := attrs.GetAsString(types.AttrID); id != "" {
+					ids = append(ids, id)
+				}
+			}
+			return nil
+		},
+	})
+	return ids
+}
+
+// validateSectionLevels checks that the sections are exactly one level below their parent section
+// (or at level 1 at the top of the document), eg: a level 1 section cannot be directly followed by a level 3 section
+func validateSectionLevels(doc *types.Document) []Problem {
+	problems := []Problem{}
+	titles := occurrences{}
+	types.Walk(*doc, types.Visitor{ // nolint: errcheck
+		Enter: func(element interface{}, ancestors types.Ancestors) error {
+			s, ok := element.(types.Section)
+			if !ok {
+				return nil
+			}
+			title := plainText(s.Title)
+			occurrence := titles.next(title)
+			if s.Level == 0 {
+				return nil
+			}
+			expected := 1
+			for i := len(ancestors) - 1; i >= 0; i-- {
+				if parent, ok := ancestors[i].(types.Section); ok {
+					expected = parent.Level + 1
+					break
+				}
+			}
+			if s.Level > expected {
+				problems = append(problems, located(Problem{
+					Rule:     SectionLevelRule,
+					Severity: Warning,
+					Message:  fmt.Sprintf("section '%s' is at level %d, but expected level %d", title, s.Level, expected),
+				}, sectionPattern(title), occurrence))
+			}
+			return nil
+		},
+	})
+	return problems
+}
+
+// plainText returns the content of the string elements in the given elements (eg: a section title)
+func plainText(elements []interface{}) string {
+	result := strings.Builder{}
+	for _, e := range types.FindAllOfType(elements, types.StringElement{}) {
+		result.WriteString(e.(types.StringElement).Content)
+	}
+	return result.String()
+}
@@ -0,0 +1,256 @@
+package validator_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("reference validation rules", func() {
+
+	validate := func(source string, options ...validator.Option) []validator.Problem {
+		doc, err := ParseDocument(source)
+		Expect(err).ToNot(HaveOccurred())
+		// the reference rules are disabled by default
+		options = append([]validator.Option{
+			validator.EnableRules(validator.BrokenCrossReferenceRule, validator.DuplicateIDRule, validator.SectionLevelRule),
+		}, options...)
+		return validator.Validate(&doc, options...)
+	}
+
+	Context("cross references", func() {
+
+		It("should not report valid cross references", func() {
+			source := `== Section A
+
+see <<_section_b>> and <<custom>> and <<other.adoc#,other doc>>
+
+[#custom]
+a paragraph
+
+== Section B`
+			Expect(validate(source)).To(BeEmpty())
+		})
+
+		It("should not report cross references to other documents", func() {
+			source := `see <<other.adoc>>, <<other.asciidoc#a>>, <<other.asc>> and <<other.ad#b,B>>`
+			Expect(validate(source)).To(BeEmpty())
+		})
+
+		It("should report broken cross reference", func() {
+			source := `== Section A
+
+see <<unknown>>`
+			Expect(validate(source)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.BrokenCrossReferenceRule,
+					Severity: validator.Warning,
+					Message:  "cross reference to an unknown element: 'unknown'",
+				},
+			}))
+		})
+	})
+
+	Context("positions", func() {
+
+		It("should report the position of the problems in the source", func() {
+			source := `== Section A
+
+see <<unknown>> and <<unknown,again>>
+
+[#custom]
+a paragraph
+
+[#custom]
+another paragraph
+
+=== Examples
+
+===== Skipped level
+
+=== Examples`
+			Expect(validate(source, validator.WithSource("doc.adoc", []byte(source)))).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.BrokenCrossReferenceRule,
+					Severity: validator.Warning,
+					Message:  "cross reference to an unknown element: 'unknown'",
+					Position: types.Position{Filename: "doc.adoc", Line: 3, Column: 5},
+				},
+				{
+					Rule:     validator.BrokenCrossReferenceRule,
+					Severity: validator.Warning,
+					Message:  "cross reference to an unknown element: 'unknown'",
+					Position: types.Position{Filename: "doc.adoc", Line: 3, Column: 21},
+				},
+				{
+					Rule:     validator.DuplicateIDRule,
+					Severity: validator.Warning,
+					Message:  "duplicate ID: '_examples' (section 'Examples' was assigned the ID '_examples_2')",
+					Position: types.Position{Filename: "doc.adoc", Line: 15, Column: 1},
+				},
+				{
+					Rule:     validator.DuplicateIDRule,
+					Severity: validator.Warning,
+					Message:  "duplicate ID: 'custom'",
+					Position: types.Position{Filename: "doc.adoc", Line: 8, Column: 1},
+				},
+				{
+					Rule:     validator.SectionLevelRule,
+					Severity: validator.Warning,
+					Message:  "section 'Skipped level' is at level 4, but expected level 3",
+					Position: types.Position{Filename: "doc.adoc", Line: 13, Column: 1},
+				},
+			}))
+		})
+	})
+
+	Context("duplicate IDs", func() {
+
+		It("should report sections with same generated ID", func() {
+			source := `== Examples
+
+== Examples
+
+== Examples 2`
+			Expect(validate(source)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.DuplicateIDRule,
+					Severity: validator.Warning,
+					Message:  "duplicate ID: '_examples' (section 'Examples' was assigned the ID '_examples_2')",
+				},
+				{
+					Rule:     validator.DuplicateIDRule,
+					Severity: validator.Warning,
+					Message:  "duplicate ID: '_examples_2' (section 'Examples 2' was assigned the ID '_examples_2_2')",
+				},
+			}))
+		})
+
+		It("should report sections with same custom ID", func() {
+			source := `[#intro]
+== Introduction
+
+[#intro]
+== Overview`
+			Expect(validate(source)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.DuplicateIDRule,
+					Severity: validator.Warning,
+					Message:  "duplicate ID: 'intro' (section 'Overview' was assigned the ID 'intro_2')",
+				},
+			}))
+		})
+
+		It("should not report sections whose ID ends with a number", func() {
+			source := `== Step
+
+== Step 2
+
+[#install]
+== Install
+
+[#install_2]
+== Install again`
+			Expect(validate(source)).To(BeEmpty())
+		})
+
+		It("should report elements with same ID", func() {
+			source := `== Section
+
+[#foo]
+a paragraph
+
+[#foo]
+another paragraph`
+			Expect(validate(source)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.DuplicateIDRule,
+					Severity: validator.Warning,
+					Message:  "duplicate ID: 'foo'",
+				},
+			}))
+		})
+	})
+
+	Context("section levels", func() {
+
+		It("should report skipped levels", func() {
+			source := `= Title
+
+== Section A
+
+==== Section A.1.1
+
+== Section B
+
+=== Section B.1`
+			Expect(validate(source)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.SectionLevelRule,
+					Severity: validator.Warning,
+					Message:  "section 'Section A.1.1' is at level 3, but expected level 2",
+				},
+			}))
+		})
+
+		It("should report skipped level at top of document", func() {
+			source := `=== Section`
+			Expect(validate(source)).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.SectionLevelRule,
+					Severity: validator.Warning,
+					Message:  "section 'Section' is at level 2, but expected level 1",
+				},
+			}))
+		})
+	})
+
+	Context("configuration", func() {
+
+		source := `=== Section
+
+see <<unknown>>`
+
+		It("should disable rules", func() {
+			Expect(validate(source, validator.DisableRules(validator.SectionLevelRule))).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.BrokenCrossReferenceRule,
+					Severity: validator.Warning,
+					Message:  "cross reference to an unknown element: 'unknown'",
+				},
+			}))
+		})
+
+		It("should override severity", func() {
+			Expect(validate(source,
+				validator.DisableRules(validator.SectionLevelRule),
+				validator.WithSeverity(validator.BrokenCrossReferenceRule, validator.Error))).To(Equal([]validator.Problem{
+				{
+					Rule:     validator.BrokenCrossReferenceRule,
+					Severity: validator.Error,
+					Message:  "cross reference to an unknown element: 'unknown'",
+				},
+			}))
+		})
+
+		It("should not apply reference rules by default", func() {
+			doc, err := ParseDocument(source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validator.Validate(&doc)).To(BeEmpty())
+		})
+
+		It("should always check manpage structure", func() {
+			doc, err := ParseDocument(`= foo(1)
+:doctype: manpage
+
+content`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validator.Validate(&doc, validator.DisableRules(validator.ManpageStructureRule))).To(BeEmpty())
+			// document is rendered as an article
+			Expect(doc.Attributes.GetAsStringWithDefault("doctype", "")).To(Equal("article"))
+		})
+	})
+})
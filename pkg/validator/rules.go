@@ -0,0 +1,124 @@
+package validator
+
+import (
+	"sync"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// Rule a validation rule, which checks a document and reports the problems it found
+type Rule interface {
+	// ID returns the unique ID of the rule (eg: `broken-cross-reference`)
+	ID() string
+	// Description returns a short description of what the rule checks
+	Description() string
+	// Check checks the given document and returns the problems found. The `Rule` field of the problems
+	// can be left empty, in which case it is set with the ID of the rule
+	Check(doc *types.Document) []Problem
+}
+
+// NewRule returns a new rule with the given ID, description and check function
+func NewRule(id, description string, check func(doc *types.Document) []Problem) Rule {
+	return funcRule{
+		id:          id,
+		description: description,
+		check:       check,
+	}
+}
+
+type funcRule struct {
+	id          string
+	description string
+	check       func(doc *types.Document) []Problem
+}
+
+func (r funcRule) ID() string {
+	return r.id
+}
+
+func (r funcRule) Description() string {
+	return r.description
+}
+
+func (r funcRule) Check(doc *types.Document) []Problem {
+	return r.check(doc)
+}
+
+// RegisteredRule a rule in the registry
+type RegisteredRule struct {
+	Rule Rule
+	// EnabledByDefault true if the rule is applied unless it is disabled in the validation options
+	EnabledByDefault bool
+}
+
+// the registry of rules, in the order in which they are applied
+var registry = struct {
+	sync.RWMutex
+	rules []RegisteredRule
+}{}
+
+// Register adds the given rule to the registry, so it is applied by `Validate` (if it is enabled by default or in the
+// validation options). Returns an error if a rule with the same ID is already registered
+func Register(rule Rule, enabledByDefault bool) error {
+	registry.Lock()
+	defer registry.Unlock()
+	for _, r := range registry.rules {
+		if r.Rule.ID() == rule.ID() {
+			return errors.Errorf("a rule with ID '%s' is already registered", rule.ID())
+		}
+	}
+	registry.rules = append(registry.rules, RegisteredRule{
+		Rule:             rule,
+		EnabledByDefault: enabledByDefault,
+	})
+	return nil
+}
+
+// RegisteredRules returns the built-in and custom rules of the registry, in the order in which they are applied
+func RegisteredRules() []RegisteredRule {
+	registry.RLock()
+	defer registry.RUnlock()
+	result := make([]RegisteredRule, len(registry.rules))
+	copy(result, registry.rules)
+	return result
+}
+
+// LookupRule returns the registered rule with the given ID, or `false` if no such rule exists
+func LookupRule(id string) (RegisteredRule, bool) {
+	for _, r := range RegisteredRules() {
+		if r.Rule.ID() == id {
+			return r, true
+		}
+	}
+	return RegisteredRule{}, false
+}
+
+// ManpageStructureRule the ID of the rule which checks the structure of the `manpage` documents
+const ManpageStructureRule = "manpage-structure"
+
+// registers the built-in rules
+func init() {
+	for _, r := range []struct {
+		rule    Rule
+		enabled bool
+	}{
+		// structure
+		{rule: NewRule(ManpageStructureRule, "the manpage documents have a header, a 'Name' section with a single paragraph and a 'Synopsis' section", validateManpageStructure), enabled: true},
+		// references (enabled by default in the `lint` command only)
+		{rule: NewRule(BrokenCrossReferenceRule, "the targets of the cross references exist", validateCrossReferences)},
+		{rule: NewRule(DuplicateIDRule, "the element IDs are unique", validateIDs)},
+		{rule: NewRule(SectionLevelRule, "the sections do not skip levels", validateSectionLevels)},
+		// style
+		{rule: NewRule(SourceLanguageRule, "the source blocks declare a language", validateSourceLanguages)},
+		{rule: NewRule(ImageAltTextRule, "the images have an alt text", validateImageAltTexts)},
+		{rule: NewRule(NestedAdmonitionRule, "the admonitions are not nested", validateNestedAdmonitions)},
+		{rule: NewRule(SentenceCaseTitleRule, "the section titles are in sentence case", validateSentenceCaseTitles)},
+		{rule: NewRule(InsecureLinkRule, "the links use 'https://' instead of 'http://'", validateInsecureLinks)},
+	} {
+		if err := Register(r.rule, r.enabled); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package validator_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validation rules", func() {
+
+	// a custom rule which reports the paragraphs
+	paragraphRule := func(id string) validator.Rule {
+		return validator.NewRule(id, "no paragraph", func(doc *types.Document) []validator.Problem {
+			problems := []validator.Problem{}
+			for range types.FindAllOfType(*doc, types.Paragraph{}) {
+				problems = append(problems, validator.Problem{
+					Severity: validator.Warning,
+					Message:  "paragraph found",
+				})
+			}
+			return problems
+		})
+	}
+
+	It("should list built-in rules", func() {
+		r, found := validator.LookupRule(validator.ManpageStructureRule)
+		Expect(found).To(BeTrue())
+		Expect(r.EnabledByDefault).To(BeTrue())
+		r, found = validator.LookupRule(validator.BrokenCrossReferenceRule)
+		Expect(found).To(BeTrue())
+		Expect(r.EnabledByDefault).To(BeFalse())
+		r, found = validator.LookupRule(validator.InsecureLinkRule)
+		Expect(found).To(BeTrue())
+		Expect(r.EnabledByDefault).To(BeFalse())
+		_, found = validator.LookupRule("unknown")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should register custom rule", func() {
+		Expect(validator.Register(paragraphRule("test-registered-rule"), false)).To(Succeed())
+		doc, err := ParseDocument("a paragraph")
+		Expect(err).ToNot(HaveOccurred())
+		// disabled by default
+		Expect(validator.Validate(&doc)).To(BeEmpty())
+		Expect(validator.Validate(&doc, validator.EnableRules("test-registered-rule"))).To(Equal([]validator.Problem{
+			{
+				Rule:     "test-registered-rule",
+				Severity: validator.Warning,
+				Message:  "paragraph found",
+			},
+		}))
+	})
+
+	It("should not register rule twice", func() {
+		Expect(validator.Register(paragraphRule(validator.DuplicateIDRule), true)).To(MatchError("a rule with ID 'duplicate-id' is already registered"))
+	})
+
+	It("should validate with additional rule", func() {
+		doc, err := ParseDocument("a paragraph")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validator.Validate(&doc, validator.WithRules(paragraphRule("test-additional-rule")))).To(Equal([]validator.Problem{
+			{
+				Rule:     "test-additional-rule",
+				Severity: validator.Warning,
+				Message:  "paragraph found",
+			},
+		}))
+	})
+
+	It("should configure rules", func() {
+		doc, err := ParseDocument(`=== Section
+
+see <<unknown>> and http://example.com`)
+		Expect(err).ToNot(HaveOccurred())
+		config := configuration.NewConfiguration(
+			configuration.WithValidationRule(validator.SectionLevelRule, configuration.RuleOff),
+			configuration.WithValidationRule(validator.BrokenCrossReferenceRule, configuration.RuleError),
+			configuration.WithValidationRule(validator.InsecureLinkRule, configuration.RuleOn))
+		Expect(validator.Validate(&doc, validator.WithConfiguration(config))).To(Equal([]validator.Problem{
+			{
+				Rule:     validator.BrokenCrossReferenceRule,
+				Severity: validator.Error,
+				Message:  "cross reference to an unknown element: 'unknown'",
+			},
+			{
+				Rule:     validator.InsecureLinkRule,
+				Severity: validator.Warning,
+				Message:  "link with 'http://' instead of 'https://': 'http://example.com'",
+			},
+		}))
+	})
+})
@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+const (
+	// SourceLanguageRule the ID of the rule which checks that the source blocks declare a language
+	SourceLanguageRule = "source-language"
+	// ImageAltTextRule the ID of the rule which checks that the images have an alt text
+	ImageAltTextRule = "image-alt-text"
+	// NestedAdmonitionRule the ID of the rule which checks that the admonitions are not nested
+	NestedAdmonitionRule = "nested-admonition"
+	// SentenceCaseTitleRule the ID of the rule which checks that the section titles are in sentence case
+	SentenceCaseTitleRule = "sentence-case-title"
+	// InsecureLinkRule the ID of the rule which checks that the links do not use `http://`
+	InsecureLinkRule = "insecure-link"
+)
+
+// the attributes of a source block without language
+var sourceBlockPattern = regexp.MustCompile(`(?m)^\[source\][ \t]*$`)
+
+func validateSourceLanguages(doc *types.Document) []Problem {
+	problems := []Problem{}
+	for _, b := range types.FindAllOfType(*doc, types.DelimitedBlock{}) {
+		b := b.(types.DelimitedBlock)
+		if b.Kind == types.Source && b.Attributes.GetAsString(types.AttrLanguage) == "" {
+			problems = append(problems, located(Problem{
+				Rule:     SourceLanguageRule,
+				Severity: Warning,
+				Message:  "source block without language",
+			}, sourceBlockPattern, len(problems)))
+		}
+	}
+	return problems
+}
+
+// validateImageAltTexts checks that the images have an alt text, ie, an alt text which was not generated from the image filename
+func validateImageAltTexts(doc *types.Document) []Problem {
+	problems := []Problem{}
+	locations := occurrences{}
+	for _, img := range types.FindImages(*doc) {
+		var location types.Location
+		var attrs types.ElementAttributes
+		switch img := img.(type) {
+		case types.ImageBlock:
+			location, attrs = img.Location, img.Attributes
+		case types.InlineImage:
+			location, attrs = img.Location, img.Attributes
+		}
+		occurrence := locations.next(location.String())
+		if alt := attrs.GetAsString(types.AttrImageAlt); alt == "" || alt == generatedAlt(location) {
+			problems = append(problems, located(Problem{
+				Rule:     ImageAltTextRule,
+				Severity: Warning,
+				Message:  fmt.Sprintf("image without alt text: '%s'", location.String()),
+			}, imagePattern(*doc, location.String()), occurrence))
+		}
+	}
+	return problems
+}
+
+// generatedAlt returns the alt text generated from the filename of the image, when no alt text is given
+func generatedAlt(location types.Location) string {
+	filename := location.String()
+	if i := strings.LastIndex(filename, "/"); i != -1 {
+		filename = filename[i+1:]
+	}
+	if i := strings.LastIndex(filename, "."); i != -1 {
+		filename = filename[:i]
+	}
+	return filename
+}
+
+func validateNestedAdmonitions(doc *types.Document) []Problem {
+	problems := []Problem{}
+	types.Walk(*doc, types.Visitor{ // nolint: errcheck
+		Enter: func(element interface{}, ancestors types.Ancestors) error {
+			kind, ok := admonitionKind(element)
+			if !ok {
+				return nil
+			}
+			for i := len(ancestors) - 1; i >= 0; i-- {
+				if parentKind, ok := admonitionKind(ancestors[i]); ok {
+					problems = append(problems, Problem{
+						Rule:     NestedAdmonitionRule,
+						Severity: Warning,
+						Message:  fmt.Sprintf("'%s' admonition nested in a '%s' admonition", kind, parentKind),
+					})
+					break
+				}
+			}
+			return nil
+		},
+	})
+	return problems
+}
+
+// admonitionKind returns the kind of admonition of the given element, or `false` if the element is not an admonition
+func admonitionKind(element interface{}) (types.AdmonitionKind, bool) {
+	switch element.(type) {
+	case types.Paragraph, types.DelimitedBlock:
+		attrs, _ := types.AttributesOf(element)
+		kind, ok := attrs[types.AttrAdmonitionKind].(types.AdmonitionKind)
+		return kind, ok && kind != types.Unknown
+	default:
+		return types.Unknown, false
+	}
+}
+
+// validateSentenceCaseTitles checks that the section titles start with an uppercase letter, and that the other words
+// are not capitalized. Words in uppercase (eg: `API`) or with mixed case (eg: `GitHub`) and the words in quoted text
+// (eg: `+Document+`) are ignored
+func validateSentenceCaseTitles(doc *types.Document) []Problem {
+	problems := []Problem{}
+	titles := occurrences{}
+	for _, e := range types.FindAllOfType(*doc, types.Section{}) {
+		s := e.(types.Section)
+		title := plainText(s.Title)
+		occurrence := titles.next(title)
+		words := []string{}
+		for _, t := range s.Title {
+			if str, ok := t.(types.StringElement); ok {
+				words = append(words, strings.Fields(str.Content)...)
+			}
+		}
+		if !isSentenceCase(words) {
+			problems = append(problems, located(Problem{
+				Rule:     SentenceCaseTitleRule,
+				Severity: Warning,
+				Message:  fmt.Sprintf("section title is not in sentence case: '%s'", title),
+			}, sectionPattern(title), occurrence))
+		}
+	}
+	return problems
+}
+
+func isSentenceCase(words []string) bool {
+	for i, w := range words {
+		runes := []rune(w)
+		if i == 0 {
+			if unicode.IsLetter(runes[0]) && !unicode.IsUpper(runes[0]) {
+				return false
+			}
+			continue
+		}
+		if isCapitalized(runes) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCapitalized returns true if the given word starts with an uppercase letter followed by lowercase letters only (eg: `Started`)
+func isCapitalized(word []rune) bool {
+	if len(word) < 2 || !unicode.IsUpper(word[0]) {
+		return false
+	}
+	for _, r := range word[1:] {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateInsecureLinks(doc *types.Document) []Problem {
+	problems := []Problem{}
+	locations := occurrences{}
+	for _, l := range types.FindLinks(*doc) {
+		location := l.Location.String()
+		occurrence := locations.next(location)
+		if strings.HasPrefix(location, "http://") {
+			problems = append(problems, located(Problem{
+				Rule:     InsecureLinkRule,
+				Severity: Warning,
+				Message:  fmt.Sprintf("link with 'http://' instead of 'https://': '%s'", location),
+			}, targetPattern(location), occurrence))
+		}
+	}
+	return problems
+}
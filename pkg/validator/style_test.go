@@ -0,0 +1,153 @@
+package validator_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("style validation rules", func() {
+
+	validate := func(source string, rule string) []validator.Problem {
+		doc, err := ParseDocument(source)
+		Expect(err).ToNot(HaveOccurred())
+		return validator.Validate(&doc, validator.EnableRules(rule))
+	}
+
+	It("should be disabled by default", func() {
+		doc, err := ParseDocument(`== Getting Started
+
+see http://example.com
+
+image::logo.png[]`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validator.Validate(&doc)).To(BeEmpty())
+	})
+
+	It("should report source blocks without language", func() {
+		source := `[source]
+----
+code
+----
+
+[source,go]
+----
+code
+----
+
+----
+listing
+----`
+		Expect(validate(source, validator.SourceLanguageRule)).To(Equal([]validator.Problem{
+			{
+				Rule:     validator.SourceLanguageRule,
+				Severity: validator.Warning,
+				Message:  "source block without language",
+			},
+		}))
+	})
+
+	It("should report images without alt text", func() {
+		source := `image::images/logo.png[]
+
+image::images/logo.png[The logo]
+
+an image:images/icon.png[] and an image:images/icon.png[An icon]`
+		Expect(validate(source, validator.ImageAltTextRule)).To(Equal([]validator.Problem{
+			{
+				Rule:     validator.ImageAltTextRule,
+				Severity: validator.Warning,
+				Message:  "image without alt text: 'images/logo.png'",
+			},
+			{
+				Rule:     validator.ImageAltTextRule,
+				Severity: validator.Warning,
+				Message:  "image without alt text: 'images/icon.png'",
+			},
+		}))
+	})
+
+	It("should report nested admonitions", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{},
+			Elements: []interface{}{
+				types.DelimitedBlock{
+					Kind: types.Example,
+					Attributes: types.ElementAttributes{
+						types.AttrAdmonitionKind: types.Note,
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Attributes: types.ElementAttributes{
+								types.AttrAdmonitionKind: types.Warning,
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "nested"},
+								},
+							},
+						},
+					},
+				},
+				types.Paragraph{
+					Attributes: types.ElementAttributes{
+						types.AttrAdmonitionKind: types.Tip,
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{Content: "not nested"},
+						},
+					},
+				},
+			},
+		}
+		Expect(validator.Validate(&doc, validator.EnableRules(validator.NestedAdmonitionRule))).To(Equal([]validator.Problem{
+			{
+				Rule:     validator.NestedAdmonitionRule,
+				Severity: validator.Warning,
+				Message:  "'warning' admonition nested in a 'note' admonition",
+			},
+		}))
+	})
+
+	It("should report section titles not in sentence case", func() {
+		source := `= Getting started with the API
+
+== Getting Started
+
+== Using GitHub and the API
+
+== lowercase title`
+		Expect(validate(source, validator.SentenceCaseTitleRule)).To(Equal([]validator.Problem{
+			{
+				Rule:     validator.SentenceCaseTitleRule,
+				Severity: validator.Warning,
+				Message:  "section title is not in sentence case: 'Getting Started'",
+			},
+			{
+				Rule:     validator.SentenceCaseTitleRule,
+				Severity: validator.Warning,
+				Message:  "section title is not in sentence case: 'lowercase title'",
+			},
+		}))
+	})
+
+	It("should report insecure links", func() {
+		source := `see http://example.com and https://example.com and http://example.org[the doc]`
+		Expect(validate(source, validator.InsecureLinkRule)).To(Equal([]validator.Problem{
+			{
+				Rule:     validator.InsecureLinkRule,
+				Severity: validator.Warning,
+				Message:  "link with 'http://' instead of 'https://': 'http://example.com'",
+			},
+			{
+				Rule:     validator.InsecureLinkRule,
+				Severity: validator.Warning,
+				Message:  "link with 'http://' instead of 'https://': 'http://example.org'",
+			},
+		}))
+	})
+})
@@ -1,27 +1,145 @@
 package validator
 
 import (
+	"regexp"
 	"strings"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
-// Validate validates the given document
+// Validate validates the given document with the registered rules which are enabled (by default or in the given options)
+// and with the additional rules given in the options.
 // May also alter some attributes (eg: doctype from `manpage` to `article`)
-func Validate(doc *types.Document) []Problem {
+func Validate(doc *types.Document, options ...Option) []Problem {
+	opts := newOptions(options...)
 	problems := []Problem{}
-	if doctype, exists := doc.Attributes.GetAsString(types.AttrDocType); exists && doctype == "manpage" {
-		problems = append(problems, validateManpage(doc)...)
+	for _, r := range append(RegisteredRules(), opts.rules...) {
+		id := r.Rule.ID()
+		enabled, found := opts.enabled[id]
+		if !found {
+			enabled = r.EnabledByDefault
+		}
+		// the manpage structure is always checked, since invalid manpages are rendered as articles
+		if !enabled && id != ManpageStructureRule {
+			continue
+		}
+		for _, p := range r.Rule.Check(doc) {
+			if !enabled {
+				continue
+			}
+			if p.Rule == "" {
+				p.Rule = id
+			}
+			if severity, found := opts.severities[id]; found {
+				p.Severity = severity
+			}
+			problems = append(problems, opts.locate(p))
+		}
 	}
 	return problems
 }
 
+// Option an option to configure the validation
+type Option func(*options)
+
+type options struct {
+	enabled    map[string]bool
+	severities map[string]Severity
+	rules      []RegisteredRule
+	positions  *positions
+}
+
+func newOptions(settings ...Option) options {
+	opts := options{
+		enabled:    map[string]bool{},
+		severities: map[string]Severity{},
+	}
+	for _, set := range settings {
+		set(&opts)
+	}
+	return opts
+}
+
+// locate sets the position of the given problem if the source of the document was given in the options
+// (and if the rule did not set it)
+func (opts options) locate(p Problem) Problem {
+	if opts.positions != nil && p.Position.Line == 0 {
+		p.Position = opts.positions.locate(p.pattern, p.occurrence)
+	}
+	p.pattern = nil
+	p.occurrence = 0
+	return p
+}
+
+// EnableRules enables the rules with the given IDs
+func EnableRules(ids ...string) Option {
+	return func(opts *options) {
+		for _, id := range ids {
+			opts.enabled[id] = true
+		}
+	}
+}
+
+// DisableRules disables the rules with the given IDs
+func DisableRules(ids ...string) Option {
+	return func(opts *options) {
+		for _, id := range ids {
+			opts.enabled[id] = false
+		}
+	}
+}
+
+// WithSeverity overrides the severity of the problems reported by the rule with the given ID (and enables the rule)
+func WithSeverity(id string, severity Severity) Option {
+	return func(opts *options) {
+		opts.enabled[id] = true
+		opts.severities[id] = severity
+	}
+}
+
+// WithRules adds the given rules (enabled unless disabled in the options) to the registered rules,
+// for this validation only
+func WithRules(rules ...Rule) Option {
+	return func(opts *options) {
+		for _, r := range rules {
+			opts.rules = append(opts.rules, RegisteredRule{
+				Rule:             r,
+				EnabledByDefault: true,
+			})
+		}
+	}
+}
+
+// WithConfiguration applies the state of the validation rules set in the given configuration
+func WithConfiguration(config configuration.Configuration) Option {
+	return func(opts *options) {
+		for id, state := range config.ValidationRules() {
+			switch state {
+			case configuration.RuleOff:
+				DisableRules(id)(opts)
+			case configuration.RuleOn:
+				EnableRules(id)(opts)
+			case configuration.RuleWarning:
+				WithSeverity(id, Warning)(opts)
+			case configuration.RuleError:
+				WithSeverity(id, Error)(opts)
+			}
+		}
+	}
+}
+
 // Problem a problem detected during validation
-// Must have a severity and an associated message
-// TODO: include element position once available in the AST.
+// Must have a rule ID, a severity and an associated message, and has a position if the source
+// of the document was given in the options
 type Problem struct {
+	Rule     string
 	Severity Severity
 	Message  string
+	Position types.Position
+	// the syntax of the element which caused the problem, and its occurrence in the document (see `WithSource`)
+	pattern    *regexp.Regexp
+	occurrence int
 }
 
 // Severity the problem severity
@@ -34,6 +152,13 @@ const (
 	Warning Severity = "Warning"
 )
 
+func validateManpageStructure(doc *types.Document) []Problem {
+	if doctype, exists := doc.Attributes.GetAsString(types.AttrDocType); exists && doctype == "manpage" {
+		return validateManpage(doc)
+	}
+	return nil
+}
+
 // validateManpage checks that the document has the expected structure, ie:
 // A document header
 // a section named `Name` (case insensitive) with a single paragraph
@@ -45,21 +170,25 @@ func validateManpage(doc *types.Document) []Problem {
 	// checks the presence of a header
 	if header, ok := assertThatElement(doc.Elements[0]).isHeader(); !ok {
 		problems = append(problems, Problem{
+			Rule:     ManpageStructureRule,
 			Severity: Error,
 			Message:  "manpage document is missing a header",
 		})
 	} else if nameSection, ok := assertThatElement(header.Elements[0]).isSection(withLevel(1), withTitle("name")); !ok {
 		problems = append(problems, Problem{
+			Rule:     ManpageStructureRule,
 			Severity: Error,
 			Message:  "manpage document is missing the 'Name' section'",
 		})
 	} else if ok := assertThatElements(nameSection.Elements).haveCount(1); !ok {
 		problems = append(problems, Problem{
+			Rule:     ManpageStructureRule,
 			Severity: Error,
 			Message:  "'Name' section' should contain a single paragraph",
 		})
 	} else if _, ok := assertThatElement(header.Elements[1]).isSection(withLevel(1), withTitle("synopsis")); !ok {
 		problems = append(problems, Problem{
+			Rule:     ManpageStructureRule,
 			Severity: Error,
 			Message:  "manpage document is missing the 'Synopsis' section'",
 		})
@@ -163,6 +163,7 @@ var _ = Describe("document validator", func() {
 
 				// then
 				Expect(problems).To(ContainElement(Problem{
+					Rule:     ManpageStructureRule,
 					Severity: Error,
 					Message:  "manpage document is missing a header",
 				}))
@@ -228,6 +229,7 @@ var _ = Describe("document validator", func() {
 
 				// then
 				Expect(problems).To(ContainElement(Problem{
+					Rule:     ManpageStructureRule,
 					Severity: Error,
 					Message:  "manpage document is missing the 'Name' section'",
 				}))
@@ -293,6 +295,7 @@ var _ = Describe("document validator", func() {
 
 				// then
 				Expect(problems).To(ContainElement(Problem{
+					Rule:     ManpageStructureRule,
 					Severity: Error,
 					Message:  "manpage document is missing the 'Name' section'",
 				}))
@@ -347,6 +350,7 @@ var _ = Describe("document validator", func() {
 
 				// then
 				Expect(problems).To(ContainElement(Problem{
+					Rule:     ManpageStructureRule,
 					Severity: Error,
 					Message:  "'Name' section' should contain a single paragraph",
 				}))
@@ -412,6 +416,7 @@ var _ = Describe("document validator", func() {
 
 				// then
 				Expect(problems).To(ContainElement(Problem{
+					Rule:     ManpageStructureRule,
 					Severity: Error,
 					Message:  "manpage document is missing the 'Synopsis' section'",
 				}))
@@ -477,6 +482,7 @@ var _ = Describe("document validator", func() {
 
 				// then
 				Expect(problems).To(ContainElement(Problem{
+					Rule:     ManpageStructureRule,
 					Severity: Error,
 					Message:  "manpage document is missing the 'Synopsis' section'",
 				}))
@@ -15,8 +15,11 @@ func ParseDraftDocument(actual string, options ...interface{}) (interface{}, err
 		filename:      "test.adoc",
 	}
 	parserOptions := []parser.Option{}
+	settings := []configuration.Setting{}
 	for _, o := range options {
 		switch set := o.(type) {
+		case configuration.Setting:
+			settings = append(settings, set)
 		case BecomeDraftDocumentOption:
 			set(c)
 		case FilenameOption:
@@ -29,7 +32,7 @@ func ParseDraftDocument(actual string, options ...interface{}) (interface{}, err
 	if !c.preprocessing {
 		return parser.ParseReader(c.filename, r, append(parserOptions, parser.Entrypoint("AsciidocDocument"))...)
 	}
-	config := configuration.NewConfiguration(configuration.WithFilename(c.filename))
+	config := configuration.NewConfiguration(append(settings, configuration.WithFilename(c.filename))...)
 	return parser.ParseDraftDocument(r, config, parserOptions...)
 }
 
@@ -0,0 +1,25 @@
+package testsupport
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	log "github.com/sirupsen/logrus"
+)
+
+// RenderAsciidoc formats the given source into canonical Asciidoc
+func RenderAsciidoc(actual string, settings ...configuration.Setting) (string, error) {
+	config := configuration.NewConfiguration(settings...)
+	contentReader := strings.NewReader(actual)
+	resultWriter := bytes.NewBuffer(nil)
+	err := libasciidoc.Format(contentReader, resultWriter, config)
+	if err != nil {
+		return "", err
+	}
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debug(resultWriter.String())
+	}
+	return resultWriter.String(), nil
+}
@@ -0,0 +1,22 @@
+package testsupport_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("asciidoc renderer", func() {
+
+	It("should match", func() {
+		// given
+		actual := "==   Section"
+		// when
+		result, err := testsupport.RenderAsciidoc(actual)
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("== Section\n"))
+	})
+
+})
@@ -0,0 +1,33 @@
+package testsupport
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+)
+
+// RenderEPUB3 renders the EPUB3 publication using the given source, and returns a reader on the resulting archive
+func RenderEPUB3(actual string, settings ...configuration.Setting) (*zip.Reader, error) {
+	config := configuration.NewConfiguration(settings...)
+	contentReader := strings.NewReader(actual)
+	resultWriter := bytes.NewBuffer(nil)
+	_, err := libasciidoc.ConvertToEPUB(contentReader, resultWriter, config)
+	if err != nil {
+		return nil, err
+	}
+	return zip.NewReader(bytes.NewReader(resultWriter.Bytes()), int64(resultWriter.Len()))
+}
+
+// RenderEPUB3Document renders the EPUB3 publication using the given file, and returns a reader on the resulting archive
+func RenderEPUB3Document(filename string, settings ...configuration.Setting) (*zip.Reader, error) {
+	config := configuration.NewConfiguration(append(settings, configuration.WithFilename(filename))...)
+	resultWriter := bytes.NewBuffer(nil)
+	_, err := libasciidoc.ConvertFileToEPUB(resultWriter, config)
+	if err != nil {
+		return nil, err
+	}
+	return zip.NewReader(bytes.NewReader(resultWriter.Bytes()), int64(resultWriter.Len()))
+}
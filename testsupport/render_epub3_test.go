@@ -0,0 +1,30 @@
+package testsupport_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("epub3 renderer", func() {
+
+	It("should render archive", func() {
+		// given
+		actual := `= hello, world!`
+		// when
+		result, err := testsupport.RenderEPUB3(actual)
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.File).NotTo(BeEmpty())
+		Expect(result.File[0].Name).To(Equal("mimetype"))
+	})
+
+	It("should fail when file does not exist", func() {
+		// when
+		_, err := testsupport.RenderEPUB3Document("../test/epub/unknown.adoc")
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+})
@@ -0,0 +1,25 @@
+package testsupport
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	log "github.com/sirupsen/logrus"
+)
+
+// RenderLaTeX renders the LaTeX content using the given source
+func RenderLaTeX(actual string, settings ...configuration.Setting) (string, error) {
+	config := configuration.NewConfiguration(settings...)
+	contentReader := strings.NewReader(actual)
+	resultWriter := bytes.NewBuffer(nil)
+	_, err := libasciidoc.ConvertToLaTeX(contentReader, resultWriter, config)
+	if err != nil {
+		return "", err
+	}
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debug(resultWriter.String())
+	}
+	return resultWriter.String(), nil
+}
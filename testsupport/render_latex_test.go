@@ -0,0 +1,22 @@
+package testsupport_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("latex renderer", func() {
+
+	It("should match", func() {
+		// given
+		actual := "hello, world!"
+		// when
+		result, err := testsupport.RenderLaTeX(actual)
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("hello, world!"))
+	})
+
+})
@@ -0,0 +1,22 @@
+package testsupport_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("slides renderer", func() {
+
+	It("should match", func() {
+		// given
+		actual := "== Slide"
+		// when
+		result, err := testsupport.RenderSlides(actual)
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("<section id=\"_slide\">\n<h2>Slide</h2>\n</section>"))
+	})
+
+})